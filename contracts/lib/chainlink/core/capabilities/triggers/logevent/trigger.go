@@ -200,15 +200,8 @@ func createTriggerResponse(log types.Sequence, version string) capabilities.Trig
 		}
 	}
 
-	wrappedPayload, err := values.WrapMap(&logeventcap.Output{
-		Cursor: log.Cursor,
-		Data:   dataAsMap,
-		Head: logeventcap.Head{
-			Hash:      "0x" + hex.EncodeToString(log.Hash),
-			Height:    log.Height,
-			Timestamp: log.Timestamp,
-		},
-	})
+	output := NewOutput(log, dataAsMap)
+	wrappedPayload, err := values.WrapMap(&output)
 	if err != nil {
 		return capabilities.TriggerResponse{
 			Err: fmt.Errorf("error wrapping trigger event: %w", err),
@@ -224,6 +217,20 @@ func createTriggerResponse(log types.Sequence, version string) capabilities.Trig
 	}
 }
 
+// NewOutput returns the trigger output for the event log, whose data is already decoded. The
+// evmlog jobs use it too, so that pipelines and workflows triggered by an event see the same payload.
+func NewOutput(log types.Sequence, data map[string]any) logeventcap.Output {
+	return logeventcap.Output{
+		Cursor: log.Cursor,
+		Data:   data,
+		Head: logeventcap.Head{
+			Hash:      "0x" + hex.EncodeToString(log.Hash),
+			Height:    log.Height,
+			Timestamp: log.Timestamp,
+		},
+	}
+}
+
 // Close contract event listener for the current contract
 // This function is called when UnregisterTrigger is called individually
 // for a specific ContractAddress and EventName
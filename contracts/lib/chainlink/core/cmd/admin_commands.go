@@ -30,6 +30,7 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 			Usage:  "Change your API password remotely",
 			Action: s.ChangePassword,
 		},
+		initAdminDrainSubCmd(s),
 		{
 			Name:   "login",
 			Usage:  "Login to remote client by creating a session cookie",
@@ -67,12 +68,16 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		initAdminProfilesSubCmd(s),
+		initAdminRolesSubCmd(s),
+		initAdminSessionsSubCmd(s),
 		{
 			Name:   "status",
 			Usage:  "Displays the health of various services running inside the node.",
 			Action: s.Status,
 			Flags:  []cli.Flag{},
 		},
+		initAdminTokensSubCmd(s),
 		{
 			Name:  "users",
 			Usage: "Create, edit permissions, or delete API users",
@@ -128,6 +133,18 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 						},
 					},
 				},
+				{
+					Name:   "unlock",
+					Usage:  "Unlock an API user locked out after too many failed logins",
+					Action: s.UnlockUser,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "email",
+							Usage:    "Email of API user to unlock",
+							Required: true,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -301,6 +318,26 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+// UnlockUser clears the failed logins of an API user by email
+func (s *Shell) UnlockUser(c *cli.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return s.errorOut(errors.New("email flag is empty, must specify an email"))
+	}
+
+	response, err := s.HTTP.Post(s.ctx(), "/v2/users/"+email+"/unlock", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully unlocked API user")
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
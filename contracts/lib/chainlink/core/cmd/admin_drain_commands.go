@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initAdminDrainSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "drain",
+		Usage: "Drain the node before planned maintenance: new pipeline runs are refused and the node reports itself as not ready, while the in-flight runs complete",
+		Subcommands: []cli.Command{
+			{
+				Name:   "start",
+				Usage:  "Start draining the node. The runs still in flight after the timeout are cancelled, the node can then be stopped",
+				Action: s.StartDrain,
+				Flags: []cli.Flag{
+					cli.DurationFlag{
+						Name:  "timeout",
+						Usage: "how long the in-flight runs are given to complete",
+						Value: 10 * time.Minute,
+					},
+				},
+			},
+			{
+				Name:   "status",
+				Usage:  "Displays whether the node is draining, and how many runs are still in flight",
+				Action: s.DrainStatus,
+			},
+			{
+				Name:   "cancel",
+				Usage:  "Stop draining the node, so that new pipeline runs are started again",
+				Action: s.CancelDrain,
+			},
+		},
+	}
+}
+
+type DrainPresenter struct {
+	JAID
+	presenters.DrainResource
+}
+
+var drainTableHeaders = []string{"State", "Started at", "Deadline", "Timed out", "In-flight runs"}
+
+func (p *DrainPresenter) ToRow() []string {
+	var startedAt, deadline string
+	if p.StartedAt != nil {
+		startedAt = p.StartedAt.String()
+	}
+	if p.Deadline != nil {
+		deadline = p.Deadline.String()
+	}
+	return []string{
+		p.State,
+		startedAt,
+		deadline,
+		fmt.Sprint(p.TimedOut),
+		fmt.Sprint(p.InFlightRuns),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *DrainPresenter) RenderTable(rt RendererTable) error {
+	renderList(drainTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// StartDrain starts draining the node
+func (s *Shell) StartDrain(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(web.DrainRequest{Timeout: c.Duration("timeout").String()})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/drain", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &DrainPresenter{}, "Draining the node, check 'admin drain status' until it is drained")
+}
+
+// DrainStatus renders the drain mode of the node
+func (s *Shell) DrainStatus(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/drain")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &DrainPresenter{})
+}
+
+// CancelDrain stops draining the node
+func (s *Shell) CancelDrain(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/drain")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &DrainPresenter{}, "Stopped draining the node")
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initAdminProfilesSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "profiles",
+		Usage: "Capture, list or download the profiles gathered by the automatic profiler (AutoPprof)",
+		Subcommands: []cli.Command{
+			{
+				Name:   "capture",
+				Usage:  "Capture the profiles of the node now, for the given reason",
+				Action: s.CaptureProfiles,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "reason",
+						Usage:    "why the profiles are captured, recorded with them",
+						Required: true,
+					},
+				},
+			},
+			{
+				Name:   "list",
+				Usage:  "Lists the profile captures stored on the node, most recent first",
+				Action: s.ListProfiles,
+			},
+			{
+				Name:   "download",
+				Usage:  "Download profile captures as a zip archive",
+				Action: s.DownloadProfiles,
+				Flags: []cli.Flag{
+					cli.StringSliceFlag{
+						Name:  "id",
+						Usage: "ID of a capture to download, as listed by 'admin profiles list'. If unset, all the completed captures are downloaded",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "path of the zip archive to write",
+						Value: "profiles.zip",
+					},
+				},
+			},
+		},
+	}
+}
+
+type ProfileCapturePresenter struct {
+	JAID
+	presenters.ProfileCaptureResource
+}
+
+var profileCapturesTableHeaders = []string{"ID", "Time", "Reason", "Meta", "Files", "Size", "In progress"}
+
+func (p *ProfileCapturePresenter) ToRow() []string {
+	meta := make([]string, 0, len(p.Meta))
+	for k, v := range p.Meta {
+		meta = append(meta, fmt.Sprintf("%s: %v", k, v))
+	}
+	sort.Strings(meta)
+	return []string{
+		p.ID,
+		p.Time.String(),
+		p.Reason,
+		strings.Join(meta, "\n"),
+		fmt.Sprint(len(p.Files)),
+		utils.FileSize(p.Size).String(),
+		fmt.Sprint(p.InProgress),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *ProfileCapturePresenter) RenderTable(rt RendererTable) error {
+	renderList(profileCapturesTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type ProfileCapturePresenters []ProfileCapturePresenter
+
+// RenderTable implements TableRenderer
+func (ps ProfileCapturePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Profile captures\n")); err != nil {
+		return err
+	}
+	renderList(profileCapturesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// CaptureProfiles requests a capture of the profiles of the node
+func (s *Shell) CaptureProfiles(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(web.ProfileCaptureRequest{Reason: c.String("reason")})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/profiles", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ProfileCapturePresenter{}, "Profile capture requested, the profiles are gathered in the background")
+}
+
+// ListProfiles renders the profile captures stored on the node
+func (s *Shell) ListProfiles(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/profiles")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ProfileCapturePresenters{})
+}
+
+// DownloadProfiles writes the given profile captures, or all of them, to a zip archive
+func (s *Shell) DownloadProfiles(c *cli.Context) (err error) {
+	path := "/v2/profiles/download"
+	if ids := c.StringSlice("id"); len(ids) > 0 {
+		path += "?" + url.Values{"id": ids}.Encode()
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		if _, err = s.parseResponse(resp); err != nil {
+			return err
+		}
+		return s.errorOut(fmt.Errorf("unexpected status %s", resp.Status))
+	}
+
+	output := c.String("output")
+	f, err := os.Create(output)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	wc := utils.NewDeferableWriteCloser(f)
+	defer wc.Close()
+	n, err := io.Copy(wc, resp.Body)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if err = wc.Close(); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Downloaded %s of profiles to %s\n", utils.FileSize(n), output)
+	return nil
+}
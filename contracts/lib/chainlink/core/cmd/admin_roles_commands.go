@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initAdminRolesSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "roles",
+		Usage: "Create, edit, delete or assign custom roles",
+		Subcommands: []cli.Command{
+			{
+				Name:   "list",
+				Usage:  "Lists all custom roles and their permissions",
+				Action: s.ListCustomRoles,
+			},
+			{
+				Name:   "create",
+				Usage:  "Create a new custom role",
+				Action: s.CreateCustomRole,
+			},
+			{
+				Name:   "update",
+				Usage:  "Replace the description and permissions of a custom role",
+				Action: s.UpdateCustomRole,
+			},
+			{
+				Name:   "delete",
+				Usage:  "Delete a custom role, unassigning it from all its users",
+				Action: s.DeleteCustomRole,
+			},
+			{
+				Name:   "assign",
+				Usage:  "Assign a custom role to an API user",
+				Action: s.AssignCustomRole,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "email",
+						Usage:    "email of the user",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:     "role",
+						Usage:    "name of the custom role",
+						Required: true,
+					},
+				},
+			},
+			{
+				Name:   "unassign",
+				Usage:  "Remove a custom role from an API user",
+				Action: s.UnassignCustomRole,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "email",
+						Usage:    "email of the user",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:     "role",
+						Usage:    "name of the custom role",
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type CustomRolePresenter struct {
+	JAID
+	presenters.CustomRoleResource
+}
+
+var customRolesTableHeaders = []string{"Name", "Description", "Permissions", "Updated at"}
+
+func (p *CustomRolePresenter) ToRow() []string {
+	grants := make([]string, len(p.Grants))
+	for i, g := range p.Grants {
+		grants[i] = formatGrant(g)
+	}
+	return []string{
+		p.Name,
+		p.Description,
+		strings.Join(grants, "\n"),
+		p.UpdatedAt.String(),
+	}
+}
+
+func formatGrant(g rbac.Grant) string {
+	var scopes []string
+	if len(g.JobTypes) > 0 {
+		scopes = append(scopes, "types: "+strings.Join(g.JobTypes, ","))
+	}
+	if len(g.JobTags) > 0 {
+		scopes = append(scopes, "tags: "+strings.Join(g.JobTags, ","))
+	}
+	if len(g.JobIDs) > 0 {
+		ids := make([]string, len(g.JobIDs))
+		for i, id := range g.JobIDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+		scopes = append(scopes, "jobs: "+strings.Join(ids, ","))
+	}
+	if len(scopes) == 0 {
+		return string(g.Permission)
+	}
+	return fmt.Sprintf("%s (%s)", g.Permission, strings.Join(scopes, "; "))
+}
+
+// RenderTable implements TableRenderer
+func (p *CustomRolePresenter) RenderTable(rt RendererTable) error {
+	renderList(customRolesTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type CustomRolePresenters []CustomRolePresenter
+
+// RenderTable implements TableRenderer
+func (ps CustomRolePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Custom roles\n")); err != nil {
+		return err
+	}
+	renderList(customRolesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListCustomRoles renders all the custom roles and their permissions
+func (s *Shell) ListCustomRoles(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/roles", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CustomRolePresenters{})
+}
+
+// CreateCustomRole creates a custom role from its JSON definition
+func (s *Shell) CreateCustomRole(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in the role's definition [JSON blob | JSON filepath]"))
+	}
+	buf, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/roles", buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CustomRolePresenter{}, "Successfully created custom role")
+}
+
+// UpdateCustomRole replaces the description and grants of a custom role
+func (s *Shell) UpdateCustomRole(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the role and its new definition [JSON blob | JSON filepath]"))
+	}
+	buf, err := getBufferFromJSON(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/roles/"+url.PathEscape(c.Args().First()), buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CustomRolePresenter{}, "Successfully updated custom role")
+}
+
+// DeleteCustomRole deletes a custom role by name
+func (s *Shell) DeleteCustomRole(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the role to delete"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/roles/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	_, err = s.parseResponse(resp)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Custom role %s deleted\n", c.Args().First())
+	return nil
+}
+
+// AssignCustomRole assigns a custom role to an API user, and renders all their custom roles
+func (s *Shell) AssignCustomRole(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(struct {
+		Email string `json:"email"`
+	}{Email: c.String("email")})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/roles/"+url.PathEscape(c.String("role"))+"/users", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CustomRolePresenters{}, "Successfully assigned custom role")
+}
+
+// UnassignCustomRole removes a custom role from an API user, and renders their remaining custom roles
+func (s *Shell) UnassignCustomRole(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/roles/"+url.PathEscape(c.String("role"))+"/users/"+url.PathEscape(c.String("email")))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CustomRolePresenters{}, "Successfully unassigned custom role")
+}
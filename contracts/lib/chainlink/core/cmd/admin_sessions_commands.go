@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initAdminSessionsSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "sessions",
+		Usage: "List or revoke the active sessions of API users",
+		Subcommands: []cli.Command{
+			{
+				Name:   "list",
+				Usage:  "Lists the active sessions of all users, or those of a single user",
+				Action: s.ListSessions,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "email",
+						Usage: "email of the user whose sessions to list",
+					},
+				},
+			},
+			{
+				Name:   "revoke",
+				Usage:  "Revoke a single session, or log a user out of all their sessions",
+				Action: s.RevokeSessions,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "id",
+						Usage: "ID of the session to revoke, as listed by 'admin sessions list'",
+					},
+					cli.StringFlag{
+						Name:  "email",
+						Usage: "email of the user to log out of all their sessions",
+					},
+				},
+			},
+		},
+	}
+}
+
+type SessionPresenter struct {
+	JAID
+	presenters.SessionResource
+}
+
+var sessionsTableHeaders = []string{"ID", "Email", "IP address", "User agent", "Created at", "Last used"}
+
+func (p *SessionPresenter) ToRow() []string {
+	id := p.ID
+	if p.Current {
+		id += " (current)"
+	}
+	return []string{
+		id,
+		p.Email,
+		p.IPAddress,
+		p.UserAgent,
+		p.CreatedAt.String(),
+		p.LastUsed.String(),
+	}
+}
+
+type SessionPresenters []SessionPresenter
+
+// RenderTable implements TableRenderer
+func (ps SessionPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Sessions\n")); err != nil {
+		return err
+	}
+	renderList(sessionsTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListSessions renders the active sessions of all users, or of the given user
+func (s *Shell) ListSessions(c *cli.Context) (err error) {
+	path := "/v2/sessions"
+	if email := c.String("email"); email != "" {
+		path += "?email=" + url.QueryEscape(email)
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &SessionPresenters{})
+}
+
+// RevokeSessions revokes the session with the given ID, or all the sessions of the given user
+func (s *Shell) RevokeSessions(c *cli.Context) (err error) {
+	id, email := c.String("id"), c.String("email")
+	var path, done string
+	switch {
+	case id != "" && email != "":
+		return s.errorOut(errors.New("only one of --id and --email can be set"))
+	case id != "":
+		path, done = "/v2/sessions/"+url.PathEscape(id), fmt.Sprintf("Session %s revoked", id)
+	case email != "":
+		path, done = "/v2/users/"+url.PathEscape(email)+"/sessions", fmt.Sprintf("User %s logged out of all their sessions", email)
+	default:
+		return s.errorOut(errors.New("one of --id or --email must be set"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	_, err = s.parseResponse(resp)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Println(done)
+	return nil
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initAdminTokensSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "tokens",
+		Usage: "Create, list or revoke named API tokens",
+		Subcommands: []cli.Command{
+			{
+				Name:   "list",
+				Usage:  "Lists your API tokens, or those of another user",
+				Action: s.ListAPITokens,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "email",
+						Usage: "email of the user whose tokens to list, admin only",
+					},
+				},
+			},
+			{
+				Name:   "create",
+				Usage:  "Create a new API token, optionally expiring and restricted to some permissions",
+				Action: s.CreateAPIToken,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "name",
+						Usage:    "name of the token",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:  "expires-in",
+						Usage: "duration after which the token expires, e.g. 720h",
+					},
+					cli.StringFlag{
+						Name:  "grants",
+						Usage: "permissions the token is restricted to [JSON blob | JSON filepath]",
+					},
+				},
+			},
+			{
+				Name:   "revoke",
+				Usage:  "Revoke one of your API tokens, or one of another user",
+				Action: s.RevokeAPIToken,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "name",
+						Usage:    "name of the token",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:  "email",
+						Usage: "email of the user owning the token, admin only",
+					},
+				},
+			},
+		},
+	}
+}
+
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokensTableHeaders = []string{"Name", "Access key", "Permissions", "Expires at", "Last used at", "Created at"}
+
+func (p *APITokenPresenter) ToRow() []string {
+	grants := make([]string, len(p.Grants))
+	for i, g := range p.Grants {
+		grants[i] = formatGrant(g)
+	}
+	if len(grants) == 0 {
+		grants = []string{"all of the user"}
+	}
+	return []string{
+		p.Name,
+		p.AccessKey,
+		strings.Join(grants, "\n"),
+		formatOptionalTime(p.ExpiresAt),
+		formatOptionalTime(p.LastUsedAt),
+		p.CreatedAt.String(),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type CreatedAPITokenPresenter struct {
+	JAID
+	presenters.CreatedAPITokenResource
+}
+
+// RenderTable implements TableRenderer
+func (p *CreatedAPITokenPresenter) RenderTable(rt RendererTable) error {
+	grants := make([]string, len(p.Grants))
+	for i, g := range p.Grants {
+		grants[i] = formatGrant(g)
+	}
+	rows := [][]string{{
+		p.Name,
+		p.AccessKey,
+		p.Secret,
+		strings.Join(grants, "\n"),
+		formatOptionalTime(p.ExpiresAt),
+	}}
+	renderList([]string{"Name", "Access key", "Secret", "Permissions", "Expires at"}, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\nThe secret cannot be retrieved again, store it now.\n")))
+}
+
+// ListAPITokens renders the API tokens of the current user, or of the given user
+func (s *Shell) ListAPITokens(c *cli.Context) (err error) {
+	path := "/v2/user/tokens"
+	if email := c.String("email"); email != "" {
+		path = "/v2/users/" + url.PathEscape(email) + "/tokens"
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a named API token for the current user, after confirming their password
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	request := struct {
+		Name      string       `json:"name"`
+		Password  string       `json:"password"`
+		ExpiresAt *time.Time   `json:"expiresAt,omitempty"`
+		Grants    []rbac.Grant `json:"grants,omitempty"`
+	}{
+		Name: c.String("name"),
+	}
+	if expiresIn := c.String("expires-in"); expiresIn != "" {
+		d, perr := time.ParseDuration(expiresIn)
+		if perr != nil {
+			return s.errorOut(fmt.Errorf("invalid expires-in: %w", perr))
+		}
+		expiresAt := time.Now().Add(d)
+		request.ExpiresAt = &expiresAt
+	}
+	if grants := c.String("grants"); grants != "" {
+		buf, gerr := getBufferFromJSON(grants)
+		if gerr != nil {
+			return s.errorOut(gerr)
+		}
+		if gerr = json.Unmarshal(buf.Bytes(), &request.Grants); gerr != nil {
+			return s.errorOut(fmt.Errorf("invalid grants: %w", gerr))
+		}
+	}
+
+	fmt.Println("Password of the current user:")
+	request.Password = s.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/user/tokens", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CreatedAPITokenPresenter{}, "Successfully created API token")
+}
+
+// RevokeAPIToken revokes a named API token of the current user, or of the given user
+func (s *Shell) RevokeAPIToken(c *cli.Context) (err error) {
+	path := "/v2/user/tokens/" + url.PathEscape(c.String("name"))
+	if email := c.String("email"); email != "" {
+		path = "/v2/users/" + url.PathEscape(email) + "/tokens/" + url.PathEscape(c.String("name"))
+	}
+	resp, err := s.HTTP.Delete(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	_, err = s.parseResponse(resp)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("API token %s revoked\n", c.String("name"))
+	return nil
+}
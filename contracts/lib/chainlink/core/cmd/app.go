@@ -205,6 +205,7 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("TON", NewTONKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+				initKeysRotatePasswordSubCmd(s, &opts),
 			},
 		},
 		{
@@ -307,6 +308,16 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "keeper",
+			Usage:       "Commands for inspecting keeper jobs",
+			Subcommands: initKeeperSubCmds(s),
+		},
+		{
+			Name:        "vrf",
+			Usage:       "Commands for inspecting VRF jobs",
+			Subcommands: initVRFSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+func initAuditLogSubCmds(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "audit-log",
+		Usage: "Commands for the local audit log.",
+		Subcommands: []cli.Command{
+			{
+				Name:      "verify",
+				Usage:     "Verify the HMAC chain of the audit log files written by AuditLogger.File with the AuditLogger.FileChainKey secret, detecting any record that was edited, removed or reordered. Defaults to all the files in AuditLogger.File.Dir, oldest first.",
+				ArgsUsage: "[file...]",
+				Action:    s.VerifyAuditLog,
+			},
+		},
+	}
+}
+
+// VerifyAuditLog verifies the HMAC chain of the audit log files, in the given order. The chain
+// must start from the anchor of the directory of the first file, and carry on from one file to
+// the next.
+func (s *Shell) VerifyAuditLog(c *cli.Context) error {
+	fileConfig := s.Config.AuditLogger().File()
+	key := []byte(fileConfig.ChainKey())
+	if len(key) == 0 {
+		return s.errorOut(errors.New("the AuditLogger.FileChainKey secret is required to verify the audit log"))
+	}
+	files := c.Args()
+	dir := fileConfig.Dir()
+	if len(files) == 0 {
+		var err error
+		files, err = audit.ChainFiles(dir)
+		if err != nil {
+			return s.errorOut(err)
+		}
+		if len(files) == 0 {
+			return s.errorOut(errors.Errorf("no audit log files found in %s", dir))
+		}
+	} else {
+		dir = filepath.Dir(files[0])
+	}
+
+	state, err := audit.ReadChainAnchor(dir, key)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	var total int
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error opening audit log file"))
+		}
+		var n int
+		state, n, err = audit.VerifyChain(f, key, state)
+		f.Close()
+		total += n
+		if err != nil {
+			return s.errorOut(errors.Wrapf(err, "audit log %s failed verification after %d valid records", file, total))
+		}
+		fmt.Printf("%s: %d records verified\n", file, n)
+	}
+	fmt.Printf("Audit log verified: %d records, last record %d with hash %s\n", total, state.Seq, state.Hash)
+	return nil
+}
@@ -2,15 +2,24 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initBlocksSubCmds(s *Shell) []cli.Command {
@@ -53,6 +62,73 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "bhs-backfill",
+			Usage:  "Report the blockhash store coverage of unfulfilled VRF requests in a block range and backfill the missing blockhashes",
+			Action: s.BHSBackfill,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "bhs-address",
+					Usage:    "Address of the BlockhashStore contract",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "batch-bhs-address",
+					Usage:    "Address of the BatchBlockhashStore contract",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "coordinator-v1-address",
+					Usage: "Address of the VRF V1 coordinator",
+				},
+				cli.StringFlag{
+					Name:  "coordinator-v2-address",
+					Usage: "Address of the VRF V2 coordinator",
+				},
+				cli.StringFlag{
+					Name:  "coordinator-v2plus-address",
+					Usage: "Address of the VRF V2Plus coordinator",
+				},
+				cli.Uint64Flag{
+					Name:     "from-block",
+					Usage:    "First block of the range to scan for requests",
+					Required: true,
+				},
+				cli.Uint64Flag{
+					Name:     "to-block",
+					Usage:    "Last block of the range to scan for requests",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "from-address",
+					Usage:    "Sending key used for the backfill transactions",
+					Required: true,
+				},
+				cli.UintFlag{
+					Name:  "get-blockhashes-batch-size",
+					Usage: "Number of blockhashes fetched per call when looking for a stored blockhash",
+					Value: 100,
+				},
+				cli.UintFlag{
+					Name:  "store-blockhashes-batch-size",
+					Usage: "Number of block headers stored per transaction",
+					Value: 10,
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "only report the coverage, without storing any blockhash",
+				},
+				cli.BoolFlag{
+					Name:  "yes, y",
+					Usage: "skip the confirmation prompt",
+				},
+			},
+		},
 	}
 }
 
@@ -140,3 +216,139 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+// BHSBackfillTaskPresenter implements TableRenderer for a BHSBackfillTaskResource.
+type BHSBackfillTaskPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.BHSBackfillTaskResource
+}
+
+// ToRow presents the BHSBackfillTaskResource as a slice of strings.
+func (p *BHSBackfillTaskPresenter) ToRow() []string {
+	anchor := ""
+	if p.AnchorBlock != nil {
+		anchor = strconv.FormatUint(*p.AnchorBlock, 10)
+	}
+	missing := make([]string, len(p.MissingBlocks))
+	for i, b := range p.MissingBlocks {
+		missing[i] = strconv.FormatUint(b, 10)
+	}
+	return []string{
+		p.ID,
+		p.State,
+		p.BHSAddress,
+		fmt.Sprintf("%d-%d", p.FromBlock, p.ToBlock),
+		strconv.FormatUint(p.LatestBlock, 10),
+		strconv.Itoa(p.UnfulfilledRequests),
+		fmt.Sprintf("%d/%d (%.2f%%)", p.StoredBlocks, p.RequestBlocks, p.Covered*100),
+		strings.Join(missing, ", "),
+		anchor,
+		strconv.Itoa(p.BlocksToStore),
+		strconv.Itoa(p.Batches),
+		strconv.FormatUint(p.EstimatedGas, 10),
+		strconv.Itoa(p.Enqueued),
+		p.Error,
+	}
+}
+
+// RenderTable implements TableRenderer
+// Just renders a single row
+func (p BHSBackfillTaskPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "State", "BHS Address", "Blocks", "Latest Block", "Unfulfilled Requests", "Covered", "Missing Blocks", "Anchor Block", "Blocks To Store", "Batches", "Estimated Gas", "Enqueued", "Error"}
+	renderList(headers, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+// bhsBackfillPollInterval is how often the status of a backfill task is polled.
+var bhsBackfillPollInterval = 2 * time.Second
+
+// BHSBackfill reports the blockhash store coverage of unfulfilled VRF requests in a block range,
+// then stores the missing blockhashes after confirmation. Both run as tasks on the node, which
+// are polled until they finish.
+func (s *Shell) BHSBackfill(c *cli.Context) (err error) {
+	req := web.BHSBackfillRequest{
+		EVMChainID:                ubig.NewI(c.Int64("evm-chain-id")),
+		BHSAddress:                common.HexToAddress(c.String("bhs-address")),
+		BatchBHSAddress:           common.HexToAddress(c.String("batch-bhs-address")),
+		FromBlock:                 c.Uint64("from-block"),
+		ToBlock:                   c.Uint64("to-block"),
+		FromAddress:               common.HexToAddress(c.String("from-address")),
+		GetBlockhashesBatchSize:   uint16(c.Uint("get-blockhashes-batch-size")),   //nolint:gosec // disable G115
+		StoreBlockhashesBatchSize: uint16(c.Uint("store-blockhashes-batch-size")), //nolint:gosec // disable G115
+		DryRun:                    true,
+	}
+	for flag, dst := range map[string]**common.Address{
+		"coordinator-v1-address":     &req.CoordinatorV1Address,
+		"coordinator-v2-address":     &req.CoordinatorV2Address,
+		"coordinator-v2plus-address": &req.CoordinatorV2PlusAddress,
+	} {
+		if c.IsSet(flag) {
+			addr := common.HexToAddress(c.String(flag))
+			*dst = &addr
+		}
+	}
+
+	report, err := s.runBHSBackfillTask(req)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if err = s.Render(report, "Blockhash store coverage"); err != nil {
+		return s.errorOut(err)
+	}
+	if report.State != string(blockheaderfeeder.BackfillTaskCompleted) || c.Bool("dry-run") || report.BlocksToStore == 0 {
+		return nil
+	}
+	if !confirmAction(c) {
+		return nil
+	}
+
+	req.DryRun = false
+	task, err := s.runBHSBackfillTask(req)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	return s.errorOut(s.Render(task, "Blockhash store backfill"))
+}
+
+// runBHSBackfillTask starts a backfill task on the node and waits for it to finish.
+func (s *Shell) runBHSBackfillTask(req web.BHSBackfillRequest) (*BHSBackfillTaskPresenter, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	task := &BHSBackfillTaskPresenter{}
+	if err = s.doBHSBackfillRequest(func() (*http.Response, error) {
+		return s.HTTP.Post(s.ctx(), "/v2/bhs/backfill", bytes.NewBuffer(b))
+	}, task); err != nil {
+		return nil, err
+	}
+
+	for task.State == string(blockheaderfeeder.BackfillTaskRunning) {
+		select {
+		case <-s.ctx().Done():
+			return nil, s.ctx().Err()
+		case <-time.After(bhsBackfillPollInterval):
+		}
+		if err = s.doBHSBackfillRequest(func() (*http.Response, error) {
+			return s.HTTP.Get(s.ctx(), "/v2/bhs/backfill/"+task.ID)
+		}, task); err != nil {
+			return nil, err
+		}
+	}
+	return task, nil
+}
+
+func (s *Shell) doBHSBackfillRequest(do func() (*http.Response, error), dst *BHSBackfillTaskPresenter) (err error) {
+	resp, err := do()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.deserializeAPIResponse(resp, dst, &jsonapi.Links{})
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store"
+	"github.com/smartcontractkit/chainlink/v2/core/store/migrate"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// pgDumpMagic starts every dump in the pg_dump custom format, which is the format of the backups
+// taken by the node.
+const pgDumpMagic = "PGDMP"
+
+func initDBRestoreSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:      "restore",
+		Usage:     "Restore a database backup into the empty database referred to by CL_DATABASE_URL env variable or by the Database.URL field in a secrets TOML config, migrate it to the latest version and verify that the node can use it.",
+		ArgsUsage: "<file>",
+		Action:    s.RestoreDatabase,
+		Before:    s.validateDB,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "text file holding the password of the restored keystore, defaults to the one of the secrets TOML config",
+			},
+		},
+	}
+}
+
+// RestoreDatabase restores a backup taken by pg_dump, like the ones of Database.Backup, into an
+// empty database. The backup is rejected if it was taken by a node with more migrations than
+// this binary. Once restored, the database is migrated forward and checked by decrypting the
+// keystore and loading all the jobs.
+func (s *Shell) RestoreDatabase(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("You must specify the backup file to restore"))
+	}
+	path := c.Args().First()
+	if c.IsSet("password") {
+		pwd, err := utils.PasswordFromFile(c.String("password"))
+		if err != nil {
+			return s.errorOut(fmt.Errorf("error reading password: %w", err))
+		}
+		s.Config.SetPasswords(&pwd, nil)
+	}
+
+	ctx := s.ctx()
+	cfg := s.Config
+	dbURL := cfg.Database().URL()
+	if dbURL.String() == "" {
+		return s.errorOut(errDBURLMissing)
+	}
+	lggr := logger.Sugared(s.Logger.Named("RestoreDatabase"))
+
+	if err := checkDumpFile(path); err != nil {
+		return s.errorOut(err)
+	}
+	dumpVersion, err := dumpMigrationVersion(ctx, path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	latest, err := migrate.Latest()
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if dumpVersion > latest {
+		return s.errorOut(errors.Errorf("backup was taken by a more recent version of the node: its database version is %d, but this binary only supports up to version %d", dumpVersion, latest))
+	}
+	lggr.Infof("Backup database version: %d, binary database version: %d", dumpVersion, latest)
+
+	db, err := store.NewConnection(ctx, cfg.Database())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error connecting to the database"))
+	}
+	defer lggr.ErrorIfFn(db.Close, "Error closing db")
+	if err = ensureEmptyDatabase(ctx, db); err != nil {
+		return s.errorOut(err)
+	}
+
+	lggr.Infof("Restoring %s into database: %s", path, dbURL.Redacted())
+	cmd := exec.CommandContext(ctx, "pg_restore", "--no-owner", "--no-privileges", "--single-transaction", "--exit-on-error", "--dbname", dbURL.String(), path)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return s.errorOut(errors.Wrapf(err, "pg_restore failed with output: %s", strings.TrimSpace(stderr.String())))
+	}
+
+	if err = migrate.SetMigrationENVVars(cfg.EVMConfigs()); err != nil {
+		return s.errorOut(err)
+	}
+	lggr.Info("Migrating the restored database")
+	if err = migrate.Migrate(ctx, db.DB); err != nil {
+		return s.errorOut(errors.Wrap(err, "error migrating the restored database"))
+	}
+
+	ds := sqlutil.WrapDataSource(db, lggr)
+	keyStore := keystore.New(ds, utils.GetScryptParams(cfg), lggr.Infof)
+	if err = keyStore.Unlock(ctx, cfg.Password().Keystore()); err != nil {
+		return s.errorOut(errors.Wrap(err, "restored keystore cannot be decrypted with the keystore password"))
+	}
+	jobORM := job.NewORM(ds, pipeline.NewORM(ds, lggr, cfg.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(ds), keyStore, lggr)
+	jobs, err := loadAllJobs(ctx, jobORM)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "restored jobs cannot be loaded"))
+	}
+
+	version, err := migrate.Current(ctx, db.DB)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Printf("Database restored successfully: database version %d, keystore decrypted, %d jobs loaded.\n", version, jobs)
+	return nil
+}
+
+// checkDumpFile checks that path is a dump in the pg_dump custom format.
+func checkDumpFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "error opening backup file")
+	}
+	defer f.Close()
+
+	header := make([]byte, len(pgDumpMagic))
+	if _, err = io.ReadFull(f, header); err != nil || string(header) != pgDumpMagic {
+		if strings.HasSuffix(path, ".age") {
+			return errors.Errorf("%s is encrypted, decrypt it first with: age -d -i <identity file> -o %s %s", path, strings.TrimSuffix(path, ".age"), path)
+		}
+		return errors.Errorf("%s is not a database backup in the pg_dump custom format", path)
+	}
+	return nil
+}
+
+// dumpMigrationVersion returns the most recent migration applied to the database of the dump at path.
+func dumpMigrationVersion(ctx context.Context, path string) (int64, error) {
+	cmd := exec.CommandContext(ctx, "pg_restore", "--data-only", "--table=goose_migrations", "--file=-", path)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, errors.Wrapf(err, "error reading the migrations of the backup, pg_restore failed with output: %s", strings.TrimSpace(stderr.String()))
+	}
+	return parseMigrationVersion(bytes.NewReader(out))
+}
+
+// parseMigrationVersion parses the COPY statement of goose_migrations in the SQL script output
+// by pg_restore, returning the most recent applied migration.
+func parseMigrationVersion(r io.Reader) (int64, error) {
+	scanner := bufio.NewScanner(r)
+	versionCol, appliedCol := -1, -1
+	var version int64
+	var found bool
+	for scanner.Scan() {
+		line := scanner.Text()
+		if versionCol < 0 {
+			// COPY public.goose_migrations (id, version_id, is_applied, tstamp) FROM stdin;
+			if !strings.HasPrefix(line, "COPY ") || !strings.Contains(line, "goose_migrations") {
+				continue
+			}
+			start, end := strings.Index(line, "("), strings.Index(line, ")")
+			if start < 0 || end < start {
+				return 0, errors.Errorf("unexpected COPY statement: %s", line)
+			}
+			for i, col := range strings.Split(line[start+1:end], ",") {
+				switch strings.TrimSpace(col) {
+				case "version_id":
+					versionCol = i
+				case "is_applied":
+					appliedCol = i
+				}
+			}
+			if versionCol < 0 || appliedCol < 0 {
+				return 0, errors.Errorf("unexpected COPY statement: %s", line)
+			}
+			continue
+		}
+		if line == `\.` {
+			break
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) <= max(versionCol, appliedCol) {
+			return 0, errors.Errorf("unexpected goose_migrations row: %s", line)
+		}
+		if fields[appliedCol] != "t" {
+			continue
+		}
+		v, err := strconv.ParseInt(fields[versionCol], 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unexpected goose_migrations version: %s", fields[versionCol])
+		}
+		version, found = max(version, v), true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, errors.New("backup has no applied migrations, it is not a backup of a node database")
+	}
+	return version, nil
+}
+
+// ensureEmptyDatabase checks that the database has no tables, so that restoring cannot mix the
+// backup with existing data.
+func ensureEmptyDatabase(ctx context.Context, ds sqlutil.DataSource) error {
+	var tables int
+	err := ds.GetContext(ctx, &tables, `SELECT count(*) FROM information_schema.tables WHERE table_schema NOT IN ('pg_catalog', 'information_schema')`)
+	if err != nil {
+		return errors.Wrap(err, "error checking that the database is empty")
+	}
+	if tables > 0 {
+		return errors.Errorf("database is not empty (%d tables found): backups can only be restored into an empty database", tables)
+	}
+	return nil
+}
+
+func loadAllJobs(ctx context.Context, orm job.ORM) (int, error) {
+	const pageSize = 100
+	var loaded int
+	for {
+		jobs, count, err := orm.FindJobs(ctx, loaded, pageSize)
+		if err != nil {
+			return loaded, err
+		}
+		loaded += len(jobs)
+		if len(jobs) == 0 || loaded >= count {
+			return loaded, nil
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package cmd
 
-import "github.com/smartcontractkit/chainlink/v2/core/logger"
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
 
 // CheckRemoteBuildCompatibility exposes checkRemoteBuildCompatibility for testing.
 func (s *Shell) CheckRemoteBuildCompatibility(lggr logger.Logger, onlyWarn bool, cliVersion, cliSha string) error {
@@ -11,3 +14,8 @@ func (s *Shell) CheckRemoteBuildCompatibility(lggr logger.Logger, onlyWarn bool,
 func (s *Shell) ConfigV2Str(userOnly bool) (string, error) {
 	return s.configV2Str(userOnly)
 }
+
+// ListAllJobs exposes listAllJobs for testing.
+func (s *Shell) ListAllJobs(pageSize int) ([]presenters.JobResource, error) {
+	return s.listAllJobs(pageSize)
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initJobLogLevelsSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "job-loglevels",
+		Usage: "Override the log level of a job, or of all the jobs of a type",
+		Subcommands: []cli.Command{
+			{
+				Name:   "list",
+				Usage:  "Lists the log level overrides which have not expired",
+				Action: s.ListJobLogLevels,
+			},
+			{
+				Name:   "set",
+				Usage:  "Set the log level of a job or job type, replacing its current override",
+				Action: s.SetJobLogLevel,
+				Flags: []cli.Flag{
+					cli.IntFlag{
+						Name:  "job-id",
+						Usage: "ID of the job",
+					},
+					cli.StringFlag{
+						Name:  "job-type",
+						Usage: "type of the jobs, e.g. offchainreporting2",
+					},
+					cli.StringFlag{
+						Name:  "level",
+						Usage: "log level of the job or job type (debug||info||warn||error)",
+					},
+					cli.StringFlag{
+						Name:  "duration",
+						Usage: "duration after which the override expires, e.g. 1h. If unset, it is kept until cleared",
+					},
+				},
+			},
+			{
+				Name:   "clear",
+				Usage:  "Clear a log level override, restoring the global log level for its job or job type",
+				Action: s.ClearJobLogLevel,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "id",
+						Usage: "ID of the override, as listed by 'config job-loglevels list'",
+					},
+				},
+			},
+		},
+	}
+}
+
+type LogLevelOverridePresenter struct {
+	JAID
+	presenters.LogLevelOverrideResource
+}
+
+var logLevelOverridesTableHeaders = []string{"ID", "Job ID", "Job type", "Level", "Expires at"}
+
+func (p *LogLevelOverridePresenter) ToRow() []string {
+	var jobID, jobType, expiresAt string
+	if p.JobID != nil {
+		jobID = strconv.FormatInt(int64(*p.JobID), 10)
+	}
+	if p.JobType != nil {
+		jobType = *p.JobType
+	}
+	if p.ExpiresAt != nil {
+		expiresAt = p.ExpiresAt.String()
+	}
+	return []string{p.ID, jobID, jobType, p.Level, expiresAt}
+}
+
+// RenderTable implements TableRenderer
+func (p *LogLevelOverridePresenter) RenderTable(rt RendererTable) error {
+	renderList(logLevelOverridesTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type LogLevelOverridePresenters []LogLevelOverridePresenter
+
+// RenderTable implements TableRenderer
+func (ps LogLevelOverridePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Job log level overrides\n")); err != nil {
+		return err
+	}
+	renderList(logLevelOverridesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListJobLogLevels renders the log level overrides of jobs and job types
+func (s *Shell) ListJobLogLevels(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/log/jobs", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogLevelOverridePresenters{})
+}
+
+// SetJobLogLevel overrides the log level of a job or job type
+func (s *Shell) SetJobLogLevel(c *cli.Context) (err error) {
+	request := web.JobLogLevelRequest{Level: c.String("level"), Duration: c.String("duration")}
+	if c.IsSet("job-id") {
+		jobID := int32(c.Int("job-id"))
+		request.JobID = &jobID
+	}
+	if c.IsSet("job-type") {
+		jobType := c.String("job-type")
+		request.JobType = &jobType
+	}
+	if (request.JobID == nil) == (request.JobType == nil) {
+		return s.errorOut(errors.New("one of --job-id or --job-type must be set"))
+	}
+	if request.Level == "" {
+		return s.errorOut(errors.New("--level must be set"))
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/log/jobs", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogLevelOverridePresenter{}, "Job log level override set")
+}
+
+// ClearJobLogLevel clears the log level override with the given ID
+func (s *Shell) ClearJobLogLevel(c *cli.Context) (err error) {
+	id := c.String("id")
+	if id == "" {
+		return s.errorOut(errors.New("--id must be set"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/log/jobs/"+url.PathEscape(id))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	_, err = s.parseResponse(resp)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Job log level override %s cleared\n", id)
+	return nil
+}
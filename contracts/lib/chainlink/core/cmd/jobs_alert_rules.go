@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// jobAlertRulesPageSize is the number of jobs requested per page when listing all the jobs.
+const jobAlertRulesPageSize = 100
+
+// jobMetricLabels are the labels of the job metrics, kept when aggregating them in alerting rules.
+const jobMetricLabels = "job_id, job_name, job_type, external_job_id"
+
+// PrometheusRuleGroups is a Prometheus rules file.
+type PrometheusRuleGroups struct {
+	Groups []PrometheusRuleGroup `yaml:"groups"`
+}
+
+type PrometheusRuleGroup struct {
+	Name  string           `yaml:"name"`
+	Rules []PrometheusRule `yaml:"rules"`
+}
+
+type PrometheusRule struct {
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// NewJobAlertRules returns the alerting rules of the jobs which declare an expected run interval. A job is overdue
+// when it has not run successfully for factor expected run intervals. If minSuccessRate is positive, a job also
+// alerts when less than this ratio of its runs succeeded over its last ten expected run intervals.
+func NewJobAlertRules(jobs []presenters.JobResource, factor, minSuccessRate float64) PrometheusRuleGroups {
+	group := PrometheusRuleGroup{Name: "chainlink-jobs", Rules: []PrometheusRule{}}
+	for _, jb := range jobs {
+		interval := jb.ExpectedRunInterval.Duration()
+		if interval <= 0 {
+			continue
+		}
+		selector := fmt.Sprintf(`external_job_id="%s"`, jb.ExternalJobID)
+		name := jb.Name
+		if name == "" {
+			name = jb.ExternalJobID.String()
+		}
+
+		overdue := time.Duration(factor * float64(interval)).Round(time.Second)
+		group.Rules = append(group.Rules, PrometheusRule{
+			Alert:  "ChainlinkJobRunOverdue",
+			Expr:   fmt.Sprintf("job_seconds_since_last_successful_run{%s} > %g", selector, overdue.Seconds()),
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary": fmt.Sprintf("Job %s has not run successfully for more than %s", name, overdue),
+			},
+		})
+
+		if minSuccessRate > 0 {
+			window := max(10*interval, 5*time.Minute).Round(time.Second)
+			group.Rules = append(group.Rules, PrometheusRule{
+				Alert: "ChainlinkJobSuccessRateLow",
+				Expr: fmt.Sprintf(`sum by (%[1]s) (rate(job_runs_total{%[2]s,status="completed"}[%[3]ds])) / sum by (%[1]s) (rate(job_runs_total{%[2]s}[%[3]ds])) < %[4]g`,
+					jobMetricLabels, selector, int64(window.Seconds()), minSuccessRate),
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf("Less than %g%% of the runs of job %s succeeded over the last %s", minSuccessRate*100, name, window),
+				},
+			})
+		}
+	}
+	return PrometheusRuleGroups{Groups: []PrometheusRuleGroup{group}}
+}
+
+// JobAlertRules prints the Prometheus alerting rules of the jobs which declare an expected run interval
+func (s *Shell) JobAlertRules(c *cli.Context) (err error) {
+	factor, minSuccessRate := c.Float64("factor"), c.Float64("min-success-rate")
+	if factor <= 0 {
+		return s.errorOut(stderrors.New("--factor must be positive"))
+	}
+	if minSuccessRate < 0 || minSuccessRate > 1 {
+		return s.errorOut(stderrors.New("--min-success-rate must be between 0 and 1"))
+	}
+
+	resources, err := s.listAllJobs(jobAlertRulesPageSize)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var b strings.Builder
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err = enc.Encode(NewJobAlertRules(resources, factor, minSuccessRate)); err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Print(b.String())
+	return nil
+}
+
+// listAllJobs lists the jobs page by page, until a page is not full.
+func (s *Shell) listAllJobs(pageSize int) ([]presenters.JobResource, error) {
+	var resources []presenters.JobResource
+	for page := 1; ; page++ {
+		jobs, err := s.listJobsPage(pageSize, page)
+		if err != nil {
+			return nil, err
+		}
+		if len(jobs) > pageSize {
+			return nil, fmt.Errorf("expected at most %d jobs in page %d, got %d", pageSize, page, len(jobs))
+		}
+		for _, jb := range jobs {
+			resources = append(resources, jb.JobResource)
+		}
+		if len(jobs) < pageSize {
+			return resources, nil
+		}
+	}
+}
+
+func (s *Shell) listJobsPage(size, page int) (jobs JobPresenters, err error) {
+	q := url.Values{}
+	q.Set("size", strconv.Itoa(size))
+	q.Set("page", strconv.Itoa(page))
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs?"+q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	err = s.deserializeAPIResponse(resp, &jobs, &jsonapi.Links{})
+	return jobs, err
+}
@@ -50,6 +50,22 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "alert-rules",
+			Usage:  "Print Prometheus alerting rules for the jobs which declare an expected run interval",
+			Action: s.JobAlertRules,
+			Flags: []cli.Flag{
+				cli.Float64Flag{
+					Name:  "factor",
+					Usage: "alert when a job has not run successfully for this many expected run intervals",
+					Value: 2,
+				},
+				cli.Float64Flag{
+					Name:  "min-success-rate",
+					Usage: "if set, also alert when less than this ratio of the runs of a job succeeded over its last ten expected run intervals",
+				},
+			},
+		},
 	}
 }
 
@@ -140,6 +156,10 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 		if p.CronSpec != nil {
 			return p.CronSpec.CreatedAt.Format(time.RFC3339)
 		}
+	case presenters.EVMLogJobSpec:
+		if p.EVMLogSpec != nil {
+			return p.EVMLogSpec.CreatedAt.Format(time.RFC3339)
+		}
 	case presenters.VRFJobSpec:
 		if p.VRFSpec != nil {
 			return p.VRFSpec.CreatedAt.Format(time.RFC3339)
@@ -2,9 +2,14 @@ package cmd_test
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
 	"flag"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
@@ -22,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -459,3 +465,90 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestNewJobAlertRules(t *testing.T) {
+	t.Parallel()
+
+	externalJobID := uuid.MustParse("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46")
+	jobs := []presenters.JobResource{
+		{Name: "eth-usd", ExternalJobID: externalJobID, ExpectedRunInterval: models.Interval(time.Minute)},
+		{Name: "no interval", ExternalJobID: uuid.New()},
+	}
+
+	rules := cmd.NewJobAlertRules(jobs, 2.5, 0)
+	require.Len(t, rules.Groups, 1)
+	require.Len(t, rules.Groups[0].Rules, 1)
+	overdue := rules.Groups[0].Rules[0]
+	assert.Equal(t, "ChainlinkJobRunOverdue", overdue.Alert)
+	assert.Equal(t, `job_seconds_since_last_successful_run{external_job_id="0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"} > 150`, overdue.Expr)
+	assert.Equal(t, "Job eth-usd has not run successfully for more than 2m30s", overdue.Annotations["summary"])
+
+	rules = cmd.NewJobAlertRules(jobs, 2, 0.9)
+	require.Len(t, rules.Groups[0].Rules, 2)
+	successRate := rules.Groups[0].Rules[1]
+	assert.Equal(t, "ChainlinkJobSuccessRateLow", successRate.Alert)
+	assert.Equal(t, `sum by (job_id, job_name, job_type, external_job_id) (rate(job_runs_total{external_job_id="0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",status="completed"}[600s])) / `+
+		`sum by (job_id, job_name, job_type, external_job_id) (rate(job_runs_total{external_job_id="0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"}[600s])) < 0.9`, successRate.Expr)
+	assert.Equal(t, "Less than 90% of the runs of job eth-usd succeeded over the last 10m0s", successRate.Annotations["summary"])
+}
+
+// pagedJobsHTTPClient serves the jobs page by page, like the jobs controller.
+type pagedJobsHTTPClient struct {
+	cmd.HTTPClient
+	jobs  []presenters.JobResource
+	pages []string
+}
+
+func (h *pagedJobsHTTPClient) Get(ctx context.Context, path string, headers ...map[string]string) (*http.Response, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	size, err := strconv.Atoi(u.Query().Get("size"))
+	if err != nil {
+		return nil, err
+	}
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return nil, err
+	}
+	h.pages = append(h.pages, u.Query().Get("page"))
+	lo, hi := min((page-1)*size, len(h.jobs)), min(page*size, len(h.jobs))
+	b, err := web.NewPaginatedResponse(*u, size, page, len(h.jobs), append([]presenters.JobResource{}, h.jobs[lo:hi]...))
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(b))}, nil
+}
+
+func TestShell_ListAllJobs(t *testing.T) {
+	t.Parallel()
+
+	jobs := make([]presenters.JobResource, 5)
+	for i := range jobs {
+		jobs[i] = presenters.JobResource{JAID: presenters.NewJAIDInt32(int32(i + 1)), Name: fmt.Sprintf("job %d", i+1), ExternalJobID: uuid.New()}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		jobs  []presenters.JobResource
+		pages []string
+	}{
+		{"no jobs", nil, []string{"1"}},
+		{"last page not full", jobs, []string{"1", "2", "3"}},
+		{"last page full", jobs[:4], []string{"1", "2", "3"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &pagedJobsHTTPClient{jobs: tc.jobs}
+			shell := &cmd.Shell{HTTP: client}
+			got, err := shell.ListAllJobs(2)
+			require.NoError(t, err)
+			assert.Equal(t, tc.pages, client.pages)
+			require.Len(t, got, len(tc.jobs))
+			for i, jb := range got {
+				assert.Equal(t, tc.jobs[i].Name, jb.Name)
+				assert.Equal(t, tc.jobs[i].ExternalJobID, jb.ExternalJobID)
+			}
+		})
+	}
+}
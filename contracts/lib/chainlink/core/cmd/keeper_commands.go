@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeeperSubCmds(s *Shell) []cli.Command {
+	jobFlag := cli.StringFlag{
+		Name:  "job, j",
+		Usage: "ID of the keeper job",
+	}
+	return []cli.Command{
+		{
+			Name:  "upkeeps",
+			Usage: "Commands for inspecting the upkeeps of keeper jobs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the upkeeps of a keeper job with their last check and turn eligibility",
+					Action: s.ListKeeperUpkeeps,
+					Flags:  []cli.Flag{jobFlag},
+				},
+				{
+					Name:   "check",
+					Usage:  "Simulate a check of an upkeep without sending a transaction",
+					Action: s.CheckKeeperUpkeep,
+					Flags: []cli.Flag{
+						jobFlag,
+						cli.Int64Flag{
+							Name:  "block, b",
+							Usage: "block number to check at, defaults to the latest head",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type KeeperUpkeepPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.KeeperUpkeepResource
+}
+
+var keeperUpkeepHeaders = []string{"Upkeep ID", "Registry", "Last Run Block", "Last Keeper Index", "Eligible", "Eligibility", "Last Checked Block", "Performable", "Gas Limit", "Last Error"}
+
+// ToRow presents the KeeperUpkeepResource as a slice of strings.
+func (p *KeeperUpkeepPresenter) ToRow() []string {
+	lastKeeperIndex := ""
+	if p.LastKeeperIndex != nil {
+		lastKeeperIndex = strconv.FormatInt(*p.LastKeeperIndex, 10)
+	}
+	lastChecked, performable, gasLimit, lastError := "", "", "", ""
+	if p.LastCheck != nil {
+		lastChecked = strconv.FormatInt(p.LastCheck.BlockNumber, 10)
+		performable = strconv.FormatBool(p.LastCheck.Performable)
+		gasLimit = formatOptionalInt64(p.LastCheck.GasLimit)
+		if p.LastCheck.Error != nil {
+			lastError = *p.LastCheck.Error
+		}
+	}
+	return []string{
+		p.PrettyID,
+		p.RegistryAddress,
+		strconv.FormatInt(p.LastRunBlockHeight, 10),
+		lastKeeperIndex,
+		strconv.FormatBool(p.Eligible),
+		p.EligibilityReason,
+		lastChecked,
+		performable,
+		gasLimit,
+		lastError,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *KeeperUpkeepPresenter) RenderTable(rt RendererTable) error {
+	renderList(keeperUpkeepHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// KeeperUpkeepPresenters implements TableRenderer for a slice of KeeperUpkeepPresenter.
+type KeeperUpkeepPresenters []KeeperUpkeepPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeeperUpkeepPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(keeperUpkeepHeaders, rows, rt.Writer)
+	return nil
+}
+
+type KeeperUpkeepCheckPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.KeeperUpkeepCheckResource
+}
+
+var keeperUpkeepCheckHeaders = []string{"Upkeep ID", "Block", "Turn Block Hash (binary)", "Eligible", "Eligibility", "Performable", "Perform Data", "Gas Limit", "Gas Estimate", "Error", "Checked At"}
+
+// ToRow presents the KeeperUpkeepCheckResource as a slice of strings.
+func (p *KeeperUpkeepCheckPresenter) ToRow() []string {
+	eligible, checkErr := "", ""
+	if p.Eligible != nil {
+		eligible = strconv.FormatBool(*p.Eligible)
+	}
+	if p.Error != nil {
+		checkErr = *p.Error
+	}
+	return []string{
+		p.GetID(),
+		strconv.FormatInt(p.BlockNumber, 10),
+		p.TurnBlockHashBinary,
+		eligible,
+		p.EligibilityReason,
+		strconv.FormatBool(p.Performable),
+		p.PerformData.String(),
+		formatOptionalInt64(p.GasLimit),
+		formatOptionalInt64(p.GasEstimate),
+		checkErr,
+		p.CheckedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *KeeperUpkeepCheckPresenter) RenderTable(rt RendererTable) error {
+	renderList(keeperUpkeepCheckHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+func formatOptionalInt64(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+// ListKeeperUpkeeps lists the upkeeps of a keeper job
+func (s *Shell) ListKeeperUpkeeps(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	if jobID == "" {
+		return s.errorOut(errors.New("must pass the keeper job ID with --job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/jobs/%s/upkeeps", url.PathEscape(jobID)))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &KeeperUpkeepPresenters{})
+}
+
+// CheckKeeperUpkeep runs a simulated check of a single upkeep of a keeper job
+func (s *Shell) CheckKeeperUpkeep(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	if jobID == "" {
+		return s.errorOut(errors.New("must pass the keeper job ID with --job"))
+	}
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the upkeep ID to be checked"))
+	}
+
+	uri := fmt.Sprintf("/v2/jobs/%s/upkeeps/%s/check", url.PathEscape(jobID), url.PathEscape(c.Args().First()))
+	if c.IsSet("block") {
+		uri += "?block=" + strconv.FormatInt(c.Int64("block"), 10)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), uri, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &KeeperUpkeepCheckPresenter{}, "Simulated upkeep check")
+}
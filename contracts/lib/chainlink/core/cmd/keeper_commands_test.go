@@ -0,0 +1,92 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeeperUpkeepPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer          = bytes.NewBufferString("")
+		r               = cmd.RendererTable{Writer: buffer}
+		lastKeeperIndex = int64(2)
+		gasLimit        = int64(150000)
+		checkErr        = "execution reverted"
+	)
+
+	p := cmd.KeeperUpkeepPresenter{
+		KeeperUpkeepResource: presenters.KeeperUpkeepResource{
+			JAID:               presenters.NewJAID("1"),
+			PrettyID:           "UPx0000000000000000000000000000000000000000000000000000000000000001",
+			RegistryAddress:    "0x1111111111111111111111111111111111111111",
+			LastRunBlockHeight: 90,
+			LastKeeperIndex:    &lastKeeperIndex,
+			BlockNumber:        100,
+			Eligible:           true,
+			EligibilityReason:  "my turn",
+			LastCheck: &presenters.KeeperUpkeepCheck{
+				BlockNumber: 99,
+				GasLimit:    &gasLimit,
+				Error:       &checkErr,
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, p.PrettyID)
+	assert.Contains(t, output, p.RegistryAddress)
+	assert.Contains(t, output, "my turn")
+	assert.Contains(t, output, "150000")
+	assert.Contains(t, output, checkErr)
+
+	buffer.Reset()
+	ps := cmd.KeeperUpkeepPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+	assert.Contains(t, buffer.String(), p.PrettyID)
+}
+
+func TestKeeperUpkeepCheckPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer      = bytes.NewBufferString("")
+		r           = cmd.RendererTable{Writer: buffer}
+		eligible    = false
+		gasEstimate = int64(84000)
+		checkedAt   = time.Now()
+	)
+
+	p := cmd.KeeperUpkeepCheckPresenter{
+		JAID: cmd.NewJAID("1"),
+		KeeperUpkeepCheckResource: presenters.KeeperUpkeepCheckResource{
+			KeeperUpkeepCheck: presenters.KeeperUpkeepCheck{
+				BlockNumber:         123,
+				Performable:         true,
+				PerformData:         []byte{0xab},
+				GasEstimate:         &gasEstimate,
+				TurnBlockHashBinary: "1011",
+				Eligible:            &eligible,
+				EligibilityReason:   "buddy's turn",
+				CheckedAt:           checkedAt,
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "123")
+	assert.Contains(t, output, "0xab")
+	assert.Contains(t, output, "84000")
+	assert.Contains(t, output, "buddy's turn")
+	assert.Contains(t, output, checkedAt.Format(time.RFC3339))
+}
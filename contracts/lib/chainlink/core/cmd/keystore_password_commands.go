@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func initKeysRotatePasswordSubCmd(s *Shell, opts *chainlink.GeneralConfigOpts) cli.Command {
+	return cli.Command{
+		Name:   "rotate-password",
+		Usage:  "Re-encrypt all keys with a new keystore password. Must be run locally, while the node is stopped",
+		Action: s.RotateKeystorePassword,
+		Before: func(c *cli.Context) error {
+			cfg, err := initServerConfig(opts, s.configFiles, s.secretsFiles)
+			if err != nil {
+				return err
+			}
+			s.Config = cfg
+			return nil
+		},
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "text file holding the current keystore password, defaults to the one of the secrets TOML config",
+			},
+			cli.StringFlag{
+				Name:     "new-password",
+				Usage:    "text file holding the new keystore password",
+				Required: true,
+			},
+			cli.DurationFlag{
+				Name:  "backup-retention",
+				Usage: "how long the key ring encrypted with the current password is kept, after which it is purged",
+				Value: 7 * 24 * time.Hour,
+			},
+		},
+	}
+}
+
+// RotateKeystorePassword re-encrypts the key ring with a new password, backing up the key ring
+// encrypted with the current one until the backup retention elapses. The node's lease lock is held, so that a running node cannot
+// keep encrypting keys with the current password.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) error {
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error reading new password: %w", err))
+	}
+	if err = utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return s.errorOut(fmt.Errorf("new password is not strong enough: %w", err))
+	}
+	if c.IsSet("password") {
+		pwd, err2 := utils.PasswordFromFile(c.String("password"))
+		if err2 != nil {
+			return s.errorOut(fmt.Errorf("error reading password: %w", err2))
+		}
+		s.Config.SetPasswords(&pwd, nil)
+	}
+
+	cfg := s.Config
+	if err = cfg.Validate(); err != nil {
+		return s.errorOut(fmt.Errorf("error validating configuration: %w", err))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RotateKeystorePassword"))
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go shutdown.HandleShutdown(func(sig string) {
+		cancel()
+		lggr.Info("received signal to stop - closing the database and releasing lock")
+
+		if cErr := ldb.Close(); cErr != nil {
+			lggr.Criticalf("Failed to close LockedDB: %v", cErr)
+		}
+
+		if cErr := s.CloseLogger(); cErr != nil {
+			log.Printf("Failed to close Logger: %v", cErr)
+		}
+	})
+
+	if err = ldb.Open(ctx); err != nil {
+		// If not successful, we know neither locks nor connection remains opened
+		return s.errorOut(errors.Wrap(err, "opening db"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	retention := c.Duration("backup-retention")
+	backupID, err := keystore.RotatePassword(ctx, ldb.DB(), cfg.Password().Keystore(), newPassword, utils.GetScryptParams(cfg), retention)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error rotating keystore password"))
+	}
+
+	fmt.Printf("Keystore password rotated, the previous key ring is backed up as encrypted_key_ring_backups %d until %s.\n",
+		backupID, time.Now().Add(retention).Format(time.RFC3339))
+	fmt.Println("Update the keystore password in the secrets config before starting the node.")
+	return nil
+}
@@ -9,9 +9,11 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	ossignal "os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -33,6 +35,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -215,6 +218,7 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Before: s.validateDB,
 					Flags:  []cli.Flag{},
 				},
+				initDBRestoreSubCmd(s),
 				{
 					Name:   "create-migration",
 					Usage:  "Create a new migration.",
@@ -270,6 +274,8 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		initAuditLogSubCmds(s),
+		initSupportBundleSubCmd(s),
 	}
 }
 
@@ -417,6 +423,18 @@ func (s *Shell) runNode(c *cli.Context) error {
 				lggr.Debugf("AutoCreateKey=false, will not ensure EVM key for chain %s", id)
 			}
 		}
+
+		if s.Config.RemoteSigner().Enabled() {
+			var chainIDs []*big.Int
+			for _, cs := range s.Config.EVMConfigs() {
+				chainIDs = append(chainIDs, cs.ChainID.ToInt())
+			}
+			lggr.Debugf("Remote signer enabled, will ensure its EVM keys for chains %v", chainIDs)
+			err2 := app.GetKeyStore().Eth().EnsureRemoteKeys(rootCtx, chainIDs...)
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to ensure remote signer keys")
+			}
+		}
 	}
 
 	if s.Config.OCR().Enabled() {
@@ -565,6 +583,8 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return nil
 	})
 
+	go handleReloadSignal(grpCtx, app, lggr)
+
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
 	grp.Go(func() error {
@@ -581,6 +601,25 @@ func (s *Shell) runNode(c *cli.Context) error {
 	return grp.Wait()
 }
 
+// handleReloadSignal reloads the configuration of the app on every SIGHUP, until ctx is cancelled.
+func handleReloadSignal(ctx context.Context, app chainlink.Application, lggr logger.Logger) {
+	ch := make(chan os.Signal, 1)
+	ossignal.Notify(ch, syscall.SIGHUP)
+	defer ossignal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			lggr.Info("Reloading configuration due to SIGHUP signal received...")
+			if _, err := app.ReloadConfig(ctx); err != nil {
+				lggr.Errorw("Failed to reload configuration, keeping the running configuration", "err", err)
+			}
+		}
+	}
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure tls sub directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
@@ -1,10 +1,13 @@
 package cmd_test
 
 import (
+	"bytes"
 	"errors"
 	"flag"
 	"math/big"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -96,7 +99,7 @@ func TestShell_RunNodeWithPasswords(t *testing.T) {
 			})
 			db := pgtest.NewSqlxDB(t)
 			keyStore := cltest.NewKeyStore(t, db)
-			authProviderORM := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger)
+			authProviderORM := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger, localauth.LockoutConfig{})
 
 			testRelayers := genTestEVMRelayers(t, cfg, db, keyStore.Eth(), &keystore.CSASigner{CSA: keyStore.CSA()})
 
@@ -177,7 +180,7 @@ func TestShell_RunNodeWithAPICredentialsFile(t *testing.T) {
 				c.Insecure.OCRDevelopmentMode = nil
 			})
 			db := pgtest.NewSqlxDB(t)
-			authProviderORM := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger)
+			authProviderORM := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger, localauth.LockoutConfig{})
 
 			// Clear out fixture users/users created from the other test cases
 			// This asserts that on initial run with an empty users table that the credentials file will instantiate and
@@ -508,6 +511,70 @@ func TestShell_CleanupChainTables(t *testing.T) {
 	require.NoError(t, client.CleanupChainTables(c))
 }
 
+func TestShell_RestoreDatabase(t *testing.T) {
+	sourceCfg, _ := heavyweight.FullTestDBV2(t, nil)
+	dump := filepath.Join(t.TempDir(), "cl_backup_test.dump")
+	sourceURL := sourceCfg.Database().URL()
+	out, err := exec.Command("pg_dump", sourceURL.String(), "-f", dump, "-F", "c").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	config, _ := heavyweight.FullTestDBEmptyV2(t, nil)
+	client := cmd.Shell{
+		Config: config,
+		Logger: logger.TestLogger(t),
+	}
+	restore := func(path string) error {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.RestoreDatabase, set, "")
+		require.NoError(t, set.Parse([]string{path}))
+		return client.RestoreDatabase(cli.NewContext(nil, set, nil))
+	}
+
+	notADump := filepath.Join(t.TempDir(), "cl_backup_test.dump.age")
+	require.NoError(t, os.WriteFile(notADump, []byte("age-encryption.org/v1"), 0600))
+	require.ErrorContains(t, restore(notADump), "is encrypted, decrypt it first")
+
+	require.NoError(t, restore(dump))
+	require.ErrorContains(t, restore(dump), "database is not empty")
+}
+
+func TestShell_VerifyAuditLog(t *testing.T) {
+	dir := t.TempDir()
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.AuditLogger.Enabled = ptr(true)
+		c.AuditLogger.File.Enabled = ptr(true)
+		c.AuditLogger.File.Dir = ptr(dir)
+		s.AuditLogger.FileChainKey = models.NewSecret("audit-chain-key-0123456789abcdef0123")
+	})
+	lggr := logger.TestLogger(t)
+
+	auditLogger, err := audit.NewAuditLogger(lggr, cfg.AuditLogger())
+	require.NoError(t, err)
+	require.NoError(t, auditLogger.Start(testutils.Context(t)))
+	auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": "alice@example.com"})
+	auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": "bob@example.com"})
+	require.NoError(t, auditLogger.Close())
+
+	client := cmd.Shell{
+		Config: cfg,
+		Logger: lggr,
+	}
+	verify := func(args ...string) error {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.VerifyAuditLog, set, "")
+		require.NoError(t, set.Parse(args))
+		return client.VerifyAuditLog(cli.NewContext(nil, set, nil))
+	}
+	require.NoError(t, verify())
+
+	path := filepath.Join(dir, audit.CurrentFileName)
+	require.NoError(t, verify(path))
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bytes.Replace(b, []byte("bob@"), []byte("eve@"), 1), 0600))
+	require.ErrorContains(t, verify(), "line 2: record hash mismatch")
+}
+
 func TestShell_RemoveBlocks(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
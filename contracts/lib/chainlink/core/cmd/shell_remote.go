@@ -21,6 +21,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -43,6 +44,11 @@ func initRemoteConfigSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "reload",
+			Usage:  "Reload the configuration files, applying the changes which do not require a restart",
+			Action: s.ReloadConfig,
+		},
 		{
 			Name:   "loglevel",
 			Usage:  "Set log level",
@@ -54,6 +60,7 @@ func initRemoteConfigSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		initJobLogLevelsSubCmd(s),
 		{
 			Name:   "logsql",
 			Usage:  "Enable/disable SQL statement logging",
@@ -338,6 +345,44 @@ func (s *Shell) configV2Str(userOnly bool) (string, error) {
 	return configV2Resource.Config, nil
 }
 
+// ReloadConfig reloads the configuration of the node, and reports the changes which require a restart
+func (s *Shell) ReloadConfig(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/config/reload", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ConfigReloadPresenter{})
+}
+
+// ConfigReloadPresenter implements TableRenderer for a ConfigReloadResource
+type ConfigReloadPresenter struct {
+	webpresenters.ConfigReloadResource
+}
+
+// RenderTable implements TableRenderer
+func (p *ConfigReloadPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, key := range p.Applied {
+		rows = append(rows, []string{key, "applied"})
+	}
+	for _, key := range p.RestartRequired {
+		rows = append(rows, []string{key, "restart required"})
+	}
+
+	if _, err := rt.Write([]byte("Configuration reloaded\n")); err != nil {
+		return err
+	}
+	renderList([]string{"Key", "Status"}, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
 func normalizePassword(password string) string {
 	return url.QueryEscape(strings.TrimSpace(password))
 }
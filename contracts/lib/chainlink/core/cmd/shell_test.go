@@ -165,7 +165,7 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			ctx := testutils.Context(t)
 			db := pgtest.NewSqlxDB(t)
 			lggr := logger.TestLogger(t)
-			orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+			orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger, localauth.LockoutConfig{})
 
 			mock := &cltest.MockCountingPrompter{T: t, EnteredStrings: test.enteredStrings, NotTerminal: !test.isTerminal}
 			tai := cmd.NewPromptingAPIInitializer(mock)
@@ -196,7 +196,7 @@ func TestTerminalAPIInitializer_InitializeWithExistingAPIUser(t *testing.T) {
 	ctx := testutils.Context(t)
 	db := pgtest.NewSqlxDB(t)
 	lggr := logger.TestLogger(t)
-	orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+	orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger, localauth.LockoutConfig{})
 
 	// Clear out fixture users/users created from the other test cases
 	// This asserts that on initial run with an empty users table that the credentials file will instantiate and
@@ -234,7 +234,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			ctx := testutils.Context(t)
 			db := pgtest.NewSqlxDB(t)
 			lggr := logger.TestLogger(t)
-			orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+			orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger, localauth.LockoutConfig{})
 
 			// Clear out fixture users/users created from the other test cases
 			// This asserts that on initial run with an empty users table that the credentials file will instantiate and
@@ -258,7 +258,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 
 func TestFileAPIInitializer_InitializeWithExistingAPIUser(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
-	orm := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger)
+	orm := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger, localauth.LockoutConfig{})
 
 	tests := []struct {
 		name      string
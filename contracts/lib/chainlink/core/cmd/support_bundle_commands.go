@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/supportbundle"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func initSupportBundleSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:   "support-bundle",
+		Usage:  "Gather the effective configuration, recent logs, job summaries and recent errored runs of the node, with their credentials redacted, and its health report, profiles and build info into a zip archive, to troubleshoot it. Downloaded from the running node, which can be remote with --remote-node-url, or gathered on this machine with --local.",
+		Action: s.SupportBundle,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "output, o",
+				Usage: "path of the zip archive to write",
+				Value: "support-bundle.zip",
+			},
+			cli.BoolFlag{
+				Name:  "local",
+				Usage: "gather the bundle from the configuration, logs, database and profiles on this machine, for when the node is not running. The health report is not included",
+			},
+		},
+	}
+}
+
+// SupportBundle writes a support bundle of the node to a zip archive.
+func (s *Shell) SupportBundle(c *cli.Context) (err error) {
+	output := c.String("output")
+	f, err := os.Create(output)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	wc := utils.NewDeferableWriteCloser(f)
+	defer wc.Close()
+
+	if c.Bool("local") {
+		err = s.writeLocalSupportBundle(s.ctx(), wc)
+	} else {
+		err = s.downloadSupportBundle(wc)
+	}
+	if err != nil {
+		return s.errorOut(errors.Join(err, os.Remove(output)))
+	}
+	if err = wc.Close(); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Support bundle written to %s\n", output)
+	return nil
+}
+
+// downloadSupportBundle downloads the support bundle of the running node to w.
+func (s *Shell) downloadSupportBundle(w io.Writer) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/support_bundle")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		if _, err = s.parseResponse(resp); err != nil {
+			return err
+		}
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// writeLocalSupportBundle gathers the support bundle from this machine to w. The jobs and runs are read from the
+// database when it is reachable.
+func (s *Shell) writeLocalSupportBundle(ctx context.Context, w io.Writer) error {
+	if err := s.Config.Validate(); err != nil {
+		return fmt.Errorf("error validating configuration: %w", err)
+	}
+	lggr := logger.Sugared(s.Logger.Named("SupportBundle"))
+	src := supportbundle.Sources{Config: s.Config}
+
+	db, err := pg.OpenUnlockedDB(ctx, s.Config.AppID(), s.Config.Database())
+	if err != nil {
+		lggr.Warnw("Could not open the database, the jobs and runs are not included", "err", err)
+	} else {
+		defer lggr.ErrorIfFn(db.Close, "Error closing db")
+		pipelineORM := pipeline.NewORM(db, lggr, s.Config.JobPipeline().MaxSuccessfulRuns())
+		// the jobs and runs are only read, which does not use the keystore
+		src.JobORM = job.NewORM(db, pipelineORM, bridges.NewORM(db), nil, lggr)
+	}
+	if s.Config.AutoPprof().Enabled() {
+		src.Nurse = services.NewNurse(s.Config.AutoPprof(), lggr)
+	}
+	return supportbundle.Write(ctx, w, src, lggr)
+}
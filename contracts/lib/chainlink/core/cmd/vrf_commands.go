@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initVRFSubCmds(s *Shell) []cli.Command {
+	jobFlag := cli.StringFlag{
+		Name:  "job, j",
+		Usage: "ID of the VRF job",
+	}
+	return []cli.Command{
+		{
+			Name:  "requests",
+			Usage: "Commands for inspecting the requests of VRF v2 and v2.5 jobs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the pending, in-flight and reverted requests of a VRF job and why they have not been fulfilled",
+					Action: s.ListVRFRequests,
+					Flags:  []cli.Flag{jobFlag},
+				},
+				{
+					Name:   "retry",
+					Usage:  "Evict a request from the in-flight cache so that it is processed again",
+					Action: s.RetryVRFRequest,
+					Flags:  []cli.Flag{jobFlag},
+				},
+			},
+		},
+	}
+}
+
+type VRFRequestPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFRequestResource
+}
+
+var vrfRequestHeaders = []string{"Request ID", "Sub ID", "Sender", "Request Block", "Confirmed At", "State", "Reason", "Sub Active", "Sub LINK Balance", "Sub Native Balance", "Fulfillment Tx", "Updated At"}
+
+// ToRow presents the VRFRequestResource as a slice of strings.
+func (p *VRFRequestPresenter) ToRow() []string {
+	subActive, linkBalance, nativeBalance, fulfillmentTx, updatedAt := "", "", "", "", ""
+	if p.SubActive != nil {
+		subActive = strconv.FormatBool(*p.SubActive)
+	}
+	if p.SubLinkBalance != nil {
+		linkBalance = *p.SubLinkBalance
+	}
+	if p.SubNativeBalance != nil {
+		nativeBalance = *p.SubNativeBalance
+	}
+	if p.FulfillmentTxHash != nil {
+		fulfillmentTx = p.FulfillmentTxHash.Hex()
+	}
+	if p.UpdatedAt != nil {
+		updatedAt = p.UpdatedAt.Format(time.RFC3339)
+	}
+	return []string{
+		p.GetID(),
+		p.SubID,
+		p.Sender.Hex(),
+		strconv.FormatUint(p.RequestBlockNumber, 10),
+		strconv.FormatUint(p.ConfirmedAtBlock, 10),
+		p.State,
+		p.Reason,
+		subActive,
+		linkBalance,
+		nativeBalance,
+		fulfillmentTx,
+		updatedAt,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFRequestPresenter) RenderTable(rt RendererTable) error {
+	renderList(vrfRequestHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// VRFRequestPresenters implements TableRenderer for a slice of VRFRequestPresenter.
+type VRFRequestPresenters []VRFRequestPresenter
+
+// RenderTable implements TableRenderer
+func (ps VRFRequestPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(vrfRequestHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListVRFRequests lists the unfulfilled requests of a VRF job
+func (s *Shell) ListVRFRequests(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	if jobID == "" {
+		return s.errorOut(errors.New("must pass the VRF job ID with --job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/jobs/%s/vrf_requests", url.PathEscape(jobID)))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &VRFRequestPresenters{})
+}
+
+// RetryVRFRequest evicts a request of a VRF job from its in-flight cache
+func (s *Shell) RetryVRFRequest(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	if jobID == "" {
+		return s.errorOut(errors.New("must pass the VRF job ID with --job"))
+	}
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the request ID to be retried"))
+	}
+
+	uri := fmt.Sprintf("/v2/jobs/%s/vrf_requests/%s/retry", url.PathEscape(jobID), url.PathEscape(c.Args().First()))
+	resp, err := s.HTTP.Post(s.ctx(), uri, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &VRFRequestPresenter{}, "Retrying VRF request")
+}
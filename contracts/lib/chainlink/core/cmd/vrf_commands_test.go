@@ -0,0 +1,54 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestVRFRequestPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer        = bytes.NewBufferString("")
+		r             = cmd.RendererTable{Writer: buffer}
+		subActive     = true
+		linkBalance   = "1000000000000000000"
+		fulfillmentTx = common.HexToHash("0x02")
+	)
+
+	p := cmd.VRFRequestPresenter{
+		JAID: cmd.NewJAID("42"),
+		VRFRequestResource: presenters.VRFRequestResource{
+			JAID:               presenters.NewJAID("42"),
+			SubID:              "7",
+			Sender:             common.HexToAddress("0x1111111111111111111111111111111111111111"),
+			RequestBlockNumber: 100,
+			ConfirmedAtBlock:   103,
+			State:              "reverted",
+			Reason:             "fulfillment transaction reverted",
+			SubActive:          &subActive,
+			SubLinkBalance:     &linkBalance,
+			FulfillmentTxHash:  &fulfillmentTx,
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, p.Sender.Hex())
+	assert.Contains(t, output, "reverted")
+	assert.Contains(t, output, linkBalance)
+	assert.Contains(t, output, fulfillmentTx.Hex())
+
+	buffer.Reset()
+	ps := cmd.VRFRequestPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+	assert.Contains(t, buffer.String(), "fulfillment transaction reverted")
+}
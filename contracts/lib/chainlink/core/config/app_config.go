@@ -63,6 +63,8 @@ type AppConfig interface {
 	CRE() CRE
 	Billing() Billing
 	BridgeStatusReporter() BridgeStatusReporter
+	RemoteSigner() RemoteSigner
+	HeadReport() HeadReport
 }
 
 type DatabaseBackupMode string
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"time"
+
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type AuditLogger interface {
@@ -11,4 +14,27 @@ type AuditLogger interface {
 	Environment() string
 	JsonWrapperKey() string
 	Headers() (models.ServiceHeaders, error)
+	KeyUsageStore() bool
+	BatchSize() uint32
+	BatchInterval() time.Duration
+	RetryQueueDir() string
+	RetryQueueMaxSize() utils.FileSize
+	File() AuditLoggerFile
+	Syslog() AuditLoggerSyslog
+}
+
+type AuditLoggerFile interface {
+	Enabled() bool
+	Dir() string
+	MaxSize() utils.FileSize
+	MaxBackups() int64
+	// ChainKey is the secret key of the HMAC chaining the records of the audit log file.
+	ChainKey() string
+}
+
+type AuditLoggerSyslog interface {
+	Enabled() bool
+	Network() string
+	Address() string
+	Tag() string
 }
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"net/url"
+	"time"
+
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -18,4 +21,16 @@ type AutoPprof interface {
 	MutexProfileFraction() int
 	PollInterval() commonconfig.Duration
 	ProfileRoot() string
+	Upload() AutoPprofUpload
+}
+
+type AutoPprofUpload interface {
+	Enabled() bool
+	Endpoint() *url.URL
+	Bucket() string
+	Region() string
+	Prefix() string
+	Timeout() time.Duration
+	AccessKeyID() string
+	SecretAccessKey() string
 }
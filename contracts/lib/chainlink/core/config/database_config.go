@@ -11,6 +11,21 @@ type Backup interface {
 	Mode() DatabaseBackupMode
 	OnVersionUpgrade() bool
 	URL() *url.URL
+	EncryptionPublicKey() string
+	MaxBackups() uint32
+	MaxAge() time.Duration
+	Upload() BackupUpload
+}
+
+type BackupUpload interface {
+	Enabled() bool
+	Endpoint() *url.URL
+	Bucket() string
+	Region() string
+	Prefix() string
+	Timeout() time.Duration
+	AccessKeyID() string
+	SecretAccessKey() string
 }
 
 type Lock interface {
@@ -0,0 +1,12 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type HeadReport interface {
+	WebhookURL() *url.URL
+	WebhookTimeout() time.Duration
+	FilePath() string
+}
@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // TelemetryIngress is an autogenerated mock type for the TelemetryIngress type
@@ -294,6 +296,96 @@ func (_c *TelemetryIngress_SendTimeout_Call) RunAndReturn(run func() time.Durati
 	return _c
 }
 
+// SpoolDir provides a mock function with no fields
+func (_m *TelemetryIngress) SpoolDir() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpoolDir")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryIngress_SpoolDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpoolDir'
+type TelemetryIngress_SpoolDir_Call struct {
+	*mock.Call
+}
+
+// SpoolDir is a helper method to define mock.On call
+func (_e *TelemetryIngress_Expecter) SpoolDir() *TelemetryIngress_SpoolDir_Call {
+	return &TelemetryIngress_SpoolDir_Call{Call: _e.mock.On("SpoolDir")}
+}
+
+func (_c *TelemetryIngress_SpoolDir_Call) Run(run func()) *TelemetryIngress_SpoolDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngress_SpoolDir_Call) Return(_a0 string) *TelemetryIngress_SpoolDir_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngress_SpoolDir_Call) RunAndReturn(run func() string) *TelemetryIngress_SpoolDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpoolMaxSize provides a mock function with no fields
+func (_m *TelemetryIngress) SpoolMaxSize() utils.FileSize {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpoolMaxSize")
+	}
+
+	var r0 utils.FileSize
+	if rf, ok := ret.Get(0).(func() utils.FileSize); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(utils.FileSize)
+	}
+
+	return r0
+}
+
+// TelemetryIngress_SpoolMaxSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpoolMaxSize'
+type TelemetryIngress_SpoolMaxSize_Call struct {
+	*mock.Call
+}
+
+// SpoolMaxSize is a helper method to define mock.On call
+func (_e *TelemetryIngress_Expecter) SpoolMaxSize() *TelemetryIngress_SpoolMaxSize_Call {
+	return &TelemetryIngress_SpoolMaxSize_Call{Call: _e.mock.On("SpoolMaxSize")}
+}
+
+func (_c *TelemetryIngress_SpoolMaxSize_Call) Run(run func()) *TelemetryIngress_SpoolMaxSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngress_SpoolMaxSize_Call) Return(_a0 utils.FileSize) *TelemetryIngress_SpoolMaxSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngress_SpoolMaxSize_Call) RunAndReturn(run func() utils.FileSize) *TelemetryIngress_SpoolMaxSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UniConn provides a mock function with no fields
 func (_m *TelemetryIngress) UniConn() bool {
 	ret := _m.Called()
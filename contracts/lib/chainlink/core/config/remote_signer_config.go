@@ -0,0 +1,12 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type RemoteSigner interface {
+	Enabled() bool
+	URL() *url.URL
+	Timeout() time.Duration
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type TelemetryIngress interface {
@@ -13,6 +15,8 @@ type TelemetryIngress interface {
 	SendInterval() time.Duration
 	SendTimeout() time.Duration
 	UseBatchSend() bool
+	SpoolDir() string
+	SpoolMaxSize() utils.FileSize
 	Endpoints() []TelemetryIngressEndpoint
 }
 
@@ -64,6 +64,8 @@ type Core struct {
 	CRE                  CreConfig            `toml:",omitempty"`
 	Billing              Billing              `toml:",omitempty"`
 	BridgeStatusReporter BridgeStatusReporter `toml:",omitempty"`
+	RemoteSigner         RemoteSigner         `toml:",omitempty"`
+	HeadReport           HeadReport           `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -109,6 +111,8 @@ func (c *Core) SetFrom(f *Core) {
 	c.CRE.setFrom(&f.CRE)
 	c.Billing.setFrom(&f.Billing)
 	c.BridgeStatusReporter.setFrom(&f.BridgeStatusReporter)
+	c.RemoteSigner.setFrom(&f.RemoteSigner)
+	c.HeadReport.setFrom(&f.HeadReport)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -131,15 +135,17 @@ func (c *Core) ValidateConfig() (err error) {
 }
 
 type Secrets struct {
-	Database   DatabaseSecrets          `toml:",omitempty"`
-	Password   Passwords                `toml:",omitempty"`
-	WebServer  WebServerSecrets         `toml:",omitempty"`
-	Pyroscope  PyroscopeSecrets         `toml:",omitempty"`
-	Prometheus PrometheusSecrets        `toml:",omitempty"`
-	Mercury    MercurySecrets           `toml:",omitempty"`
-	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
-	EVM        EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
-	Solana     SolKeys                  `toml:",omitempty"` // choose Solana as the TOML field name to align with relayer config convention
+	Database    DatabaseSecrets          `toml:",omitempty"`
+	Password    Passwords                `toml:",omitempty"`
+	WebServer   WebServerSecrets         `toml:",omitempty"`
+	Pyroscope   PyroscopeSecrets         `toml:",omitempty"`
+	AutoPprof   AutoPprofSecrets         `toml:",omitempty"`
+	AuditLogger AuditLoggerSecrets       `toml:",omitempty"`
+	Prometheus  PrometheusSecrets        `toml:",omitempty"`
+	Mercury     MercurySecrets           `toml:",omitempty"`
+	Threshold   ThresholdKeyShareSecrets `toml:",omitempty"`
+	EVM         EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
+	Solana      SolKeys                  `toml:",omitempty"` // choose Solana as the TOML field name to align with relayer config convention
 
 	P2PKey P2PKey     `toml:",omitempty"`
 	CRE    CreSecrets `toml:",omitempty"`
@@ -282,9 +288,11 @@ func dbURLPasswordComplexity(err error) string {
 }
 
 type DatabaseSecrets struct {
-	URL                  *models.SecretURL
-	BackupURL            *models.SecretURL
-	AllowSimplePasswords *bool
+	URL                         *models.SecretURL
+	BackupURL                   *models.SecretURL
+	AllowSimplePasswords        *bool
+	BackupUploadAccessKeyID     *models.Secret
+	BackupUploadSecretAccessKey *models.Secret
 }
 
 func validateDBURL(dbURI url.URL) error {
@@ -349,6 +357,12 @@ func (d *DatabaseSecrets) SetFrom(f *DatabaseSecrets) (err error) {
 	if v := f.URL; v != nil {
 		d.URL = v
 	}
+	if v := f.BackupUploadAccessKeyID; v != nil {
+		d.BackupUploadAccessKeyID = v
+	}
+	if v := f.BackupUploadSecretAccessKey; v != nil {
+		d.BackupUploadSecretAccessKey = v
+	}
 	return nil
 }
 
@@ -365,6 +379,14 @@ func (d *DatabaseSecrets) validateMerge(f *DatabaseSecrets) (err error) {
 		err = errors.Join(err, configutils.ErrOverride{Name: "URL"})
 	}
 
+	if d.BackupUploadAccessKeyID != nil && f.BackupUploadAccessKeyID != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "BackupUploadAccessKeyID"})
+	}
+
+	if d.BackupUploadSecretAccessKey != nil && f.BackupUploadSecretAccessKey != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "BackupUploadSecretAccessKey"})
+	}
+
 	return err
 }
 
@@ -518,6 +540,70 @@ func (p *PyroscopeSecrets) validateMerge(f *PyroscopeSecrets) (err error) {
 	return err
 }
 
+type AutoPprofSecrets struct {
+	UploadAccessKeyID     *models.Secret
+	UploadSecretAccessKey *models.Secret
+}
+
+func (a *AutoPprofSecrets) SetFrom(f *AutoPprofSecrets) (err error) {
+	err = a.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if v := f.UploadAccessKeyID; v != nil {
+		a.UploadAccessKeyID = v
+	}
+	if v := f.UploadSecretAccessKey; v != nil {
+		a.UploadSecretAccessKey = v
+	}
+
+	return nil
+}
+
+func (a *AutoPprofSecrets) validateMerge(f *AutoPprofSecrets) (err error) {
+	if a.UploadAccessKeyID != nil && f.UploadAccessKeyID != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "UploadAccessKeyID"})
+	}
+	if a.UploadSecretAccessKey != nil && f.UploadSecretAccessKey != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "UploadSecretAccessKey"})
+	}
+
+	return err
+}
+
+type AuditLoggerSecrets struct {
+	FileChainKey *models.Secret
+}
+
+func (a *AuditLoggerSecrets) SetFrom(f *AuditLoggerSecrets) (err error) {
+	err = a.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if v := f.FileChainKey; v != nil {
+		a.FileChainKey = v
+	}
+
+	return nil
+}
+
+func (a *AuditLoggerSecrets) validateMerge(f *AuditLoggerSecrets) (err error) {
+	if a.FileChainKey != nil && f.FileChainKey != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "FileChainKey"})
+	}
+
+	return err
+}
+
+func (a *AuditLoggerSecrets) ValidateConfig() (err error) {
+	if a.FileChainKey != nil && len(*a.FileChainKey) < 32 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "FileChainKey", Value: "*****", Msg: "must be at least 32 characters long"})
+	}
+	return err
+}
+
 type PrometheusSecrets struct {
 	AuthToken *models.Secret
 }
@@ -667,10 +753,15 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 //
 // Note: url is stored in Secrets.DatabaseBackupURL
 type DatabaseBackup struct {
-	Dir              *string
-	Frequency        *commonconfig.Duration
-	Mode             *config.DatabaseBackupMode
-	OnVersionUpgrade *bool
+	Dir                 *string
+	Frequency           *commonconfig.Duration
+	Mode                *config.DatabaseBackupMode
+	OnVersionUpgrade    *bool
+	EncryptionPublicKey *string
+	MaxBackups          *uint32
+	MaxAge              *commonconfig.Duration
+
+	Upload DatabaseBackupUpload `toml:",omitempty"`
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
@@ -686,6 +777,82 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.EncryptionPublicKey; v != nil {
+		d.EncryptionPublicKey = v
+	}
+	if v := f.MaxBackups; v != nil {
+		d.MaxBackups = v
+	}
+	if v := f.MaxAge; v != nil {
+		d.MaxAge = v
+	}
+	d.Upload.setFrom(&f.Upload)
+}
+
+func (d *DatabaseBackup) ValidateConfig() (err error) {
+	if d.EncryptionPublicKey != nil && *d.EncryptionPublicKey != "" && !strings.HasPrefix(*d.EncryptionPublicKey, "age1") {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "EncryptionPublicKey", Value: *d.EncryptionPublicKey, Msg: "must be an age X25519 public key, starting with age1"})
+	}
+	if d.MaxAge != nil && d.MaxAge.Duration() < 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "MaxAge", Value: d.MaxAge.Duration(), Msg: "must not be negative"})
+	}
+	return err
+}
+
+// DatabaseBackupUpload
+//
+// Note: credentials are stored in Secrets.Database
+type DatabaseBackupUpload struct {
+	Enabled  *bool
+	Endpoint *commonconfig.URL
+	Bucket   *string
+	Region   *string
+	Prefix   *string
+	Timeout  *commonconfig.Duration
+}
+
+func (u *DatabaseBackupUpload) setFrom(f *DatabaseBackupUpload) {
+	if v := f.Enabled; v != nil {
+		u.Enabled = v
+	}
+	if v := f.Endpoint; v != nil {
+		u.Endpoint = v
+	}
+	if v := f.Bucket; v != nil {
+		u.Bucket = v
+	}
+	if v := f.Region; v != nil {
+		u.Region = v
+	}
+	if v := f.Prefix; v != nil {
+		u.Prefix = v
+	}
+	if v := f.Timeout; v != nil {
+		u.Timeout = v
+	}
+}
+
+func (u *DatabaseBackupUpload) ValidateConfig() (err error) {
+	if u.Enabled == nil || !*u.Enabled {
+		return nil
+	}
+
+	if u.Endpoint == nil || u.Endpoint.IsZero() {
+		err = errors.Join(err, configutils.ErrMissing{Name: "Endpoint", Msg: "must be set when Upload is enabled"})
+	} else if u.Endpoint.Scheme != "http" && u.Endpoint.Scheme != "https" {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Endpoint", Value: u.Endpoint.String(), Msg: "must be an http or https URL"})
+	}
+	if u.Bucket == nil || *u.Bucket == "" {
+		err = errors.Join(err, configutils.ErrMissing{Name: "Bucket", Msg: "must be set when Upload is enabled"})
+	}
+	if u.Region == nil || *u.Region == "" {
+		err = errors.Join(err, configutils.ErrMissing{Name: "Region", Msg: "must be set when Upload is enabled"})
+	}
+	if u.Timeout != nil && u.Timeout.Duration() <= 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Timeout", Value: u.Timeout.Duration(), Msg: "must be positive"})
+	}
+
+	return err
 }
 
 type TelemetryIngress struct {
@@ -696,6 +863,7 @@ type TelemetryIngress struct {
 	SendInterval *commonconfig.Duration
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
+	SpoolMaxSize *utils.FileSize
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
 }
 
@@ -728,16 +896,26 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	if v := f.SpoolMaxSize; v != nil {
+		t.SpoolMaxSize = v
+	}
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
 }
 
 type AuditLogger struct {
-	Enabled        *bool
-	ForwardToUrl   *commonconfig.URL
-	JsonWrapperKey *string
-	Headers        *[]models.ServiceHeader
+	Enabled           *bool
+	ForwardToUrl      *commonconfig.URL
+	JsonWrapperKey    *string
+	Headers           *[]models.ServiceHeader
+	KeyUsageStore     *bool
+	BatchSize         *uint32
+	BatchInterval     *commonconfig.Duration
+	RetryQueueMaxSize *utils.FileSize
+
+	File   AuditLoggerFile   `toml:",omitempty"`
+	Syslog AuditLoggerSyslog `toml:",omitempty"`
 }
 
 func (p *AuditLogger) SetFrom(f *AuditLogger) {
@@ -753,6 +931,106 @@ func (p *AuditLogger) SetFrom(f *AuditLogger) {
 	if v := f.Headers; v != nil {
 		p.Headers = v
 	}
+	if v := f.KeyUsageStore; v != nil {
+		p.KeyUsageStore = v
+	}
+	if v := f.BatchSize; v != nil {
+		p.BatchSize = v
+	}
+	if v := f.BatchInterval; v != nil {
+		p.BatchInterval = v
+	}
+	if v := f.RetryQueueMaxSize; v != nil {
+		p.RetryQueueMaxSize = v
+	}
+	p.File.setFrom(&f.File)
+	p.Syslog.setFrom(&f.Syslog)
+}
+
+func (p *AuditLogger) ValidateConfig() (err error) {
+	if p.BatchSize != nil && *p.BatchSize == 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "BatchSize", Value: *p.BatchSize, Msg: "must be greater than zero"})
+	}
+	if p.BatchInterval != nil && p.BatchInterval.Duration() <= 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "BatchInterval", Value: p.BatchInterval.Duration(), Msg: "must be greater than zero"})
+	}
+	return err
+}
+
+type AuditLoggerFile struct {
+	Enabled    *bool
+	Dir        *string
+	MaxSize    *utils.FileSize
+	MaxBackups *int64
+}
+
+func (f *AuditLoggerFile) setFrom(o *AuditLoggerFile) {
+	if v := o.Enabled; v != nil {
+		f.Enabled = v
+	}
+	if v := o.Dir; v != nil {
+		f.Dir = v
+	}
+	if v := o.MaxSize; v != nil {
+		f.MaxSize = v
+	}
+	if v := o.MaxBackups; v != nil {
+		f.MaxBackups = v
+	}
+}
+
+func (f *AuditLoggerFile) ValidateConfig() (err error) {
+	if f.Enabled == nil || !*f.Enabled {
+		return nil
+	}
+	if f.MaxSize != nil && *f.MaxSize == 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "MaxSize", Value: *f.MaxSize, Msg: "must be greater than zero"})
+	}
+	if f.MaxBackups != nil && *f.MaxBackups < 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "MaxBackups", Value: *f.MaxBackups, Msg: "must not be negative"})
+	}
+	return err
+}
+
+type AuditLoggerSyslog struct {
+	Enabled *bool
+	Network *string
+	Address *string
+	Tag     *string
+}
+
+func (s *AuditLoggerSyslog) setFrom(f *AuditLoggerSyslog) {
+	if v := f.Enabled; v != nil {
+		s.Enabled = v
+	}
+	if v := f.Network; v != nil {
+		s.Network = v
+	}
+	if v := f.Address; v != nil {
+		s.Address = v
+	}
+	if v := f.Tag; v != nil {
+		s.Tag = v
+	}
+}
+
+func (s *AuditLoggerSyslog) ValidateConfig() (err error) {
+	if s.Enabled == nil || !*s.Enabled || s.Network == nil {
+		return nil
+	}
+	switch *s.Network {
+	case "":
+		if s.Address != nil && *s.Address != "" {
+			err = errors.Join(err, configutils.ErrInvalid{Name: "Network", Value: *s.Network, Msg: "must be set with Address"})
+		}
+	case "udp", "tcp", "unix", "unixgram":
+		if s.Address == nil || *s.Address == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: "Address", Msg: "required with Network"})
+		}
+	default:
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Network", Value: *s.Network, Msg: "must be one of udp, tcp, unix or unixgram, or empty for the local syslog"})
+	}
+	return err
 }
 
 // LogLevel replaces dpanic with crit/CRIT
@@ -844,11 +1122,12 @@ type WebServer struct {
 	StartTimeout            *commonconfig.Duration
 	ListenIP                *net.IP
 
-	LDAP      WebServerLDAP      `toml:",omitempty"`
-	OIDC      WebServerOIDC      `toml:",omitempty"`
-	MFA       WebServerMFA       `toml:",omitempty"`
-	RateLimit WebServerRateLimit `toml:",omitempty"`
-	TLS       WebServerTLS       `toml:",omitempty"`
+	LDAP         WebServerLDAP         `toml:",omitempty"`
+	OIDC         WebServerOIDC         `toml:",omitempty"`
+	MFA          WebServerMFA          `toml:",omitempty"`
+	RateLimit    WebServerRateLimit    `toml:",omitempty"`
+	LoginLockout WebServerLoginLockout `toml:",omitempty"`
+	TLS          WebServerTLS          `toml:",omitempty"`
 }
 
 func (w *WebServer) setFrom(f *WebServer) {
@@ -893,10 +1172,16 @@ func (w *WebServer) setFrom(f *WebServer) {
 	w.OIDC.setFrom(&f.OIDC)
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
+	w.LoginLockout.setFrom(&f.LoginLockout)
 	w.TLS.setFrom(&f.TLS)
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
+	if w.LoginLockout.MaxFailures != nil && *w.LoginLockout.MaxFailures > 0 &&
+		w.LoginLockout.LockoutDuration != nil && w.LoginLockout.LockoutDuration.Duration() <= 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "LoginLockout.LockoutDuration", Value: w.LoginLockout.LockoutDuration.String(), Msg: "must be greater than zero when MaxFailures is set"})
+	}
+
 	switch *w.AuthenticationMethod {
 	case string(sessions.LDAPAuth):
 		// Assert LDAP fields when AuthMethod set to LDAP
@@ -1001,6 +1286,28 @@ func (w *WebServerRateLimit) setFrom(f *WebServerRateLimit) {
 	}
 }
 
+type WebServerLoginLockout struct {
+	MaxFailures      *uint32
+	MaxFailuresPerIP *uint32
+	LockoutDuration  *commonconfig.Duration
+	MaxDelay         *commonconfig.Duration
+}
+
+func (w *WebServerLoginLockout) setFrom(f *WebServerLoginLockout) {
+	if v := f.MaxFailures; v != nil {
+		w.MaxFailures = v
+	}
+	if v := f.MaxFailuresPerIP; v != nil {
+		w.MaxFailuresPerIP = v
+	}
+	if v := f.LockoutDuration; v != nil {
+		w.LockoutDuration = v
+	}
+	if v := f.MaxDelay; v != nil {
+		w.MaxDelay = v
+	}
+}
+
 type WebServerTLS struct {
 	CertPath      *string
 	ForceRedirect *bool
@@ -1538,6 +1845,8 @@ type AutoPprof struct {
 	MutexProfileFraction *int64 // runtime.SetMutexProfileFraction
 	MemThreshold         *utils.FileSize
 	GoroutineThreshold   *int64
+
+	Upload AutoPprofUpload `toml:",omitempty"`
 }
 
 func (p *AutoPprof) setFrom(f *AutoPprof) {
@@ -1577,6 +1886,63 @@ func (p *AutoPprof) setFrom(f *AutoPprof) {
 	if v := f.GoroutineThreshold; v != nil {
 		p.GoroutineThreshold = v
 	}
+	p.Upload.setFrom(&f.Upload)
+}
+
+// AutoPprofUpload
+//
+// Note: credentials are stored in Secrets.AutoPprof
+type AutoPprofUpload struct {
+	Enabled  *bool
+	Endpoint *commonconfig.URL
+	Bucket   *string
+	Region   *string
+	Prefix   *string
+	Timeout  *commonconfig.Duration
+}
+
+func (u *AutoPprofUpload) setFrom(f *AutoPprofUpload) {
+	if v := f.Enabled; v != nil {
+		u.Enabled = v
+	}
+	if v := f.Endpoint; v != nil {
+		u.Endpoint = v
+	}
+	if v := f.Bucket; v != nil {
+		u.Bucket = v
+	}
+	if v := f.Region; v != nil {
+		u.Region = v
+	}
+	if v := f.Prefix; v != nil {
+		u.Prefix = v
+	}
+	if v := f.Timeout; v != nil {
+		u.Timeout = v
+	}
+}
+
+func (u *AutoPprofUpload) ValidateConfig() (err error) {
+	if u.Enabled == nil || !*u.Enabled {
+		return nil
+	}
+
+	if u.Endpoint == nil || u.Endpoint.IsZero() {
+		err = errors.Join(err, configutils.ErrMissing{Name: "Endpoint", Msg: "must be set when Upload is enabled"})
+	} else if u.Endpoint.Scheme != "http" && u.Endpoint.Scheme != "https" {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Endpoint", Value: u.Endpoint.String(), Msg: "must be an http or https URL"})
+	}
+	if u.Bucket == nil || *u.Bucket == "" {
+		err = errors.Join(err, configutils.ErrMissing{Name: "Bucket", Msg: "must be set when Upload is enabled"})
+	}
+	if u.Region == nil || *u.Region == "" {
+		err = errors.Join(err, configutils.ErrMissing{Name: "Region", Msg: "must be set when Upload is enabled"})
+	}
+	if u.Timeout != nil && u.Timeout.Duration() <= 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Timeout", Value: u.Timeout.Duration(), Msg: "must be positive"})
+	}
+
+	return err
 }
 
 type Pyroscope struct {
@@ -2491,6 +2857,70 @@ func (e *BridgeStatusReporter) ValidateConfig() error {
 	return nil
 }
 
+type RemoteSigner struct {
+	Enabled *bool
+	URL     *commonconfig.URL
+	Timeout *commonconfig.Duration
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if f.Enabled != nil {
+		r.Enabled = f.Enabled
+	}
+	if f.URL != nil {
+		r.URL = f.URL
+	}
+	if f.Timeout != nil {
+		r.Timeout = f.Timeout
+	}
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return nil
+	}
+
+	if r.URL == nil || r.URL.IsZero() {
+		err = errors.Join(err, configutils.ErrMissing{Name: "URL", Msg: "must be set when RemoteSigner is enabled"})
+	} else if r.URL.Scheme != "http" && r.URL.Scheme != "https" {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "URL", Value: r.URL.String(), Msg: "must be an http or https URL"})
+	}
+
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Timeout", Value: r.Timeout.Duration(), Msg: "must be positive"})
+	}
+
+	return err
+}
+
+type HeadReport struct {
+	WebhookURL     *commonconfig.URL
+	WebhookTimeout *commonconfig.Duration
+	FilePath       *string
+}
+
+func (h *HeadReport) setFrom(f *HeadReport) {
+	if f.WebhookURL != nil {
+		h.WebhookURL = f.WebhookURL
+	}
+	if f.WebhookTimeout != nil {
+		h.WebhookTimeout = f.WebhookTimeout
+	}
+	if f.FilePath != nil {
+		h.FilePath = f.FilePath
+	}
+}
+
+func (h *HeadReport) ValidateConfig() (err error) {
+	if h.WebhookURL != nil && !h.WebhookURL.IsZero() && h.WebhookURL.Scheme != "http" && h.WebhookURL.Scheme != "https" {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "WebhookURL", Value: h.WebhookURL.String(), Msg: "must be an http or https URL"})
+	}
+	if h.WebhookTimeout != nil && h.WebhookTimeout.Duration() <= 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "WebhookTimeout", Value: h.WebhookTimeout.Duration(), Msg: "must be positive"})
+	}
+	return err
+}
+
 type JobDistributor struct {
 	DisplayName *string
 }
@@ -861,3 +861,49 @@ func durationPtr(d time.Duration) *commonconfig.Duration {
 	cd := *commonconfig.MustNewDuration(d)
 	return &cd
 }
+
+func TestRemoteSigner_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		config   RemoteSigner
+		errorMsg string
+	}{
+		{name: "disabled", config: RemoteSigner{Enabled: ptr(false)}},
+		{name: "nil enabled", config: RemoteSigner{}},
+		{name: "enabled", config: RemoteSigner{Enabled: ptr(true), URL: commonconfig.MustParseURL("https://signer.example"), Timeout: durationPtr(time.Second)}},
+		{name: "missing URL", config: RemoteSigner{Enabled: ptr(true), URL: &commonconfig.URL{}}, errorMsg: "URL: missing: must be set when RemoteSigner is enabled"},
+		{name: "invalid scheme", config: RemoteSigner{Enabled: ptr(true), URL: commonconfig.MustParseURL("ftp://signer.example")}, errorMsg: "URL: invalid value (ftp://signer.example): must be an http or https URL"},
+		{name: "zero timeout", config: RemoteSigner{Enabled: ptr(true), URL: commonconfig.MustParseURL("http://localhost:9000"), Timeout: durationPtr(0)}, errorMsg: "Timeout: invalid value (0s): must be positive"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateConfig()
+			if tc.errorMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.errorMsg)
+		})
+	}
+}
+
+func TestHeadReport_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		config   HeadReport
+		errorMsg string
+	}{
+		{name: "empty", config: HeadReport{WebhookURL: &commonconfig.URL{}, WebhookTimeout: durationPtr(time.Second), FilePath: ptr("")}},
+		{name: "webhook", config: HeadReport{WebhookURL: commonconfig.MustParseURL("https://heads.example.com"), WebhookTimeout: durationPtr(time.Second)}},
+		{name: "invalid scheme", config: HeadReport{WebhookURL: commonconfig.MustParseURL("ftp://heads.example.com")}, errorMsg: "WebhookURL: invalid value (ftp://heads.example.com): must be an http or https URL"},
+		{name: "zero timeout", config: HeadReport{WebhookTimeout: durationPtr(0)}, errorMsg: "WebhookTimeout: invalid value (0s): must be positive"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateConfig()
+			if tc.errorMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.errorMsg)
+		})
+	}
+}
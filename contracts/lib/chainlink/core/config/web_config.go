@@ -27,6 +27,13 @@ type RateLimit interface {
 	UnauthenticatedPeriod() time.Duration
 }
 
+type LoginLockout interface {
+	MaxFailures() uint32
+	MaxFailuresPerIP() uint32
+	LockoutDuration() time.Duration
+	MaxDelay() time.Duration
+}
+
 type MFA interface {
 	RPID() string
 	RPOrigin() string
@@ -87,6 +94,7 @@ type WebServer interface {
 
 	TLS() TLS
 	RateLimit() RateLimit
+	LoginLockout() LoginLockout
 	MFA() MFA
 	LDAP() LDAP
 	OIDC() OIDC
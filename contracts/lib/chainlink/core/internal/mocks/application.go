@@ -21,6 +21,10 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 
+	keyusage "github.com/smartcontractkit/chainlink/v2/core/services/keyusage"
+
+	loglevel "github.com/smartcontractkit/chainlink/v2/core/services/loglevel"
+
 	logger "github.com/smartcontractkit/chainlink/v2/core/logger"
 
 	logpoller "github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
@@ -31,16 +35,24 @@ import (
 
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
 
+	apitokens "github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+
+	rbac "github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+
 	services "github.com/smartcontractkit/chainlink/v2/core/services"
 
 	sessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	time "time"
+
 	txmgr "github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
 
 	uuid "github.com/google/uuid"
 
+	v2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
 	zapcore "go.uber.org/zap/zapcore"
@@ -59,6 +71,53 @@ func (_m *Application) EXPECT() *Application_Expecter {
 	return &Application_Expecter{mock: &_m.Mock}
 }
 
+// APITokensORM provides a mock function with no fields
+func (_m *Application) APITokensORM() apitokens.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APITokensORM")
+	}
+
+	var r0 apitokens.ORM
+	if rf, ok := ret.Get(0).(func() apitokens.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apitokens.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_APITokensORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APITokensORM'
+type Application_APITokensORM_Call struct {
+	*mock.Call
+}
+
+// APITokensORM is a helper method to define mock.On call
+func (_e *Application_Expecter) APITokensORM() *Application_APITokensORM_Call {
+	return &Application_APITokensORM_Call{Call: _e.mock.On("APITokensORM")}
+}
+
+func (_c *Application_APITokensORM_Call) Run(run func()) *Application_APITokensORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_APITokensORM_Call) Return(_a0 apitokens.ORM) *Application_APITokensORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_APITokensORM_Call) RunAndReturn(run func() apitokens.ORM) *Application_APITokensORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	ret := _m.Called(ctx, _a1)
@@ -247,6 +306,53 @@ func (_c *Application_BridgeORM_Call) RunAndReturn(run func() bridges.ORM) *Appl
 	return _c
 }
 
+// CustomRolesORM provides a mock function with no fields
+func (_m *Application) CustomRolesORM() rbac.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CustomRolesORM")
+	}
+
+	var r0 rbac.ORM
+	if rf, ok := ret.Get(0).(func() rbac.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(rbac.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_CustomRolesORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CustomRolesORM'
+type Application_CustomRolesORM_Call struct {
+	*mock.Call
+}
+
+// CustomRolesORM is a helper method to define mock.On call
+func (_e *Application_Expecter) CustomRolesORM() *Application_CustomRolesORM_Call {
+	return &Application_CustomRolesORM_Call{Call: _e.mock.On("CustomRolesORM")}
+}
+
+func (_c *Application_CustomRolesORM_Call) Run(run func()) *Application_CustomRolesORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_CustomRolesORM_Call) Return(_a0 rbac.ORM) *Application_CustomRolesORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_CustomRolesORM_Call) RunAndReturn(run func() rbac.ORM) *Application_CustomRolesORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -342,6 +448,107 @@ func (_c *Application_DeleteLogPollerDataAfter_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// Drain provides a mock function with given fields: timeout
+func (_m *Application) Drain(timeout time.Duration) (chainlink.DrainStatus, error) {
+	ret := _m.Called(timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 chainlink.DrainStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Duration) (chainlink.DrainStatus, error)); ok {
+		return rf(timeout)
+	}
+	if rf, ok := ret.Get(0).(func(time.Duration) chainlink.DrainStatus); ok {
+		r0 = rf(timeout)
+	} else {
+		r0 = ret.Get(0).(chainlink.DrainStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Duration) error); ok {
+		r1 = rf(timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type Application_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+//   - timeout time.Duration
+func (_e *Application_Expecter) Drain(timeout interface{}) *Application_Drain_Call {
+	return &Application_Drain_Call{Call: _e.mock.On("Drain", timeout)}
+}
+
+func (_c *Application_Drain_Call) Run(run func(timeout time.Duration)) *Application_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Application_Drain_Call) Return(_a0 chainlink.DrainStatus, _a1 error) *Application_Drain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_Drain_Call) RunAndReturn(run func(time.Duration) (chainlink.DrainStatus, error)) *Application_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrainStatus provides a mock function with no fields
+func (_m *Application) DrainStatus() chainlink.DrainStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainStatus")
+	}
+
+	var r0 chainlink.DrainStatus
+	if rf, ok := ret.Get(0).(func() chainlink.DrainStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.DrainStatus)
+	}
+
+	return r0
+}
+
+// Application_DrainStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainStatus'
+type Application_DrainStatus_Call struct {
+	*mock.Call
+}
+
+// DrainStatus is a helper method to define mock.On call
+func (_e *Application_Expecter) DrainStatus() *Application_DrainStatus_Call {
+	return &Application_DrainStatus_Call{Call: _e.mock.On("DrainStatus")}
+}
+
+func (_c *Application_DrainStatus_Call) Run(run func()) *Application_DrainStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_DrainStatus_Call) Return(_a0 chainlink.DrainStatus) *Application_DrainStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_DrainStatus_Call) RunAndReturn(run func() chainlink.DrainStatus) *Application_DrainStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLCA provides a mock function with given fields: ctx, chainID
 func (_m *Application) FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.Block, error) {
 	ret := _m.Called(ctx, chainID)
@@ -1104,6 +1311,147 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// KeyUsageORM provides a mock function with no fields
+func (_m *Application) KeyUsageORM() keyusage.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyUsageORM")
+	}
+
+	var r0 keyusage.ORM
+	if rf, ok := ret.Get(0).(func() keyusage.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keyusage.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_KeyUsageORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyUsageORM'
+type Application_KeyUsageORM_Call struct {
+	*mock.Call
+}
+
+// KeyUsageORM is a helper method to define mock.On call
+func (_e *Application_Expecter) KeyUsageORM() *Application_KeyUsageORM_Call {
+	return &Application_KeyUsageORM_Call{Call: _e.mock.On("KeyUsageORM")}
+}
+
+func (_c *Application_KeyUsageORM_Call) Run(run func()) *Application_KeyUsageORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_KeyUsageORM_Call) Return(_a0 keyusage.ORM) *Application_KeyUsageORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_KeyUsageORM_Call) RunAndReturn(run func() keyusage.ORM) *Application_KeyUsageORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogLevelOverrides provides a mock function with no fields
+func (_m *Application) LogLevelOverrides() *loglevel.Manager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogLevelOverrides")
+	}
+
+	var r0 *loglevel.Manager
+	if rf, ok := ret.Get(0).(func() *loglevel.Manager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*loglevel.Manager)
+		}
+	}
+
+	return r0
+}
+
+// Application_LogLevelOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLevelOverrides'
+type Application_LogLevelOverrides_Call struct {
+	*mock.Call
+}
+
+// LogLevelOverrides is a helper method to define mock.On call
+func (_e *Application_Expecter) LogLevelOverrides() *Application_LogLevelOverrides_Call {
+	return &Application_LogLevelOverrides_Call{Call: _e.mock.On("LogLevelOverrides")}
+}
+
+func (_c *Application_LogLevelOverrides_Call) Run(run func()) *Application_LogLevelOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_LogLevelOverrides_Call) Return(_a0 *loglevel.Manager) *Application_LogLevelOverrides_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_LogLevelOverrides_Call) RunAndReturn(run func() *loglevel.Manager) *Application_LogLevelOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Nurse provides a mock function with no fields
+func (_m *Application) Nurse() *services.Nurse {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Nurse")
+	}
+
+	var r0 *services.Nurse
+	if rf, ok := ret.Get(0).(func() *services.Nurse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Nurse)
+		}
+	}
+
+	return r0
+}
+
+// Application_Nurse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Nurse'
+type Application_Nurse_Call struct {
+	*mock.Call
+}
+
+// Nurse is a helper method to define mock.On call
+func (_e *Application_Expecter) Nurse() *Application_Nurse_Call {
+	return &Application_Nurse_Call{Call: _e.mock.On("Nurse")}
+}
+
+func (_c *Application_Nurse_Call) Run(run func()) *Application_Nurse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_Nurse_Call) Return(_a0 *services.Nurse) *Application_Nurse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_Nurse_Call) RunAndReturn(run func() *services.Nurse) *Application_Nurse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with no fields
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1151,6 +1499,62 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// ReloadConfig provides a mock function with given fields: ctx
+func (_m *Application) ReloadConfig(ctx context.Context) (chainlink.ConfigReload, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 chainlink.ConfigReload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (chainlink.ConfigReload, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) chainlink.ConfigReload); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(chainlink.ConfigReload)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReloadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadConfig'
+type Application_ReloadConfig_Call struct {
+	*mock.Call
+}
+
+// ReloadConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Application_Expecter) ReloadConfig(ctx interface{}) *Application_ReloadConfig_Call {
+	return &Application_ReloadConfig_Call{Call: _e.mock.On("ReloadConfig", ctx)}
+}
+
+func (_c *Application_ReloadConfig_Call) Run(run func(ctx context.Context)) *Application_ReloadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) Return(_a0 chainlink.ConfigReload, _a1 error) *Application_ReloadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) RunAndReturn(run func(context.Context) (chainlink.ConfigReload, error)) *Application_ReloadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: ctx, chainFamily, chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(ctx, chainFamily, chainID, number, forceBroadcast)
@@ -1597,6 +2001,98 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// Undrain provides a mock function with no fields
+func (_m *Application) Undrain() chainlink.DrainStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Undrain")
+	}
+
+	var r0 chainlink.DrainStatus
+	if rf, ok := ret.Get(0).(func() chainlink.DrainStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.DrainStatus)
+	}
+
+	return r0
+}
+
+// Application_Undrain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Undrain'
+type Application_Undrain_Call struct {
+	*mock.Call
+}
+
+// Undrain is a helper method to define mock.On call
+func (_e *Application_Expecter) Undrain() *Application_Undrain_Call {
+	return &Application_Undrain_Call{Call: _e.mock.On("Undrain")}
+}
+
+func (_c *Application_Undrain_Call) Run(run func()) *Application_Undrain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_Undrain_Call) Return(_a0 chainlink.DrainStatus) *Application_Undrain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_Undrain_Call) RunAndReturn(run func() chainlink.DrainStatus) *Application_Undrain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VRFRequestInspectors provides a mock function with no fields
+func (_m *Application) VRFRequestInspectors() *v2.InspectorRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VRFRequestInspectors")
+	}
+
+	var r0 *v2.InspectorRegistry
+	if rf, ok := ret.Get(0).(func() *v2.InspectorRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v2.InspectorRegistry)
+		}
+	}
+
+	return r0
+}
+
+// Application_VRFRequestInspectors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VRFRequestInspectors'
+type Application_VRFRequestInspectors_Call struct {
+	*mock.Call
+}
+
+// VRFRequestInspectors is a helper method to define mock.On call
+func (_e *Application_Expecter) VRFRequestInspectors() *Application_VRFRequestInspectors_Call {
+	return &Application_VRFRequestInspectors_Call{Call: _e.mock.On("VRFRequestInspectors")}
+}
+
+func (_c *Application_VRFRequestInspectors_Call) Run(run func()) *Application_VRFRequestInspectors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_VRFRequestInspectors_Call) Return(_a0 *v2.InspectorRegistry) *Application_VRFRequestInspectors_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_VRFRequestInspectors_Call) RunAndReturn(run func() *v2.InspectorRegistry) *Application_VRFRequestInspectors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with no fields
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -0,0 +1,197 @@
+// Package s3test provides an in memory stand-in for an S3 compatible object store.
+package s3test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/s3"
+)
+
+const (
+	AccessKeyID     = "test-access-key"
+	SecretAccessKey = "test-secret-key"
+)
+
+// Server is an in memory S3 compatible object store, serving a single bucket to the AccessKeyID credentials.
+type Server struct {
+	*httptest.Server
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string]object
+	uploads map[string]map[int][]byte
+	nextID  int
+}
+
+type object struct {
+	data         []byte
+	lastModified time.Time
+}
+
+func NewServer(t testing.TB, bucket string) *Server {
+	s := &Server{bucket: bucket, objects: map[string]object{}, uploads: map[string]map[int][]byte{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Endpoint returns the URL of the server, to be passed to s3.NewClient.
+func (s *Server) Endpoint(t testing.TB) url.URL {
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	return *u
+}
+
+// Put stores an object directly, bypassing the API.
+func (s *Server) Put(key string, data []byte, lastModified time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = object{data, lastModified}
+}
+
+// Get returns the data of the object stored at key.
+func (s *Server) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.objects[key]
+	return o.data, ok
+}
+
+// Uploads returns the number of multipart uploads in progress.
+func (s *Server) Uploads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.uploads)
+}
+
+// Keys returns the sorted keys of all the stored objects.
+func (s *Server) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for key := range s.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	// only the credentials are checked, the signature is verified separately
+	if !strings.HasPrefix(r.Header.Get("Authorization"), s3.Algorithm+" Credential="+AccessKeyID+"/") || r.Header.Get("X-Amz-Date") == "" {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+	bucketPrefix := "/" + s.bucket
+	if !strings.HasPrefix(r.URL.Path, bucketPrefix) {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, bucketPrefix), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPost && key != "" && query.Has("uploads"):
+		s.nextID++
+		uploadID := strconv.Itoa(s.nextID)
+		s.uploads[uploadID] = map[int][]byte{}
+		s.writeXML(w, struct {
+			XMLName xml.Name `xml:"InitiateMultipartUploadResult"`
+			s3.InitiateMultipartUploadResult
+		}{InitiateMultipartUploadResult: s3.InitiateMultipartUploadResult{UploadID: uploadID}})
+	case r.Method == http.MethodPut && key != "" && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			http.Error(w, "NoSuchUpload", http.StatusNotFound)
+			return
+		}
+		number, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		parts[number] = data
+		w.Header().Set("ETag", partETag(number))
+	case r.Method == http.MethodPost && key != "" && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			http.Error(w, "NoSuchUpload", http.StatusNotFound)
+			return
+		}
+		var complete s3.CompleteMultipartUpload
+		if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for i, part := range complete.Parts {
+			if part.PartNumber != i+1 || part.ETag != partETag(part.PartNumber) {
+				http.Error(w, "InvalidPart", http.StatusBadRequest)
+				return
+			}
+			data = append(data, parts[part.PartNumber]...)
+		}
+		delete(s.uploads, query.Get("uploadId"))
+		s.objects[key] = object{data, time.Now()}
+		_, _ = w.Write([]byte("<CompleteMultipartUploadResult><Key>" + key + "</Key></CompleteMultipartUploadResult>"))
+	case r.Method == http.MethodDelete && key != "" && query.Has("uploadId"):
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut && key != "":
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = object{data, time.Now()}
+	case r.Method == http.MethodDelete && key != "":
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && key == "" && query.Get("list-type") == "2":
+		var result s3.ListResult
+		for k, o := range s.objects {
+			if strings.HasPrefix(k, query.Get("prefix")) {
+				result.Contents = append(result.Contents, s3.Object{Key: k, LastModified: o.lastModified, Size: int64(len(o.data))})
+			}
+		}
+		s.writeXML(w, struct {
+			XMLName xml.Name `xml:"ListBucketResult"`
+			s3.ListResult
+		}{ListResult: result})
+	default:
+		http.Error(w, "NotImplemented", http.StatusNotImplemented)
+	}
+}
+
+func (s *Server) writeXML(w http.ResponseWriter, v any) {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(b)
+}
+
+func partETag(number int) string {
+	return fmt.Sprintf("%q", "part-"+strconv.Itoa(number))
+}
@@ -1,23 +1,18 @@
 package audit
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"time"
 
-	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
-	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 const bufferCapacity = 2048
@@ -36,33 +31,28 @@ type HTTPAuditLoggerInterface interface {
 }
 
 type AuditLoggerService struct {
-	logger          logger.Logger            // The standard logger configured in the node
-	enabled         bool                     // Whether the audit logger is enabled or not
-	forwardToUrl    commonconfig.URL         // Location we are going to send logs to
-	headers         []models.ServiceHeader   // Headers to be sent along with logs for identification/authentication
-	jsonWrapperKey  string                   // Wrap audit data as a map under this key if present
-	environmentName string                   // Decorate the environment this is coming from
-	hostname        string                   // The self-reported hostname of the machine
-	localIP         string                   // A non-loopback IP address as reported by the machine
-	loggingClient   HTTPAuditLoggerInterface // Abstract type for sending logs onward
-
-	loggingChannel chan wrappedAuditLog
+	logger          logger.Logger // The standard logger configured in the node
+	enabled         bool          // Whether the audit logger is enabled or not
+	environmentName string        // Decorate the environment this is coming from
+	hostname        string        // The self-reported hostname of the machine
+	localIP         string        // A non-loopback IP address as reported by the machine
+	batchSize       int           // Maximum number of events written to the sinks at once
+	batchInterval   time.Duration // Maximum time an event waits for its batch to fill up
+	sinks           []Sink        // Destinations of the audit events
+	httpSink        *httpSink     // The sink forwarding to the HTTP log service, if configured
+
+	loggingChannel chan Event
 	chStop         services.StopChan
 	chDone         chan struct{}
 }
 
-type wrappedAuditLog struct {
-	eventID EventID
-	data    Data
-}
-
 var NoopLogger AuditLogger = &AuditLoggerService{}
 
 // NewAuditLogger returns a buffer push system that ingests audit log events and
-// asynchronously pushes them up to an HTTP log service.
-// Parses and validates the AUDIT_LOGS_* environment values and returns an enabled
-// AuditLogger instance. If the environment variables are not set, the logger
-// is disabled and short circuits execution via enabled flag.
+// asynchronously writes them in batches to the configured sinks: an HTTP log service,
+// a local HMAC-chained file and syslog.
+// If the config is nil or disabled, or its forward URL or headers are invalid, the logger is
+// disabled and short circuits execution via enabled flag.
 func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogger, error) {
 	// If the unverified config is nil, then we assume this came from the
 	// configuration system and return a nil logger.
@@ -75,40 +65,62 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		return nil, fmt.Errorf("initialization error - unable to get hostname: %w", err)
 	}
 
-	forwardToUrl, err := config.ForwardToUrl()
-	if err != nil {
-		return &AuditLoggerService{}, nil
-	}
-
-	headers, err := config.Headers()
-	if err != nil {
-		return &AuditLoggerService{}, nil
-	}
-
-	loggingChannel := make(chan wrappedAuditLog, bufferCapacity)
-
-	// Create new AuditLoggerService
 	auditLogger := AuditLoggerService{
 		logger:          logger.Helper(1),
 		enabled:         true,
-		forwardToUrl:    forwardToUrl,
-		headers:         headers,
-		jsonWrapperKey:  config.JsonWrapperKey(),
 		environmentName: config.Environment(),
 		hostname:        hostname,
 		localIP:         getLocalIP(),
-		loggingClient:   &http.Client{Timeout: time.Second * webRequestTimeout},
+		batchSize:       max(int(config.BatchSize()), 1),
+		batchInterval:   config.BatchInterval(),
 
-		loggingChannel: loggingChannel,
+		loggingChannel: make(chan Event, bufferCapacity),
 		chStop:         make(chan struct{}),
 		chDone:         make(chan struct{}),
 	}
 
+	forwardToUrl, err := config.ForwardToUrl()
+	if err != nil {
+		logger.Errorw("Disabling the audit logger: invalid forward URL", "err", err)
+		return &AuditLoggerService{}, nil
+	}
+	if (*url.URL)(&forwardToUrl).String() != "" {
+		headers, err := config.Headers()
+		if err != nil {
+			logger.Errorw("Disabling the audit logger: invalid headers", "err", err)
+			return &AuditLoggerService{}, nil
+		}
+		auditLogger.httpSink = newHTTPSink(auditLogger.logger, httpSinkConfig{
+			url:               forwardToUrl,
+			headers:           headers,
+			jsonWrapperKey:    config.JsonWrapperKey(),
+			batchSize:         auditLogger.batchSize,
+			retryQueueDir:     config.RetryQueueDir(),
+			retryQueueMaxSize: config.RetryQueueMaxSize(),
+		})
+		auditLogger.sinks = append(auditLogger.sinks, auditLogger.httpSink)
+	}
+	if file := config.File(); file.Enabled() {
+		if file.ChainKey() == "" {
+			return nil, errors.New("initialization error - AuditLogger.File requires the AuditLogger.FileChainKey secret")
+		}
+		auditLogger.sinks = append(auditLogger.sinks, newFileSink(auditLogger.logger, file.Dir(), []byte(file.ChainKey()), file.MaxSize(), file.MaxBackups()))
+	}
+	if syslog := config.Syslog(); syslog.Enabled() {
+		auditLogger.sinks = append(auditLogger.sinks, newSyslogSink(syslog.Network(), syslog.Address(), syslog.Tag()))
+	}
+	if len(auditLogger.sinks) == 0 {
+		auditLogger.logger.Warn("The audit logger is enabled, but has no sinks: audit events will be dropped")
+	}
+
 	return &auditLogger, nil
 }
 
+// SetLoggingClient swaps the client used to forward the events to the HTTP log service.
 func (l *AuditLoggerService) SetLoggingClient(newClient HTTPAuditLoggerInterface) {
-	l.loggingClient = newClient
+	if l.httpSink != nil {
+		l.httpSink.client = newClient
+	}
 }
 
 // Entrypoint for new audit logs. This buffers all logs that come in they will
@@ -121,29 +133,42 @@ func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
 		return
 	}
 
-	wrappedLog := wrappedAuditLog{
-		eventID: eventID,
-		data:    data,
+	event := Event{
+		EventID:  eventID,
+		Time:     time.Now().UTC(),
+		Hostname: l.hostname,
+		LocalIP:  l.localIP,
+		Env:      l.environmentName,
+		Data:     data,
 	}
 
 	select {
-	case l.loggingChannel <- wrappedLog:
+	case l.loggingChannel <- event:
 	default:
 		l.logger.Errorf("buffer is full. Dropping log with eventID: %s", eventID)
 	}
 }
 
-// Start the audit logger and begin processing logs on the channel
-func (l *AuditLoggerService) Start(context.Context) error {
+// Start the audit logger and its sinks, and begin processing logs on the channel
+func (l *AuditLoggerService) Start(ctx context.Context) error {
 	if !l.enabled {
 		return errors.New("The audit logger is not enabled")
 	}
 
+	for i, sink := range l.sinks {
+		if err := sink.Start(ctx); err != nil {
+			for _, started := range l.sinks[:i] {
+				l.logger.ErrorIfFn(started.Close, "Error closing audit log sink "+started.Name())
+			}
+			return fmt.Errorf("failed to start audit log sink %s: %w", sink.Name(), err)
+		}
+	}
+
 	go l.runLoop()
 	return nil
 }
 
-// Stops the logger and will close the channel.
+// Stops the logger, flushing the buffered logs, and closes the sinks.
 func (l *AuditLoggerService) Close() error {
 	if !l.enabled {
 		return errors.New("The audit logger is not enabled")
@@ -153,7 +178,13 @@ func (l *AuditLoggerService) Close() error {
 	close(l.chStop)
 	<-l.chDone
 
-	return nil
+	var errs error
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to close audit log sink %s: %w", sink.Name(), err))
+		}
+	}
+	return errs
 }
 
 func (l *AuditLoggerService) Name() string {
@@ -178,80 +209,65 @@ func (l *AuditLoggerService) Ready() error {
 	return nil
 }
 
-// Entrypoint for our log handling goroutine. This waits on the channel and sends out
-// logs as they come in.
+// Entrypoint for our log handling goroutine. This waits on the channel and writes
+// the logs to the sinks once a batch is full, or BatchInterval after its first log.
+// On shutdown, the logs left in the channel are flushed before returning.
 //
-// This function calls postLogToLogService which blocks.
+// This function calls flush which blocks.
 func (l *AuditLoggerService) runLoop() {
 	defer close(l.chDone)
 
+	batch := make([]Event, 0, l.batchSize)
+	ticker := time.NewTicker(l.batchInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-l.chStop:
 			l.logger.Warn("The audit logger is shutting down")
-			return
+			// the whole drain shares one deadline, so that unreachable sinks cannot stall the shutdown
+			ctx, cancel := context.WithTimeout(context.Background(), webRequestTimeout*time.Second)
+			defer cancel()
+			for {
+				select {
+				case event := <-l.loggingChannel:
+					batch = append(batch, event)
+					if len(batch) == l.batchSize {
+						batch = l.flush(ctx, batch)
+					}
+				default:
+					l.flush(ctx, batch)
+					return
+				}
+			}
 		case event := <-l.loggingChannel:
-			l.postLogToLogService(event.eventID, event.data)
+			batch = append(batch, event)
+			if len(batch) == l.batchSize {
+				batch = l.flush(context.Background(), batch)
+			}
+		case <-ticker.C:
+			batch = l.flush(context.Background(), batch)
 		}
 	}
 }
 
-// Takes an EventID and associated data and sends it to the configured logging
-// endpoint. This function blocks on the send by timesout after a period of
-// several seconds. This helps us prevent getting stuck on a single log
-// due to transient network errors.
+// flush writes the batch to every sink and returns it emptied. A sink failing does not stop
+// the others from receiving the batch. Writes are bounded by webRequestTimeout, so that a
+// single slow sink cannot hold the logs back indefinitely.
 //
 // This function blocks when called.
-func (l *AuditLoggerService) postLogToLogService(eventID EventID, data Data) {
-	// Audit log JSON data
-	logItem := map[string]interface{}{
-		"eventID":  eventID,
-		"hostname": l.hostname,
-		"localIP":  l.localIP,
-		"env":      l.environmentName,
-		"data":     data,
-	}
-
-	// Optionally wrap audit log data into JSON object to help dynamically structure for an HTTP log service call
-	if l.jsonWrapperKey != "" {
-		logItem = map[string]interface{}{l.jsonWrapperKey: logItem}
-	}
-
-	serializedLog, err := json.Marshal(logItem)
-	if err != nil {
-		l.logger.Errorw("unable to serialize wrapped audit log item to JSON", "err", err, "logItem", logItem)
-		return
+func (l *AuditLoggerService) flush(ctx context.Context, batch []Event) []Event {
+	if len(batch) == 0 {
+		return batch
 	}
-	ctx, cancel := l.chStop.NewCtx()
-	defer cancel()
-
-	// Send to remote service
-	req, err := http.NewRequestWithContext(ctx, "POST", (*url.URL)(&l.forwardToUrl).String(), bytes.NewReader(serializedLog))
-	if err != nil {
-		l.logger.Error("failed to create request to remote logging service!")
-	}
-	for _, header := range l.headers {
-		req.Header.Add(header.Header, header.Value)
-	}
-	resp, err := l.loggingClient.Do(req)
-	if err != nil {
-		l.logger.Errorw("failed to send audit log to HTTP log service", "err", err, "logItem", logItem)
-		return
-	}
-	if resp.StatusCode != 200 {
-		if resp.Body == nil {
-			l.logger.Errorw("no body to read. Possibly an error occurred sending", "logItem", logItem)
-			return
-		}
-
-		bodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			l.logger.Errorw("error reading errored HTTP log service webhook response body", "err", err, "logItem", logItem)
-			return
+	for _, sink := range l.sinks {
+		ctx, cancel := context.WithTimeout(ctx, webRequestTimeout*time.Second)
+		if err := sink.Write(ctx, batch); err != nil {
+			l.logger.Errorw("failed to write audit logs", "sink", sink.Name(), "count", len(batch), "err", err)
 		}
-		l.logger.Errorw("error sending log to HTTP log service", "statusCode", resp.StatusCode, "bodyString", string(bodyBytes))
-		return
+		cancel()
 	}
+	return batch[:0]
 }
 
 // getLocalIP returns the first non-loopback local IP of the host
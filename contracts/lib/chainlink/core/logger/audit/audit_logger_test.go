@@ -2,6 +2,7 @@ package audit_test
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"io"
 	"net/http"
@@ -11,13 +12,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"go.uber.org/zap/zapcore"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type MockedHTTPEvent struct {
@@ -53,7 +57,7 @@ func (mock *MockHTTPClient) Do(req *http.Request) (*http.Response, error) {
 
 	mock.loggingChannel <- message
 
-	return &http.Response{}, nil
+	return &http.Response{StatusCode: http.StatusOK}, nil
 }
 
 type Config struct{}
@@ -82,6 +86,64 @@ func (c Config) JsonWrapperKey() string {
 	return ""
 }
 
+func (c Config) KeyUsageStore() bool {
+	return false
+}
+
+func (c Config) BatchSize() uint32 {
+	return 1
+}
+
+func (c Config) BatchInterval() time.Duration {
+	return time.Second
+}
+
+func (c Config) RetryQueueDir() string {
+	return ""
+}
+
+func (c Config) RetryQueueMaxSize() utils.FileSize {
+	return 0
+}
+
+func (c Config) File() config.AuditLoggerFile {
+	return disabledSink{}
+}
+
+func (c Config) Syslog() config.AuditLoggerSyslog {
+	return disabledSink{}
+}
+
+type disabledSink struct{}
+
+func (disabledSink) Enabled() bool           { return false }
+func (disabledSink) Dir() string             { return "" }
+func (disabledSink) MaxSize() utils.FileSize { return 0 }
+func (disabledSink) MaxBackups() int64       { return 0 }
+func (disabledSink) ChainKey() string        { return "" }
+func (disabledSink) Network() string         { return "" }
+func (disabledSink) Address() string         { return "" }
+func (disabledSink) Tag() string             { return "" }
+
+type invalidHeadersConfig struct {
+	Config
+}
+
+func (c invalidHeadersConfig) Headers() (models.ServiceHeaders, error) {
+	return nil, errors.New("invalid header")
+}
+
+func TestNewAuditLogger_InvalidHeaders(t *testing.T) {
+	t.Parallel()
+
+	lggr, observed := logger.TestLoggerObserved(t, zapcore.ErrorLevel)
+	auditLogger, err := audit.NewAuditLogger(lggr, invalidHeadersConfig{})
+	require.NoError(t, err)
+
+	require.ErrorContains(t, auditLogger.Start(testutils.Context(t)), "not enabled")
+	require.Equal(t, 1, observed.FilterMessage("Disabling the audit logger: invalid headers").Len())
+}
+
 func TestCheckLoginAuditLog(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,11 @@ const (
 	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
 	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
 	AuthSessionDeleted      EventID = "SESSION_DELETED"
+	AuthSessionRevoked      EventID = "SESSION_REVOKED"
+	AuthUserSessionsRevoked EventID = "USER_SESSIONS_REVOKED"
+	AuthLoginLockedOut      EventID = "AUTH_LOGIN_LOCKED_OUT"
+	AuthLoginIPLockedOut    EventID = "AUTH_LOGIN_IP_LOCKED_OUT"
+	AuthUserUnlocked        EventID = "AUTH_USER_UNLOCKED"
 
 	PasswordResetAttemptFailedMismatch EventID = "PASSWORD_RESET_ATTEMPT_FAILED_MISMATCH"
 	PasswordResetSuccess               EventID = "PASSWORD_RESET_SUCCESS"
@@ -20,6 +25,12 @@ const (
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
 
+	CustomRoleCreated    EventID = "CUSTOM_ROLE_CREATED"
+	CustomRoleUpdated    EventID = "CUSTOM_ROLE_UPDATED"
+	CustomRoleDeleted    EventID = "CUSTOM_ROLE_DELETED"
+	CustomRoleAssigned   EventID = "CUSTOM_ROLE_ASSIGNED"
+	CustomRoleUnassigned EventID = "CUSTOM_ROLE_UNASSIGNED"
+
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
@@ -50,6 +61,7 @@ const (
 	KeyImported EventID = "KEY_IMPORTED"
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
+	KeyUsed     EventID = "KEY_USED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
@@ -81,14 +93,29 @@ const (
 	JobProposalSpecRejected EventID = "JOB_PROPOSAL_SPEC_REJECTED"
 
 	ConfigUpdated            EventID = "CONFIG_UPDATED"
+	ConfigReloaded           EventID = "CONFIG_RELOADED"
 	ConfigSqlLoggingEnabled  EventID = "CONFIG_SQL_LOGGING_ENABLED"
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
+	JobLogLevelSet           EventID = "JOB_LOG_LEVEL_SET"
+	JobLogLevelCleared       EventID = "JOB_LOG_LEVEL_CLEARED"
 
-	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
-	JobRunSet         EventID = "JOB_RUN_SET"
+	JobErrorDismissed  EventID = "JOB_ERROR_DISMISSED"
+	JobRunSet          EventID = "JOB_RUN_SET"
+	VRFRequestRetried  EventID = "VRF_REQUEST_RETRIED"
+	BHSBackfillStarted EventID = "BHS_BACKFILL_STARTED"
 
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
+	ProfileCaptureRequested EventID = "PROFILE_CAPTURE_REQUESTED"
+	ProfilesDownloaded      EventID = "PROFILES_DOWNLOADED"
+
+	SupportBundleDownloaded EventID = "SUPPORT_BUNDLE_DOWNLOADED"
+
+	NodeDrainStarted  EventID = "NODE_DRAIN_STARTED"
+	NodeDrainCanceled EventID = "NODE_DRAIN_CANCELED"
+
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
+
+	AuditLogChainReset EventID = "AUDIT_LOG_CHAIN_RESET"
 )
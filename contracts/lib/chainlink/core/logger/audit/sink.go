@@ -0,0 +1,26 @@
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Event is an audit event, as delivered to the sinks.
+type Event struct {
+	EventID  EventID   `json:"eventID"`
+	Time     time.Time `json:"time"`
+	Hostname string    `json:"hostname"`
+	LocalIP  string    `json:"localIP"`
+	Env      string    `json:"env"`
+	Data     Data      `json:"data"`
+}
+
+// Sink is a destination of the audit events. Events are written in batches, in the order they
+// were audited, from a single goroutine.
+type Sink interface {
+	Name() string
+	Start(ctx context.Context) error
+	// Write delivers a batch of events. The batch must not be retained after Write returns.
+	Write(ctx context.Context, events []Event) error
+	Close() error
+}
@@ -0,0 +1,521 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// CurrentFileName is the audit log file being written to.
+	CurrentFileName = "audit.jsonl"
+	// AnchorFileName holds the last record of the newest pruned file, which the chain of the
+	// remaining files carries on from.
+	AnchorFileName = "audit.anchor"
+
+	rotatedFilePrefix = "audit-"
+	rotatedFileSuffix = ".jsonl"
+	// brokenDirPrefix names the directories the files of a broken chain are moved to.
+	brokenDirPrefix = "broken-"
+	// rotatedTimeFormat sorts lexically in time order.
+	rotatedTimeFormat = "20060102T150405.000000000Z"
+)
+
+// errBrokenChain is returned when the last records of the audit log files cannot be authenticated.
+var errBrokenChain = errors.New("audit log chain is broken")
+
+// ChainState is the position in the chain of the audit log file: the sequence number and the
+// HMAC of the last record.
+type ChainState struct {
+	Seq  uint64
+	Hash string
+}
+
+// chainRecord is authenticated and written as is, so that the HMAC can be verified from the file
+// alone.
+type chainRecord struct {
+	Seq      uint64 `json:"seq"`
+	PrevHash string `json:"prevHash"`
+	Event    Event  `json:"event"`
+}
+
+type chainLine struct {
+	Hash   string          `json:"hash"`
+	Record json.RawMessage `json:"record"`
+}
+
+// fileSink writes the events to a local file, one JSON line each. Every line holds the HMAC of its
+// record, which includes the HMAC of the previous record, so that editing, removing or reordering
+// lines breaks the chain, and the chain cannot be rebuilt without the key. The chain continues
+// across rotated files, and starts from the anchor once the oldest files are pruned.
+type fileSink struct {
+	lggr       logger.Logger
+	dir        string
+	key        []byte
+	maxSize    utils.FileSize
+	maxBackups int64
+	now        func() time.Time
+
+	f     *os.File
+	size  int64
+	state ChainState
+}
+
+func newFileSink(lggr logger.Logger, dir string, key []byte, maxSize utils.FileSize, maxBackups int64) *fileSink {
+	return &fileSink{lggr: lggr, dir: dir, key: key, maxSize: maxSize, maxBackups: maxBackups, now: time.Now}
+}
+
+func (s *fileSink) Name() string { return "File" }
+
+// Start carries on the chain of the audit log files. If it is broken, the files are moved aside
+// and a new segment is started, so that a damaged file does not keep the node from auditing.
+func (s *fileSink) Start(context.Context) error {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	state, err := s.recoverChain()
+	if errors.Is(err, errBrokenChain) {
+		s.lggr.Errorw("The audit log chain is broken, moving its files aside and starting a new segment", "err", err)
+		state, err = s.startSegment(err)
+	}
+	if err != nil {
+		return err
+	}
+	s.state = state
+	return s.open()
+}
+
+// recoverChain cleans up after an interrupted write or pruning, and returns the state the chain
+// carries on from.
+func (s *fileSink) recoverChain() (ChainState, error) {
+	torn, err := truncateTornLine(filepath.Join(s.dir, CurrentFileName))
+	if err != nil {
+		return ChainState{}, err
+	}
+	if torn > 0 {
+		s.lggr.Warnw("Removed the incomplete last line of the audit log file, left by an interrupted write", "bytes", torn)
+	}
+	if err = s.removePrunedFiles(); err != nil {
+		return ChainState{}, err
+	}
+	return lastChainState(s.dir, s.key)
+}
+
+// startSegment moves the audit log files and the anchor to a new directory, and anchors the new
+// chain to a record of the reset. The record is authenticated like the others, so that a reset
+// cannot be forged without the key.
+func (s *fileSink) startSegment(cause error) (ChainState, error) {
+	now := s.now().UTC()
+	broken := filepath.Join(s.dir, brokenDirPrefix+now.Format(rotatedTimeFormat))
+	if err := os.Mkdir(broken, 0700); err != nil {
+		return ChainState{}, fmt.Errorf("failed to move the broken audit log files aside: %w", err)
+	}
+	files, err := ChainFiles(s.dir)
+	if err != nil {
+		return ChainState{}, err
+	}
+	if _, err = os.Stat(filepath.Join(s.dir, AnchorFileName)); err == nil {
+		files = append(files, filepath.Join(s.dir, AnchorFileName))
+	}
+	for _, file := range files {
+		if err = os.Rename(file, filepath.Join(broken, filepath.Base(file))); err != nil {
+			return ChainState{}, fmt.Errorf("failed to move the broken audit log files aside: %w", err)
+		}
+	}
+
+	record, err := json.Marshal(chainRecord{Event: Event{
+		EventID: AuditLogChainReset,
+		Time:    now,
+		Data:    Data{"reason": cause.Error(), "brokenFilesDir": broken},
+	}})
+	if err != nil {
+		return ChainState{}, fmt.Errorf("unable to serialize audit log to JSON: %w", err)
+	}
+	hash := hashRecord(s.key, record)
+	if err = writeAnchor(s.dir, []byte(fmt.Sprintf(`{"hash":%q,"record":%s}`, hash, record))); err != nil {
+		return ChainState{}, err
+	}
+	return ChainState{Hash: hash}, nil
+}
+
+func (s *fileSink) open() error {
+	f, err := os.OpenFile(filepath.Join(s.dir, CurrentFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to open audit log file: %w", err), f.Close())
+	}
+	s.f, s.size = f, info.Size()
+	return nil
+}
+
+func (s *fileSink) Close() error {
+	if s.f == nil {
+		return nil
+	}
+	return errors.Join(s.f.Sync(), s.f.Close())
+}
+
+func (s *fileSink) Write(_ context.Context, events []Event) error {
+	var buf bytes.Buffer
+	state := s.state
+	for _, event := range events {
+		record, err := json.Marshal(chainRecord{Seq: state.Seq + 1, PrevHash: state.Hash, Event: event})
+		if err != nil {
+			return fmt.Errorf("unable to serialize audit log to JSON: %w", err)
+		}
+		hash := hashRecord(s.key, record)
+		line := fmt.Sprintf(`{"hash":%q,"record":%s}`+"\n", hash, record)
+
+		if s.maxSize > 0 && s.size+int64(buf.Len()) > 0 && s.size+int64(buf.Len()+len(line)) > int64(s.maxSize) {
+			if err = s.writeAndSync(buf.Bytes()); err != nil {
+				return err
+			}
+			s.state = state
+			buf.Reset()
+			if err = s.rotate(); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(line)
+		state = ChainState{Seq: state.Seq + 1, Hash: hash}
+	}
+	if err := s.writeAndSync(buf.Bytes()); err != nil {
+		return err
+	}
+	s.state = state
+	return nil
+}
+
+// writeAndSync appends b to the current file. On failure, the file is truncated back to its
+// previous size, so that the next write carries on from the last record written.
+func (s *fileSink) writeAndSync(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	_, err := s.f.Write(b)
+	if err == nil {
+		err = s.f.Sync()
+	}
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to write audit log file: %w", err), s.f.Truncate(s.size))
+	}
+	s.size += int64(len(b))
+	return nil
+}
+
+// rotate moves the current file aside and prunes the rotated files above maxBackups.
+func (s *fileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return fmt.Errorf("failed to close audit log file: %w", err)
+	}
+	rotated := rotatedFilePrefix + s.now().UTC().Format(rotatedTimeFormat) + rotatedFileSuffix
+	if err := os.Rename(filepath.Join(s.dir, CurrentFileName), filepath.Join(s.dir, rotated)); err != nil {
+		return fmt.Errorf("failed to rotate audit log file: %w", err)
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+	if s.maxBackups <= 0 {
+		return nil
+	}
+	files, err := rotatedFiles(s.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > int(s.maxBackups) {
+		if err = s.prune(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// prune moves the anchor to the last record of the rotated file at path, then removes the file.
+func (s *fileSink) prune(path string) error {
+	line, err := lastLine(path)
+	if err != nil {
+		return err
+	}
+	if len(line) > 0 {
+		if err = writeAnchor(s.dir, line); err != nil {
+			return err
+		}
+	}
+	if err = os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove rotated audit log file: %w", err)
+	}
+	return nil
+}
+
+// removePrunedFiles removes the rotated files whose records are all covered by the anchor, left
+// behind if the node stopped while pruning.
+func (s *fileSink) removePrunedFiles() error {
+	anchor, err := ReadChainAnchor(s.dir, s.key)
+	if err != nil || anchor == (ChainState{}) {
+		return err
+	}
+	files, err := rotatedFiles(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		line, err := lastLine(file)
+		if err != nil {
+			return err
+		}
+		if len(line) > 0 {
+			record, _, err := readLine(line, s.key)
+			if err != nil {
+				return fmt.Errorf("%w: file %s is corrupted: %w", errBrokenChain, file, err)
+			}
+			if record.Seq > anchor.Seq {
+				return nil
+			}
+		}
+		if err = os.Remove(file); err != nil {
+			return fmt.Errorf("failed to remove rotated audit log file: %w", err)
+		}
+	}
+	return nil
+}
+
+func writeAnchor(dir string, line []byte) error {
+	tmp, err := os.CreateTemp(dir, AnchorFileName+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write audit log anchor: %w", err)
+	}
+	_, err = tmp.Write(append(line, '\n'))
+	if err == nil {
+		err = tmp.Sync()
+	}
+	err = errors.Join(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(dir, AnchorFileName))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write audit log anchor: %w", err)
+	}
+	return nil
+}
+
+func hashRecord(key []byte, record []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(record)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ChainFiles returns the audit log files in dir in chain order: the rotated files, oldest first,
+// then the current file.
+func ChainFiles(dir string) ([]string, error) {
+	files, err := rotatedFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	current := filepath.Join(dir, CurrentFileName)
+	if _, err = os.Stat(current); err == nil {
+		files = append(files, current)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return files, nil
+}
+
+func rotatedFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log directory: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), rotatedFilePrefix) && strings.HasSuffix(entry.Name(), rotatedFileSuffix) {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// ReadChainAnchor returns the state the chain of the audit log files in dir starts from: the last
+// record pruned, or the zero ChainState if none was.
+func ReadChainAnchor(dir string, key []byte) (ChainState, error) {
+	b, err := os.ReadFile(filepath.Join(dir, AnchorFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return ChainState{}, nil
+	} else if err != nil {
+		return ChainState{}, fmt.Errorf("failed to read audit log anchor: %w", err)
+	}
+	record, hash, err := readLine(bytes.TrimSpace(b), key)
+	if err != nil {
+		return ChainState{}, fmt.Errorf("%w: anchor is corrupted: %w", errBrokenChain, err)
+	}
+	return ChainState{Seq: record.Seq, Hash: hash}, nil
+}
+
+// lastChainState returns the state at the end of the newest non-empty audit log file in dir, or
+// the anchor if there is none, so that the chain carries on after a restart.
+func lastChainState(dir string, key []byte) (ChainState, error) {
+	files, err := ChainFiles(dir)
+	if err != nil {
+		return ChainState{}, err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		line, err := lastLine(files[i])
+		if err != nil {
+			return ChainState{}, err
+		}
+		if len(line) == 0 {
+			continue
+		}
+		record, hash, err := readLine(line, key)
+		if err != nil {
+			return ChainState{}, fmt.Errorf("%w: file %s is corrupted: %w", errBrokenChain, files[i], err)
+		}
+		return ChainState{Seq: record.Seq, Hash: hash}, nil
+	}
+	return ReadChainAnchor(dir, key)
+}
+
+// truncateTornLine removes the incomplete line left at the end of the file at path by a write
+// interrupted by a crash, returning the number of bytes removed.
+func truncateTornLine(path string) (int64, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	const chunkSize = 4096
+	var keep int64
+	for end := info.Size(); end > 0; {
+		start := max(end-chunkSize, 0)
+		chunk := make([]byte, end-start)
+		if _, err = f.ReadAt(chunk, start); err != nil {
+			return 0, fmt.Errorf("failed to read audit log file: %w", err)
+		}
+		if i := bytes.LastIndexByte(chunk, '\n'); i >= 0 {
+			keep = start + int64(i) + 1
+			break
+		}
+		end = start
+	}
+	if keep == info.Size() {
+		return 0, nil
+	}
+	if err = f.Truncate(keep); err != nil {
+		return 0, fmt.Errorf("failed to truncate audit log file: %w", err)
+	}
+	return info.Size() - keep, f.Sync()
+}
+
+// lastLine returns the last line of the file at path, read from the end of the file.
+func lastLine(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	const chunkSize = 4096
+	var tail []byte
+	for end := info.Size(); end > 0; {
+		start := max(end-chunkSize, 0)
+		chunk := make([]byte, end-start)
+		if _, err = f.ReadAt(chunk, start); err != nil {
+			return nil, fmt.Errorf("failed to read audit log file: %w", err)
+		}
+		tail = append(chunk, tail...)
+		end = start
+		trimmed := bytes.TrimRight(tail, "\n")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return trimmed[i+1:], nil
+		}
+	}
+	return bytes.TrimRight(tail, "\n"), nil
+}
+
+// VerifyChain verifies the HMAC chain of the audit log lines read from r, continuing from prev:
+// the zero ChainState at the start of the chain, or the state returned by ReadChainAnchor if the
+// oldest files were pruned. It returns the state at the last record and the number of records
+// verified, or an error locating the first record that was tampered with.
+func VerifyChain(r io.Reader, key []byte, prev ChainState) (ChainState, int, error) {
+	br := bufio.NewReader(r)
+	var verified int
+	for lineNum := 1; ; lineNum++ {
+		line, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return prev, verified, err
+		}
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+			state, verr := verifyLine(trimmed, key, prev)
+			if verr != nil {
+				return prev, verified, fmt.Errorf("line %d: %w", lineNum, verr)
+			}
+			prev = state
+			verified++
+		}
+		if err != nil {
+			return prev, verified, nil
+		}
+	}
+}
+
+func verifyLine(line []byte, key []byte, prev ChainState) (ChainState, error) {
+	record, hash, err := readLine(line, key)
+	if err != nil {
+		return prev, err
+	}
+	if record.PrevHash != prev.Hash {
+		return prev, fmt.Errorf("chain broken at record %d: previous hash is %q, expected %q", record.Seq, record.PrevHash, prev.Hash)
+	}
+	if record.Seq != prev.Seq+1 {
+		return prev, fmt.Errorf("chain broken at record %d: expected record %d", record.Seq, prev.Seq+1)
+	}
+	return ChainState{Seq: record.Seq, Hash: hash}, nil
+}
+
+// readLine authenticates an audit log line, returning its record and HMAC.
+func readLine(line []byte, key []byte) (chainRecord, string, error) {
+	var l chainLine
+	if err := json.Unmarshal(line, &l); err != nil {
+		return chainRecord{}, "", fmt.Errorf("invalid audit log line: %w", err)
+	}
+	if hash := hashRecord(key, l.Record); !hmac.Equal([]byte(hash), []byte(l.Hash)) {
+		// the expected HMAC is not reported, as it would allow forging the record
+		return chainRecord{}, "", errors.New("record hash mismatch")
+	}
+	var record chainRecord
+	if err := json.Unmarshal(l.Record, &record); err != nil {
+		return chainRecord{}, "", fmt.Errorf("invalid audit log record: %w", err)
+	}
+	return record, l.Hash, nil
+}
@@ -0,0 +1,258 @@
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func testEvents(n int) []Event {
+	events := make([]Event, n)
+	for i := range events {
+		events[i] = Event{
+			EventID:  AuthLoginSuccessNo2FA,
+			Time:     time.Date(2024, 1, 1, 0, 0, i, 0, time.UTC),
+			Hostname: "node",
+			Env:      "test",
+			Data:     Data{"email": fmt.Sprintf("user%d@example.com", i)},
+		}
+	}
+	return events
+}
+
+var testChainKey = []byte("audit-chain-key-0123456789abcdef0123")
+
+func newTestFileSink(t *testing.T, dir string, maxSize utils.FileSize, maxBackups int64) *fileSink {
+	return newFileSink(logger.TestLogger(t), dir, testChainKey, maxSize, maxBackups)
+}
+
+func verifyFiles(t *testing.T, dir string) (ChainState, int, error) {
+	files, err := ChainFiles(dir)
+	require.NoError(t, err)
+	state, err := ReadChainAnchor(dir, testChainKey)
+	require.NoError(t, err)
+	var total int
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		require.NoError(t, err)
+		var n int
+		state, n, err = VerifyChain(bytes.NewReader(b), testChainKey, state)
+		total += n
+		if err != nil {
+			return state, total, err
+		}
+	}
+	return state, total, nil
+}
+
+func TestFileSink_Chain(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	sink := newTestFileSink(t, dir, 0, 0)
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(3)))
+	require.NoError(t, sink.Close())
+
+	// the chain carries on after a restart
+	sink = newTestFileSink(t, dir, 0, 0)
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(2)))
+	require.NoError(t, sink.Close())
+
+	state, n, err := verifyFiles(t, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, uint64(5), state.Seq)
+	assert.Equal(t, sink.state, state)
+}
+
+func TestFileSink_Tampering(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	sink := newTestFileSink(t, dir, 0, 0)
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(4)))
+	require.NoError(t, sink.Close())
+
+	b, err := os.ReadFile(filepath.Join(dir, CurrentFileName))
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(b), "\n"), "\n")
+	require.Len(t, lines, 4)
+
+	for name, tc := range map[string]struct {
+		lines []string
+		err   string
+	}{
+		"edited":    {[]string{lines[0], strings.Replace(lines[1], "user1@", "evil@", 1), lines[2], lines[3]}, "line 2: record hash mismatch"},
+		"removed":   {[]string{lines[0], lines[2], lines[3]}, "line 2: chain broken at record 3"},
+		"reordered": {[]string{lines[0], lines[2], lines[1], lines[3]}, "line 2: chain broken at record 3"},
+		"garbage":   {[]string{lines[0], "not json\n", lines[2], lines[3]}, "line 2: invalid audit log line"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state, n, err := VerifyChain(strings.NewReader(strings.Join(tc.lines, "")), testChainKey, ChainState{})
+			require.ErrorContains(t, err, tc.err)
+			assert.Equal(t, 1, n)
+			assert.Equal(t, uint64(1), state.Seq)
+		})
+	}
+
+	t.Run("truncated head", func(t *testing.T) {
+		_, n, err := VerifyChain(strings.NewReader(strings.Join(lines[2:], "")), testChainKey, ChainState{})
+		require.ErrorContains(t, err, "line 1: chain broken at record 3")
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("rebuilt without the key", func(t *testing.T) {
+		_, n, err := VerifyChain(strings.NewReader(strings.Join(lines, "")), []byte("another key"), ChainState{})
+		require.ErrorContains(t, err, "line 1: record hash mismatch")
+		assert.Equal(t, 0, n)
+	})
+}
+
+func TestFileSink_TornLine(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	sink := newTestFileSink(t, dir, 0, 0)
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(2)))
+	require.NoError(t, sink.Close())
+
+	// a crash in the middle of a write leaves an incomplete last line
+	path := filepath.Join(dir, CurrentFileName)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"hash":"abc","record":{"seq":3,`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	sink = newTestFileSink(t, dir, 0, 0)
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(1)))
+	require.NoError(t, sink.Close())
+
+	state, n, err := verifyFiles(t, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, uint64(3), state.Seq)
+}
+
+func TestFileSink_BrokenChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	sink := newTestFileSink(t, dir, 0, 0)
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(3)))
+	require.NoError(t, sink.Close())
+
+	// the last record is edited, so the chain cannot carry on from it
+	path := filepath.Join(dir, CurrentFileName)
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bytes.Replace(b, []byte("user2@"), []byte("evil@"), 1), 0600))
+
+	sink = newTestFileSink(t, dir, 0, 0)
+	sink.now = func() time.Time { return time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC) }
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(2)))
+	require.NoError(t, sink.Close())
+
+	// the broken files are kept aside, and the new segment is anchored to a record of the reset
+	moved, err := os.ReadFile(filepath.Join(dir, "broken-20240102T000000.000000000Z", CurrentFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(moved), "evil@")
+	anchor, err := os.ReadFile(filepath.Join(dir, AnchorFileName))
+	require.NoError(t, err)
+	record, _, err := readLine(bytes.TrimSpace(anchor), testChainKey)
+	require.NoError(t, err)
+	assert.Equal(t, AuditLogChainReset, record.Event.EventID)
+	assert.Contains(t, record.Event.Data["reason"], "record hash mismatch")
+
+	state, n, err := verifyFiles(t, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, uint64(2), state.Seq)
+}
+
+func TestFileSink_WriteFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	sink := newTestFileSink(t, dir, 0, 0)
+	require.NoError(t, sink.Start(ctx))
+	require.NoError(t, sink.Write(ctx, testEvents(2)))
+	state := sink.state
+
+	require.NoError(t, sink.f.Close())
+	require.Error(t, sink.Write(ctx, testEvents(1)))
+	assert.Equal(t, state, sink.state, "the chain must not advance past a failed write")
+
+	require.NoError(t, sink.open())
+	require.NoError(t, sink.Write(ctx, testEvents(1)))
+	require.NoError(t, sink.Close())
+
+	state, n, err := verifyFiles(t, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, uint64(3), state.Seq)
+}
+
+func TestFileSink_Rotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	sink := newTestFileSink(t, dir, utils.FileSize(1*utils.KB), 2)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sink.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	require.NoError(t, sink.Start(ctx))
+	for i := 0; i < 10; i++ {
+		require.NoError(t, sink.Write(ctx, testEvents(3)))
+	}
+	require.NoError(t, sink.Close())
+
+	files, err := ChainFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3, "2 backups and the current file")
+	for _, file := range files {
+		info, err := os.Stat(file)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(1*utils.KB))
+	}
+
+	// the oldest records were pruned, but the remaining ones still form a chain from the anchor
+	anchor, err := ReadChainAnchor(dir, testChainKey)
+	require.NoError(t, err)
+	assert.Positive(t, anchor.Seq)
+	state, n, err := verifyFiles(t, dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(30), state.Seq)
+	assert.Equal(t, 30-int(anchor.Seq), n)
+
+	// without the anchor, the pruning cannot be told apart from removing the oldest records
+	require.NoError(t, os.Remove(filepath.Join(dir, AnchorFileName)))
+	_, _, err = verifyFiles(t, dir)
+	require.ErrorContains(t, err, "line 1: chain broken")
+}
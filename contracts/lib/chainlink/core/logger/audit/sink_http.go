@@ -0,0 +1,282 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// retryInterval is how often the queued payloads are sent again.
+	retryInterval = 10 * time.Second
+	// maxErrorBodySize bounds how much of an error response is reported.
+	maxErrorBodySize = 1024
+	retryFileSuffix  = ".json"
+)
+
+type httpSinkConfig struct {
+	url               commonconfig.URL       // Location we are going to send logs to
+	headers           []models.ServiceHeader // Headers to be sent along with logs for identification/authentication
+	jsonWrapperKey    string                 // Wrap audit data as a map under this key if present
+	batchSize         int                    // Batches are sent as JSON arrays, unless the batch size is 1
+	retryQueueDir     string                 // Where the payloads that could not be sent are queued
+	retryQueueMaxSize utils.FileSize         // Maximum size of the retry queue, zero disables it
+}
+
+// httpSink forwards the events to an HTTP log service. When the retry queue is enabled, the
+// payloads that cannot be sent are queued on disk and sent again in order, so that an outage of
+// the log service does not lose events.
+type httpSink struct {
+	lggr   logger.Logger
+	cfg    httpSinkConfig
+	client HTTPAuditLoggerInterface // Abstract type for sending logs onward
+
+	mu      sync.Mutex // guards the retry queue and serializes sends, to keep the events in order
+	nextSeq uint64     // number of the next queued payload
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func newHTTPSink(lggr logger.Logger, cfg httpSinkConfig) *httpSink {
+	return &httpSink{
+		lggr:   lggr,
+		cfg:    cfg,
+		client: &http.Client{Timeout: time.Second * webRequestTimeout},
+		stopCh: make(chan struct{}),
+	}
+}
+
+func (s *httpSink) Name() string { return "HTTP" }
+
+func (s *httpSink) retryQueueEnabled() bool { return s.cfg.retryQueueMaxSize > 0 }
+
+func (s *httpSink) Start(context.Context) error {
+	if !s.retryQueueEnabled() {
+		return nil
+	}
+	if err := os.MkdirAll(s.cfg.retryQueueDir, 0700); err != nil {
+		return fmt.Errorf("failed to create retry queue directory: %w", err)
+	}
+	queued, err := s.queuedFiles()
+	if err != nil {
+		return err
+	}
+	if len(queued) > 0 {
+		last, _ := strconv.ParseUint(strings.TrimSuffix(queued[len(queued)-1].Name(), retryFileSuffix), 10, 64)
+		s.nextSeq = last + 1
+		s.lggr.Infow("Resuming audit log retry queue", "queued", len(queued))
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(retryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+				ctx, cancel := s.stopCh.CtxWithTimeout(webRequestTimeout * time.Second)
+				s.retry(ctx)
+				cancel()
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	close(s.stopCh)
+	s.wg.Wait()
+	return nil
+}
+
+func (s *httpSink) Write(ctx context.Context, events []Event) error {
+	payload, err := s.marshal(events)
+	if err != nil {
+		return fmt.Errorf("unable to serialize audit logs to JSON: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.retryQueueEnabled() {
+		queued, err := s.queuedFiles()
+		if err != nil {
+			return err
+		}
+		if len(queued) > 0 {
+			// older payloads are still waiting, queue behind them to keep the events in order
+			return s.enqueue(payload)
+		}
+	}
+
+	err = s.post(ctx, payload)
+	if err != nil && s.retryQueueEnabled() {
+		if qerr := s.enqueue(payload); qerr != nil {
+			return errors.Join(err, qerr)
+		}
+		return fmt.Errorf("%w: queued for retry", err)
+	}
+	return err
+}
+
+// marshal serializes the events in the payload format of the HTTP log service.
+func (s *httpSink) marshal(events []Event) ([]byte, error) {
+	items := make([]map[string]any, len(events))
+	for i, event := range events {
+		// Audit log JSON data
+		logItem := map[string]any{
+			"eventID":  event.EventID,
+			"time":     event.Time,
+			"hostname": event.Hostname,
+			"localIP":  event.LocalIP,
+			"env":      event.Env,
+			"data":     event.Data,
+		}
+		// Optionally wrap audit log data into JSON object to help dynamically structure for an HTTP log service call
+		if s.cfg.jsonWrapperKey != "" {
+			logItem = map[string]any{s.cfg.jsonWrapperKey: logItem}
+		}
+		items[i] = logItem
+	}
+	if s.cfg.batchSize == 1 && len(items) == 1 {
+		return json.Marshal(items[0])
+	}
+	return json.Marshal(items)
+}
+
+// post sends a payload to the HTTP log service.
+func (s *httpSink) post(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, (*url.URL)(&s.cfg.url).String(), bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request to remote logging service: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for _, header := range s.cfg.headers {
+		req.Header.Add(header.Header, header.Value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send audit logs to HTTP log service: %w", err)
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var body []byte
+		if resp.Body != nil {
+			body, _ = io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		}
+		return fmt.Errorf("error sending audit logs to HTTP log service: status code %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// retry sends the queued payloads in order, stopping at the first failure.
+func (s *httpSink) retry(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queued, err := s.queuedFiles()
+	if err != nil {
+		s.lggr.Errorw("Failed to read audit log retry queue", "err", err)
+		return
+	}
+	for _, entry := range queued {
+		path := filepath.Join(s.cfg.retryQueueDir, entry.Name())
+		payload, err := os.ReadFile(path)
+		if err != nil {
+			s.lggr.Errorw("Failed to read queued audit logs", "file", path, "err", err)
+			return
+		}
+		if err = s.post(ctx, payload); err != nil {
+			s.lggr.Warnw("Failed to send queued audit logs, will retry", "queued", len(queued), "err", err)
+			return
+		}
+		if err = os.Remove(path); err != nil {
+			s.lggr.Errorw("Failed to remove sent audit logs from the retry queue", "file", path, "err", err)
+			return
+		}
+	}
+	if len(queued) > 0 {
+		s.lggr.Infow("Sent queued audit logs", "count", len(queued))
+	}
+}
+
+// enqueue writes a payload to the retry queue, dropping the oldest payloads once the queue is
+// larger than its maximum size.
+func (s *httpSink) enqueue(payload []byte) error {
+	name := fmt.Sprintf("%020d%s", s.nextSeq, retryFileSuffix)
+	tmp := filepath.Join(s.cfg.retryQueueDir, "."+name+".tmp")
+	if err := os.WriteFile(tmp, payload, 0600); err != nil {
+		return fmt.Errorf("failed to queue audit logs: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(s.cfg.retryQueueDir, name)); err != nil {
+		return fmt.Errorf("failed to queue audit logs: %w", err)
+	}
+	s.nextSeq++
+
+	queued, err := s.queuedFiles()
+	if err != nil {
+		return err
+	}
+	var total int64
+	sizes := make([]int64, len(queued))
+	for i, entry := range queued {
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to read audit log retry queue: %w", err)
+		}
+		sizes[i] = info.Size()
+		total += sizes[i]
+	}
+	var dropped int
+	for i := 0; total > int64(s.cfg.retryQueueMaxSize) && i < len(queued)-1; i++ {
+		if err := os.Remove(filepath.Join(s.cfg.retryQueueDir, queued[i].Name())); err != nil {
+			return fmt.Errorf("failed to drop queued audit logs: %w", err)
+		}
+		total -= sizes[i]
+		dropped++
+	}
+	if dropped > 0 {
+		s.lggr.Errorw("Audit log retry queue is full, dropped the oldest queued audit logs", "dropped", dropped, "maxSize", s.cfg.retryQueueMaxSize)
+	}
+	return nil
+}
+
+// queuedFiles returns the payloads in the retry queue, oldest first.
+func (s *httpSink) queuedFiles() ([]os.DirEntry, error) {
+	entries, err := os.ReadDir(s.cfg.retryQueueDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log retry queue: %w", err)
+	}
+	queued := entries[:0]
+	for _, entry := range entries {
+		// zero padded names, so that the directory order is the queue order
+		if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") && strings.HasSuffix(entry.Name(), retryFileSuffix) {
+			queued = append(queued, entry)
+		}
+	}
+	return queued, nil
+}
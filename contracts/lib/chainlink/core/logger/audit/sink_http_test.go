@@ -0,0 +1,166 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// logService is a stand-in for an HTTP log service, which can be made unavailable.
+type logService struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	down     bool
+	payloads []string
+}
+
+func newLogService(t *testing.T) *logService {
+	s := &logService{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.down {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get("Authorization") != "token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.payloads = append(s.payloads, string(b))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *logService) setDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.down = down
+}
+
+func (s *logService) received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.payloads...)
+}
+
+func newTestHTTPSink(t *testing.T, server *logService, cfg httpSinkConfig) *httpSink {
+	u, err := commonconfig.ParseURL(server.URL)
+	require.NoError(t, err)
+	cfg.url = *u
+	cfg.headers = []models.ServiceHeader{{Header: "Authorization", Value: "token"}}
+	sink := newHTTPSink(logger.TestLogger(t), cfg)
+	require.NoError(t, sink.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, sink.Close()) })
+	return sink
+}
+
+func TestHTTPSink_Payload(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	server := newLogService(t)
+
+	single := newTestHTTPSink(t, server, httpSinkConfig{batchSize: 1, jsonWrapperKey: "event"})
+	require.NoError(t, single.Write(ctx, testEvents(1)))
+
+	batched := newTestHTTPSink(t, server, httpSinkConfig{batchSize: 10})
+	require.NoError(t, batched.Write(ctx, testEvents(3)))
+
+	payloads := server.received()
+	require.Len(t, payloads, 2)
+
+	var wrapped map[string]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(payloads[0]), &wrapped))
+	assert.Equal(t, "AUTH_LOGIN_SUCCESS_NO_2FA", wrapped["event"]["eventID"])
+	assert.Equal(t, "test", wrapped["event"]["env"])
+
+	var batch []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(payloads[1]), &batch))
+	require.Len(t, batch, 3)
+	assert.Equal(t, map[string]any{"email": "user2@example.com"}, batch[2]["data"])
+}
+
+func TestHTTPSink_RetryQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	server := newLogService(t)
+	dir := t.TempDir()
+	sink := newTestHTTPSink(t, server, httpSinkConfig{batchSize: 10, retryQueueDir: dir, retryQueueMaxSize: utils.FileSize(1 * utils.MB)})
+
+	server.setDown(true)
+	require.ErrorContains(t, sink.Write(ctx, testEvents(1)), "queued for retry")
+	// queued behind the first payload, without trying to send it first
+	require.NoError(t, sink.Write(ctx, testEvents(2)))
+	queued, err := sink.queuedFiles()
+	require.NoError(t, err)
+	require.Len(t, queued, 2)
+
+	sink.retry(ctx)
+	assert.Empty(t, server.received())
+
+	server.setDown(false)
+	sink.retry(ctx)
+	payloads := server.received()
+	require.Len(t, payloads, 2)
+	var first, second []Event
+	require.NoError(t, json.Unmarshal([]byte(payloads[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(payloads[1]), &second))
+	assert.Len(t, first, 1)
+	assert.Len(t, second, 2)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	require.NoError(t, sink.Write(ctx, testEvents(1)))
+	assert.Len(t, server.received(), 3)
+}
+
+func TestHTTPSink_RetryQueueMaxSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	server := newLogService(t)
+	server.setDown(true)
+	sink := newTestHTTPSink(t, server, httpSinkConfig{batchSize: 10, retryQueueDir: t.TempDir(), retryQueueMaxSize: utils.FileSize(1 * utils.KB)})
+
+	require.Error(t, sink.Write(ctx, testEvents(1)))
+	for i := 0; i < 9; i++ {
+		require.NoError(t, sink.Write(ctx, testEvents(1)))
+	}
+	queued, err := sink.queuedFiles()
+	require.NoError(t, err)
+	var total int64
+	for _, entry := range queued {
+		info, err := entry.Info()
+		require.NoError(t, err)
+		total += info.Size()
+	}
+	assert.LessOrEqual(t, total, int64(1*utils.KB))
+	assert.Less(t, len(queued), 10)
+	// the newest payloads are kept
+	assert.Equal(t, "00000000000000000009.json", queued[len(queued)-1].Name())
+}
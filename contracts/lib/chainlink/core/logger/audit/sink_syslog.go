@@ -0,0 +1,59 @@
+//go:build !windows
+// +build !windows
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/syslog"
+)
+
+// syslogSink writes each event as a JSON message to syslog, under the auth facility.
+type syslogSink struct {
+	network string
+	address string
+	tag     string
+
+	w *syslog.Writer
+}
+
+func newSyslogSink(network, address, tag string) Sink {
+	return &syslogSink{network: network, address: address, tag: tag}
+}
+
+func (s *syslogSink) Name() string { return "Syslog" }
+
+func (s *syslogSink) Start(context.Context) error {
+	// an empty network connects to the local syslog server
+	w, err := syslog.Dial(s.network, s.address, syslog.LOG_INFO|syslog.LOG_AUTH, s.tag)
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	s.w = w
+	return nil
+}
+
+func (s *syslogSink) Write(_ context.Context, events []Event) error {
+	var errs error
+	for _, event := range events {
+		b, err := json.Marshal(event)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("unable to serialize audit log to JSON: %w", err))
+			continue
+		}
+		if err = s.w.Info(string(b)); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to write audit log to syslog: %w", err))
+		}
+	}
+	return errs
+}
+
+func (s *syslogSink) Close() error {
+	if s.w == nil {
+		return nil
+	}
+	return s.w.Close()
+}
@@ -0,0 +1,47 @@
+//go:build !windows
+// +build !windows
+
+package audit
+
+import (
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestSyslogSink(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	sink := newSyslogSink("udp", conn.LocalAddr().String(), "chainlink")
+	require.NoError(t, sink.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, sink.Close()) })
+	require.NoError(t, sink.Write(ctx, testEvents(2)))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 4096)
+	for i := 0; i < 2; i++ {
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		msg := string(buf[:n])
+		// <38> is the auth facility at the info severity
+		assert.True(t, strings.HasPrefix(msg, "<38>"), msg)
+		assert.Contains(t, msg, "chainlink[")
+
+		var event Event
+		require.NoError(t, json.Unmarshal([]byte(msg[strings.Index(msg, "{"):]), &event))
+		assert.Equal(t, AuthLoginSuccessNo2FA, event.EventID)
+		assert.Equal(t, testEvents(2)[i].Data["email"], event.Data["email"])
+	}
+}
@@ -0,0 +1,28 @@
+//go:build windows
+// +build windows
+
+package audit
+
+import (
+	"context"
+	"errors"
+)
+
+// syslogSink is unavailable on Windows, which has no syslog.
+type syslogSink struct{}
+
+func newSyslogSink(network, address, tag string) Sink {
+	return syslogSink{}
+}
+
+func (syslogSink) Name() string { return "Syslog" }
+
+func (syslogSink) Start(context.Context) error {
+	return errors.New("syslog is not supported on Windows")
+}
+
+func (syslogSink) Write(context.Context, []Event) error {
+	return errors.New("syslog is not supported on Windows")
+}
+
+func (syslogSink) Close() error { return nil }
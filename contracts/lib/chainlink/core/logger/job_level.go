@@ -0,0 +1,99 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// jobIDKey is the key of the field identifying the job of a logger.
+const jobIDKey = "jobID"
+
+// LevelOverride is the log level of the loggers of a job, overriding the global log level until it expires.
+type LevelOverride struct {
+	Level   zapcore.Level
+	Expires time.Time // zero for no expiry
+}
+
+func (o LevelOverride) active(now time.Time) bool {
+	return o.Expires.IsZero() || now.Before(o.Expires)
+}
+
+var jobLevelOverrides atomic.Pointer[map[string]LevelOverride]
+
+// SetJobLevelOverrides replaces the log level overrides of the jobs, keyed by job ID. They apply at once to all the
+// loggers having a jobID field, including those already created.
+func SetJobLevelOverrides(overrides map[int32]LevelOverride) {
+	m := make(map[string]LevelOverride, len(overrides))
+	for id, o := range overrides {
+		m[strconv.FormatInt(int64(id), 10)] = o
+	}
+	jobLevelOverrides.Store(&m)
+}
+
+func jobLevelOverride(jobID string) (LevelOverride, bool) {
+	if jobID == "" {
+		return LevelOverride{}, false
+	}
+	m := jobLevelOverrides.Load()
+	if m == nil {
+		return LevelOverride{}, false
+	}
+	o, ok := (*m)[jobID]
+	if !ok || !o.active(time.Now()) {
+		return LevelOverride{}, false
+	}
+	return o, true
+}
+
+var _ zapcore.Core = (*jobLevelCore)(nil)
+
+// jobLevelCore applies the job level overrides to the entries of the loggers having a jobID field, in place of the
+// level of the wrapped core.
+type jobLevelCore struct {
+	zapcore.Core
+	jobID string
+}
+
+func newJobLevelCore(core zapcore.Core) zapcore.Core {
+	return &jobLevelCore{Core: core}
+}
+
+func (c *jobLevelCore) Enabled(lvl zapcore.Level) bool {
+	if o, ok := jobLevelOverride(c.jobID); ok {
+		return lvl >= o.Level
+	}
+	return c.Core.Enabled(lvl)
+}
+
+func (c *jobLevelCore) With(fields []zapcore.Field) zapcore.Core {
+	jobID := c.jobID
+	for _, f := range fields {
+		if f.Key == jobIDKey {
+			jobID = fieldValue(f)
+		}
+	}
+	return &jobLevelCore{Core: c.Core.With(fields), jobID: jobID}
+}
+
+func (c *jobLevelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	o, ok := jobLevelOverride(c.jobID)
+	if !ok {
+		return c.Core.Check(ent, ce)
+	}
+	if ent.Level < o.Level {
+		return ce
+	}
+	// The wrapped core would filter the entry by its own level.
+	return ce.AddCore(ent, c.Core)
+}
+
+// fieldValue returns the value of f as a string, whatever its type.
+func fieldValue(f zapcore.Field) string {
+	enc := zapcore.NewMapObjectEncoder()
+	f.AddTo(enc)
+	return fmt.Sprint(enc.Fields[f.Key])
+}
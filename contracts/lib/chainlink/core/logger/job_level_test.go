@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestJobLevelCore(t *testing.T) {
+	t.Cleanup(func() { SetJobLevelOverrides(nil) })
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	lggr := zap.New(newJobLevelCore(core)).Sugar()
+	job7, job8 := lggr.With("jobID", int32(7)), lggr.With("jobID", int32(8))
+
+	job7.Debug("debug")
+	assert.Equal(t, 0, logs.Len())
+
+	SetJobLevelOverrides(map[int32]LevelOverride{7: {Level: zapcore.DebugLevel}})
+	job7.Debug("debug")
+	job7.With("run.ID", 1).Debug("debug")
+	job8.Debug("debug")
+	lggr.Debug("debug")
+	assert.Equal(t, 2, logs.Len())
+	for _, e := range logs.TakeAll() {
+		assert.Equal(t, int64(7), e.Context[0].Integer)
+	}
+
+	SetJobLevelOverrides(map[int32]LevelOverride{7: {Level: zapcore.DebugLevel, Expires: time.Now().Add(-time.Second)}})
+	job7.Debug("debug")
+	assert.Equal(t, 0, logs.Len())
+
+	SetJobLevelOverrides(map[int32]LevelOverride{7: {Level: zapcore.ErrorLevel, Expires: time.Now().Add(time.Hour)}})
+	job7.Info("info")
+	job8.Info("info")
+	assert.Equal(t, 1, logs.Len())
+	assert.Equal(t, int64(8), logs.TakeAll()[0].Context[0].Integer)
+}
@@ -268,7 +268,7 @@ func newLoggerForCore(zcfg zap.Config, core zapcore.Core) (*zapLogger, func(), e
 
 	return &zapLogger{
 		level:         zcfg.Level,
-		SugaredLogger: zap.New(core, zap.ErrorOutput(errSink), zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel)).Sugar(),
+		SugaredLogger: zap.New(newJobLevelCore(core), zap.ErrorOutput(errSink), zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel)).Sugar(),
 	}, closeFn, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -18,6 +19,11 @@ type batchBHSConfig interface {
 	LimitDefault() uint64
 }
 
+// GasEstimator estimates the gas used by a call.
+type GasEstimator interface {
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
 type BatchBlockhashStore struct {
 	config   batchBHSConfig
 	txm      txmgr.TxManager
@@ -70,3 +76,22 @@ func (b *BatchBlockhashStore) StoreVerifyHeader(ctx context.Context, blockNumber
 
 	return nil
 }
+
+// EstimateStoreVerifyHeader estimates the gas used by a storeVerifyHeader call for the given blocks.
+func (b *BatchBlockhashStore) EstimateStoreVerifyHeader(ctx context.Context, estimator GasEstimator, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) (uint64, error) {
+	payload, err := b.abi.Pack("storeVerifyHeader", blockNumbers, blockHeaders)
+	if err != nil {
+		return 0, errors.Wrap(err, "packing args")
+	}
+
+	to := b.batchbhs.Address()
+	gas, err := estimator.EstimateGas(ctx, ethereum.CallMsg{
+		From: fromAddress,
+		To:   &to,
+		Data: payload,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "estimating gas")
+	}
+	return gas, nil
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -87,7 +88,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		return nil, errors.New("log poller must be enabled to run blockhashstore")
 	}
 
-	ks := keys.NewChainStore(keystore.NewEthSigner(d.ks, cid), cid)
+	ks := keys.NewChainStore(keystore.NewEthSigner(d.ks, cid).WithCaller("BlockhashStore", &jb.ID), cid)
 
 	enabled, err := ks.EnabledAddresses(ctx)
 	if err != nil {
@@ -101,107 +102,140 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		fromAddresses = jb.BlockhashStoreSpec.FromAddresses
 	}
 
-	bhs, err := blockhash_store.NewBlockhashStore(
-		jb.BlockhashStoreSpec.BlockhashStoreAddress.Address(), chain.Client())
-	if err != nil {
-		return nil, errors.Wrap(err, "building BHS")
-	}
-
-	var trustedBHS *trusted_blockhash_store.TrustedBlockhashStore
-	if jb.BlockhashStoreSpec.TrustedBlockhashStoreAddress != nil && jb.BlockhashStoreSpec.TrustedBlockhashStoreAddress.Hex() != EmptyAddress {
-		trustedBHS, err = trusted_blockhash_store.NewTrustedBlockhashStore(
-			jb.BlockhashStoreSpec.TrustedBlockhashStoreAddress.Address(),
-			chain.Client(),
-		)
-		if err != nil {
-			return nil, errors.Wrap(err, "building trusted BHS")
-		}
-	}
-
 	lp := chain.LogPoller()
-	var coordinators []Coordinator
-	if jb.BlockhashStoreSpec.CoordinatorV1Address != nil {
-		var c *v1.VRFCoordinator
-		if c, err = v1.NewVRFCoordinator(
-			jb.BlockhashStoreSpec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
-			return nil, errors.Wrap(err, "building V1 coordinator")
+	log := d.logger.Named("BHSFeeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
+	scanCache := NewScanCache(func(ctx context.Context) (uint64, error) {
+		head, err := lp.LatestBlock(ctx)
+		if err != nil {
+			return 0, errors.Wrap(err, "getting chain head")
 		}
+		return uint64(head.BlockNumber), nil
+	})
 
-		var coord *V1Coordinator
-		coord, err = NewV1Coordinator(ctx, c, lp)
+	// Coordinators are built once per address, so that a coordinator feeding several stores
+	// registers a single log poller filter and is scanned once per poll cycle.
+	coordinators := make(map[common.Address]Coordinator)
+	var feeders []*Feeder
+	for _, store := range jb.BlockhashStoreSpec.Stores() {
+		var storeCoordinators []Coordinator
+		storeCoordinators, err = BuildStoreCoordinators(ctx, chain, scanCache, coordinators, store)
 		if err != nil {
-			return nil, errors.Wrap(err, "building V1 coordinator")
-		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockhashStoreSpec.CoordinatorV2Address != nil {
-		var c *v2.VRFCoordinatorV2
-		if c, err = v2.NewVRFCoordinatorV2(
-			jb.BlockhashStoreSpec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
-			return nil, errors.Wrap(err, "building V2 coordinator")
+			return nil, err
 		}
 
-		var coord *V2Coordinator
-		coord, err = NewV2Coordinator(ctx, c, lp)
+		var bhs *blockhash_store.BlockhashStore
+		bhs, err = blockhash_store.NewBlockhashStore(store.BlockhashStoreAddress.Address(), chain.Client())
 		if err != nil {
-			return nil, errors.Wrap(err, "building V2 coordinator")
+			return nil, errors.Wrap(err, "building BHS")
 		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockhashStoreSpec.CoordinatorV2PlusAddress != nil {
-		var c v2plus.IVRFCoordinatorV2PlusInternalInterface
-		if c, err = v2plus.NewIVRFCoordinatorV2PlusInternal(
-			jb.BlockhashStoreSpec.CoordinatorV2PlusAddress.Address(), chain.Client()); err != nil {
-			return nil, errors.Wrap(err, "building V2Plus coordinator")
+
+		var trustedBHS *trusted_blockhash_store.TrustedBlockhashStore
+		if store.TrustedBlockhashStoreAddress != nil && store.TrustedBlockhashStoreAddress.Hex() != EmptyAddress {
+			trustedBHS, err = trusted_blockhash_store.NewTrustedBlockhashStore(
+				store.TrustedBlockhashStoreAddress.Address(),
+				chain.Client(),
+			)
+			if err != nil {
+				return nil, errors.Wrap(err, "building trusted BHS")
+			}
 		}
 
-		var coord *V2PlusCoordinator
-		coord, err = NewV2PlusCoordinator(ctx, c, lp)
+		var bpBHS *BulletproofBHS
+		bpBHS, err = NewBulletproofBHS(
+			chain.Config().EVM().GasEstimator(),
+			d.cfg.Database(),
+			fromAddresses,
+			chain.TxManager(),
+			bhs,
+			trustedBHS,
+			ks,
+		)
 		if err != nil {
-			return nil, errors.Wrap(err, "building V2Plus coordinator")
+			return nil, errors.Wrap(err, "building bulletproof bhs")
 		}
-		coordinators = append(coordinators, coord)
-	}
 
-	bpBHS, err := NewBulletproofBHS(
-		chain.Config().EVM().GasEstimator(),
-		d.cfg.Database(),
-		fromAddresses,
-		chain.TxManager(),
-		bhs,
-		trustedBHS,
-		ks,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "building bulletproof bhs")
+		feeders = append(feeders, NewFeeder(
+			log.With("bhsAddress", store.BlockhashStoreAddress),
+			NewMultiCoordinator(storeCoordinators...),
+			bpBHS,
+			lp,
+			jb.BlockhashStoreSpec.TrustedBlockhashStoreBatchSize,
+			int(jb.BlockhashStoreSpec.WaitBlocks),
+			int(jb.BlockhashStoreSpec.LookbackBlocks),
+			jb.BlockhashStoreSpec.HeartbeatPeriod,
+			scanCache.LatestBlock,
+		))
 	}
 
-	log := d.logger.Named("BHSFeeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
-	feeder := NewFeeder(
-		log,
-		NewMultiCoordinator(coordinators...),
-		bpBHS,
-		lp,
-		jb.BlockhashStoreSpec.TrustedBlockhashStoreBatchSize,
-		int(jb.BlockhashStoreSpec.WaitBlocks),
-		int(jb.BlockhashStoreSpec.LookbackBlocks),
-		jb.BlockhashStoreSpec.HeartbeatPeriod,
-		func(ctx context.Context) (uint64, error) {
-			head, err := lp.LatestBlock(ctx)
-			if err != nil {
-				return 0, errors.Wrap(err, "getting chain head")
-			}
-			return uint64(head.BlockNumber), nil
-		})
-
 	return []job.ServiceCtx{&service{
-		feeder:     feeder,
+		feeders:    feeders,
+		scanCache:  scanCache,
 		pollPeriod: jb.BlockhashStoreSpec.PollPeriod,
 		runTimeout: jb.BlockhashStoreSpec.RunTimeout,
 		logger:     log,
 	}}, nil
 }
 
+// BuildStoreCoordinators returns the coordinators of the given store, reusing the ones in built
+// that were created for other stores of the same job. New coordinators are added to built and
+// wrapped by scanCache.
+func BuildStoreCoordinators(
+	ctx context.Context,
+	chain legacyevm.Chain,
+	scanCache *ScanCache,
+	built map[common.Address]Coordinator,
+	store job.BlockhashStoreTarget,
+) ([]Coordinator, error) {
+	lp := chain.LogPoller()
+	var coordinators []Coordinator
+	if store.CoordinatorV1Address != nil {
+		addr := store.CoordinatorV1Address.Address()
+		if _, ok := built[addr]; !ok {
+			c, err := v1.NewVRFCoordinator(addr, chain.Client())
+			if err != nil {
+				return nil, errors.Wrap(err, "building V1 coordinator")
+			}
+			coord, err := NewV1Coordinator(ctx, c, lp)
+			if err != nil {
+				return nil, errors.Wrap(err, "building V1 coordinator")
+			}
+			built[addr] = scanCache.Coordinator(coord)
+		}
+		coordinators = append(coordinators, built[addr])
+	}
+	if store.CoordinatorV2Address != nil {
+		addr := store.CoordinatorV2Address.Address()
+		if _, ok := built[addr]; !ok {
+			c, err := v2.NewVRFCoordinatorV2(addr, chain.Client())
+			if err != nil {
+				return nil, errors.Wrap(err, "building V2 coordinator")
+			}
+			coord, err := NewV2Coordinator(ctx, c, lp)
+			if err != nil {
+				return nil, errors.Wrap(err, "building V2 coordinator")
+			}
+			built[addr] = scanCache.Coordinator(coord)
+		}
+		coordinators = append(coordinators, built[addr])
+	}
+	if store.CoordinatorV2PlusAddress != nil {
+		addr := store.CoordinatorV2PlusAddress.Address()
+		if _, ok := built[addr]; !ok {
+			c, err := v2plus.NewIVRFCoordinatorV2PlusInternal(addr, chain.Client())
+			if err != nil {
+				return nil, errors.Wrap(err, "building V2Plus coordinator")
+			}
+			coord, err := NewV2PlusCoordinator(ctx, c, lp)
+			if err != nil {
+				return nil, errors.Wrap(err, "building V2Plus coordinator")
+			}
+			built[addr] = scanCache.Coordinator(coord)
+		}
+		coordinators = append(coordinators, built[addr])
+	}
+	return coordinators, nil
+}
+
 // AfterJobCreated satisfies the job.Delegate interface.
 func (d *Delegate) AfterJobCreated(spec job.Job) {}
 
@@ -214,10 +248,11 @@ func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
 // OnDeleteJob satisfies the job.Delegate interface.
 func (d *Delegate) OnDeleteJob(context.Context, job.Job) error { return nil }
 
-// service is a job.Service that runs the BHS feeder every pollPeriod.
+// service is a job.Service that runs the BHS feeders of a job every pollPeriod.
 type service struct {
 	services.StateMachine
-	feeder     *Feeder
+	feeders    []*Feeder
+	scanCache  *ScanCache
 	wg         sync.WaitGroup
 	pollPeriod time.Duration
 	runTimeout time.Duration
@@ -228,15 +263,17 @@ type service struct {
 // Start the BHS feeder service, satisfying the job.Service interface.
 func (s *service) Start(context.Context) error {
 	return s.StartOnce("BHS Feeder Service", func() error {
-		s.logger.Infow("Starting BHS feeder")
+		s.logger.Infow("Starting BHS feeder", "stores", len(s.feeders))
 		s.stopCh = make(chan struct{})
-		s.wg.Add(2)
-		go func() {
-			defer s.wg.Done()
-			ctx, cancel := s.stopCh.NewCtx()
-			defer cancel()
-			s.feeder.StartHeartbeats(ctx, &realTimer{})
-		}()
+		s.wg.Add(len(s.feeders) + 1)
+		for _, feeder := range s.feeders {
+			go func() {
+				defer s.wg.Done()
+				ctx, cancel := s.stopCh.NewCtx()
+				defer cancel()
+				feeder.StartHeartbeats(ctx, &realTimer{})
+			}()
+		}
 		go func() {
 			defer s.wg.Done()
 			ctx, cancel := s.stopCh.NewCtx()
@@ -246,7 +283,7 @@ func (s *service) Start(context.Context) error {
 			for {
 				select {
 				case <-ticker.C:
-					s.runFeeder(ctx)
+					s.runFeeders(ctx)
 				case <-ctx.Done():
 					return
 				}
@@ -266,15 +303,39 @@ func (s *service) Close() error {
 	})
 }
 
-func (s *service) runFeeder(ctx context.Context) {
+func (s *service) runFeeders(ctx context.Context) {
 	s.logger.Debugw("Running BHS feeder")
-	ctx, cancel := context.WithTimeout(ctx, s.runTimeout)
-	defer cancel()
-	err := s.feeder.Run(ctx)
-	if err == nil {
+	s.scanCache.Reset()
+	errs := RunFeeders(ctx, s.runTimeout, s.feeders)
+	if errs == nil {
 		s.logger.Debugw("BHS feeder run completed successfully")
 	} else {
 		s.logger.Errorw("BHS feeder run was at least partially unsuccessful",
-			"err", err)
+			"err", errs)
 	}
 }
+
+// Runner is a feeder of a single store.
+type Runner interface {
+	Run(ctx context.Context) error
+}
+
+// RunFeeders runs the feeders of the stores of a job one after the other within runTimeout. Each feeder is given an
+// equal share of the time left, so that a slow store does not leave the stores after it without time to run, and a
+// run does not take longer than runTimeout however many stores the job has.
+func RunFeeders[R Runner](ctx context.Context, runTimeout time.Duration, feeders []R) (errs error) {
+	ctx, cancel := context.WithTimeout(ctx, runTimeout)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+	for i, feeder := range feeders {
+		share := time.Until(deadline) / time.Duration(len(feeders)-i)
+		errs = stderrors.Join(errs, runWithTimeout(ctx, share, feeder))
+	}
+	return errs
+}
+
+func runWithTimeout(ctx context.Context, timeout time.Duration, feeder Runner) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return feeder.Run(ctx)
+}
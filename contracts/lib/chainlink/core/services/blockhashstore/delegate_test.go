@@ -1,6 +1,7 @@
 package blockhashstore_test
 
 import (
+	"context"
 	"math"
 	"testing"
 	"time"
@@ -123,6 +124,27 @@ func TestDelegate_ServicesForSpec(t *testing.T) {
 		require.Len(t, services, 1)
 	})
 
+	t.Run("happy with additional stores", func(t *testing.T) {
+		coordinatorV1 := cltest.NewEIP55Address()
+		coordinatorV2 := cltest.NewEIP55Address()
+
+		spec := job.Job{BlockhashStoreSpec: &job.BlockhashStoreSpec{
+			WaitBlocks:            defaultWaitBlocks,
+			CoordinatorV1Address:  &coordinatorV1,
+			BlockhashStoreAddress: cltest.NewEIP55Address(),
+			EVMChainID:            (*big.Big)(testutils.FixtureChainID),
+			AdditionalStores: job.BlockhashStoreTargets{{
+				CoordinatorV1Address:  &coordinatorV1,
+				CoordinatorV2Address:  &coordinatorV2,
+				BlockhashStoreAddress: cltest.NewEIP55Address(),
+			}},
+		}}
+		services, err := delegate.ServicesForSpec(testutils.Context(t), spec)
+
+		require.NoError(t, err)
+		require.Len(t, services, 1)
+	})
+
 	t.Run("missing BlockhashStoreSpec", func(t *testing.T) {
 		spec := job.Job{BlockhashStoreSpec: nil}
 		_, err := delegate.ServicesForSpec(testutils.Context(t), spec)
@@ -189,3 +211,32 @@ func TestDelegate_StartStop(t *testing.T) {
 
 	assert.NotZero(t, testData.logs.FilterMessage("Stopping BHS feeder").Len())
 }
+
+type runnerFunc func(ctx context.Context) error
+
+func (f runnerFunc) Run(ctx context.Context) error { return f(ctx) }
+
+func TestRunFeeders(t *testing.T) {
+	t.Parallel()
+
+	var ran []string
+	slow := runnerFunc(func(ctx context.Context) error {
+		ran = append(ran, "slow")
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	fast := runnerFunc(func(ctx context.Context) error {
+		ran = append(ran, "fast")
+		return ctx.Err()
+	})
+
+	start := time.Now()
+	err := blockhashstore.RunFeeders(testutils.Context(t), time.Second, []runnerFunc{slow, fast})
+	elapsed := time.Since(start)
+
+	// the slow store only gets its share of the run timeout, leaving the fast store time to run
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, []string{"slow", "fast"}, ran)
+	assert.Less(t, elapsed, 900*time.Millisecond)
+	assert.GreaterOrEqual(t, elapsed, 500*time.Millisecond)
+}
@@ -0,0 +1,142 @@
+package blockhashstore
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated"
+	v1 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	v2 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2"
+	v2plus "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2plus_interface"
+)
+
+var _ Coordinator = &RPCCoordinator{}
+
+// defaultLogsChunkSize is the number of blocks requested per eth_getLogs call, kept low
+// enough to stay under the range limits of common RPC providers.
+const defaultLogsChunkSize = 2000
+
+// LogFilterer is the subset of the EVM client used by RPCCoordinator.
+type LogFilterer interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	LatestBlockHeight(ctx context.Context) (*big.Int, error)
+}
+
+// RPCCoordinator fetches request and fulfillment logs from a VRF coordinator contract directly
+// from the RPC, instead of the log poller. This allows scanning block ranges that are older than
+// the log poller's retention, at the expense of more RPC calls.
+type RPCCoordinator struct {
+	client           LogFilterer
+	address          common.Address
+	requestTopic     common.Hash
+	fulfillmentTopic common.Hash
+	parseLog         func(log types.Log) (generated.AbigenLog, error)
+	chunkSize        uint64
+}
+
+// NewRPCV1Coordinator creates a new RPCCoordinator for a VRF V1 coordinator.
+func NewRPCV1Coordinator(c v1.VRFCoordinatorInterface, client LogFilterer) *RPCCoordinator {
+	return &RPCCoordinator{
+		client:           client,
+		address:          c.Address(),
+		requestTopic:     v1.VRFCoordinatorRandomnessRequest{}.Topic(),
+		fulfillmentTopic: v1.VRFCoordinatorRandomnessRequestFulfilled{}.Topic(),
+		parseLog:         c.ParseLog,
+		chunkSize:        defaultLogsChunkSize,
+	}
+}
+
+// NewRPCV2Coordinator creates a new RPCCoordinator for a VRF V2 coordinator.
+func NewRPCV2Coordinator(c v2.VRFCoordinatorV2Interface, client LogFilterer) *RPCCoordinator {
+	return &RPCCoordinator{
+		client:           client,
+		address:          c.Address(),
+		requestTopic:     v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(),
+		fulfillmentTopic: v2.VRFCoordinatorV2RandomWordsFulfilled{}.Topic(),
+		parseLog:         c.ParseLog,
+		chunkSize:        defaultLogsChunkSize,
+	}
+}
+
+// NewRPCV2PlusCoordinator creates a new RPCCoordinator for a VRF V2Plus coordinator.
+func NewRPCV2PlusCoordinator(c v2plus.IVRFCoordinatorV2PlusInternalInterface, client LogFilterer) *RPCCoordinator {
+	return &RPCCoordinator{
+		client:           client,
+		address:          c.Address(),
+		requestTopic:     v2plus.IVRFCoordinatorV2PlusInternalRandomWordsRequested{}.Topic(),
+		fulfillmentTopic: v2plus.IVRFCoordinatorV2PlusInternalRandomWordsFulfilled{}.Topic(),
+		parseLog:         c.ParseLog,
+		chunkSize:        defaultLogsChunkSize,
+	}
+}
+
+// Requests satisfies the Coordinator interface.
+func (r *RPCCoordinator) Requests(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
+	events, err := r.events(ctx, r.requestTopic, fromBlock, toBlock)
+	return events, errors.Wrap(err, "filter requests")
+}
+
+// Fulfillments satisfies the Coordinator interface.
+func (r *RPCCoordinator) Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error) {
+	latest, err := r.client.LatestBlockHeight(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching latest block")
+	}
+	events, err := r.events(ctx, r.fulfillmentTopic, fromBlock, latest.Uint64())
+	return events, errors.Wrap(err, "filter fulfillments")
+}
+
+func (r *RPCCoordinator) events(ctx context.Context, topic common.Hash, fromBlock, toBlock uint64) ([]Event, error) {
+	var events []Event
+	for start := fromBlock; start <= toBlock; start += r.chunkSize {
+		end := start + r.chunkSize - 1
+		if end > toBlock {
+			end = toBlock
+		}
+		logs, err := r.client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{r.address},
+			Topics:    [][]common.Hash{{topic}},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "fetching logs for blocks %d-%d", start, end)
+		}
+		for _, l := range logs {
+			parsed, err := r.parseLog(l)
+			if err != nil {
+				continue // malformed log should not break flow
+			}
+			if event, ok := eventFromLog(parsed); ok {
+				events = append(events, event)
+			}
+		}
+	}
+	return events, nil
+}
+
+// eventFromLog converts a parsed request or fulfillment log of any coordinator version to an Event.
+func eventFromLog(log generated.AbigenLog) (Event, bool) {
+	switch l := log.(type) {
+	case *v1.VRFCoordinatorRandomnessRequest:
+		return Event{ID: hex.EncodeToString(l.RequestID[:]), Block: l.Raw.BlockNumber}, true
+	case *v1.VRFCoordinatorRandomnessRequestFulfilled:
+		return Event{ID: hex.EncodeToString(l.RequestId[:]), Block: l.Raw.BlockNumber}, true
+	case *v2.VRFCoordinatorV2RandomWordsRequested:
+		return Event{ID: l.RequestId.String(), Block: l.Raw.BlockNumber}, true
+	case *v2.VRFCoordinatorV2RandomWordsFulfilled:
+		return Event{ID: l.RequestId.String(), Block: l.Raw.BlockNumber}, true
+	case *v2plus.IVRFCoordinatorV2PlusInternalRandomWordsRequested:
+		return Event{ID: l.RequestId.String(), Block: l.Raw.BlockNumber}, true
+	case *v2plus.IVRFCoordinatorV2PlusInternalRandomWordsFulfilled:
+		return Event{ID: l.RequestId.String(), Block: l.Raw.BlockNumber}, true
+	default:
+		return Event{}, false
+	}
+}
@@ -0,0 +1,117 @@
+package blockhashstore
+
+import (
+	"context"
+	"sync"
+)
+
+// ScanCache lets the feeders of a job maintaining several stores share a single latest block
+// lookup and a single set of log queries per coordinator in each poll cycle. Reset must be called
+// at the start of every cycle.
+type ScanCache struct {
+	latestBlock func(ctx context.Context) (uint64, error)
+
+	mu           sync.Mutex
+	latest       *uint64
+	coordinators []*sharedCoordinator
+}
+
+// NewScanCache creates a new ScanCache fetching the latest block with the given function.
+func NewScanCache(latestBlock func(ctx context.Context) (uint64, error)) *ScanCache {
+	return &ScanCache{latestBlock: latestBlock}
+}
+
+// LatestBlock returns the latest block, fetching it at most once per cycle.
+func (s *ScanCache) LatestBlock(ctx context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.latest != nil {
+		return *s.latest, nil
+	}
+	latest, err := s.latestBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	s.latest = &latest
+	return latest, nil
+}
+
+// Coordinator wraps c so that its requests and fulfillments are fetched at most once per cycle
+// for a given block range, however many feeders use it.
+func (s *ScanCache) Coordinator(c Coordinator) Coordinator {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	shared := &sharedCoordinator{c: c}
+	s.coordinators = append(s.coordinators, shared)
+	return shared
+}
+
+// Reset discards the results of the previous cycle.
+func (s *ScanCache) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latest = nil
+	for _, c := range s.coordinators {
+		c.reset()
+	}
+}
+
+type requestsKey struct {
+	fromBlock uint64
+	toBlock   uint64
+}
+
+// sharedCoordinator memoizes the results of a Coordinator until reset.
+type sharedCoordinator struct {
+	c Coordinator
+
+	mu           sync.Mutex
+	requests     map[requestsKey][]Event
+	fulfillments map[uint64][]Event
+}
+
+var _ Coordinator = &sharedCoordinator{}
+
+// Requests satisfies the Coordinator interface.
+func (s *sharedCoordinator) Requests(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := requestsKey{fromBlock: fromBlock, toBlock: toBlock}
+	if reqs, ok := s.requests[key]; ok {
+		return reqs, nil
+	}
+	reqs, err := s.c.Requests(ctx, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	if s.requests == nil {
+		s.requests = make(map[requestsKey][]Event)
+	}
+	s.requests[key] = reqs
+	return reqs, nil
+}
+
+// Fulfillments satisfies the Coordinator interface.
+func (s *sharedCoordinator) Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fuls, ok := s.fulfillments[fromBlock]; ok {
+		return fuls, nil
+	}
+	fuls, err := s.c.Fulfillments(ctx, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	if s.fulfillments == nil {
+		s.fulfillments = make(map[uint64][]Event)
+	}
+	s.fulfillments[fromBlock] = fuls
+	return fuls, nil
+}
+
+func (s *sharedCoordinator) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.fulfillments = nil
+}
@@ -0,0 +1,70 @@
+package blockhashstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type countingCoordinator struct {
+	TestCoordinator
+	requestCalls     int
+	fulfillmentCalls int
+}
+
+func (c *countingCoordinator) Requests(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
+	c.requestCalls++
+	return c.TestCoordinator.Requests(ctx, fromBlock, toBlock)
+}
+
+func (c *countingCoordinator) Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error) {
+	c.fulfillmentCalls++
+	return c.TestCoordinator.Fulfillments(ctx, fromBlock)
+}
+
+func TestScanCache(t *testing.T) {
+	ctx := testutils.Context(t)
+	var latestCalls int
+	cache := NewScanCache(func(ctx context.Context) (uint64, error) {
+		latestCalls++
+		return 450, nil
+	})
+	coordinator := &countingCoordinator{TestCoordinator: TestCoordinator{
+		RequestEvents:     []Event{{Block: 150, ID: "request1"}, {Block: 151, ID: "request2"}},
+		FulfillmentEvents: []Event{{Block: 152, ID: "request1"}},
+	}}
+	shared := cache.Coordinator(coordinator)
+
+	// Two feeders scanning the same window in a cycle only query once.
+	for range 2 {
+		latest, err := cache.LatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(450), latest)
+
+		blockToRequests, err := GetUnfulfilledBlocksAndRequests(ctx, logger.TestLogger(t), shared, 100, 200)
+		require.NoError(t, err)
+		assert.Empty(t, blockToRequests[150])
+		assert.Len(t, blockToRequests[151], 1)
+	}
+	assert.Equal(t, 1, latestCalls)
+	assert.Equal(t, 1, coordinator.requestCalls)
+	assert.Equal(t, 1, coordinator.fulfillmentCalls)
+
+	// A different window is queried separately.
+	_, err := shared.Requests(ctx, 101, 201)
+	require.NoError(t, err)
+	assert.Equal(t, 2, coordinator.requestCalls)
+
+	cache.Reset()
+	_, err = cache.LatestBlock(ctx)
+	require.NoError(t, err)
+	_, err = shared.Requests(ctx, 100, 200)
+	require.NoError(t, err)
+	assert.Equal(t, 2, latestCalls)
+	assert.Equal(t, 3, coordinator.requestCalls)
+}
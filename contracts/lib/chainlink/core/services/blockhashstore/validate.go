@@ -51,8 +51,21 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 	if spec.EVMChainID == nil {
 		return jb, notSet("evmChainID")
 	}
-	if spec.TrustedBlockhashStoreAddress != nil && spec.TrustedBlockhashStoreAddress.Hex() != EmptyAddress && spec.TrustedBlockhashStoreBatchSize == 0 {
-		return jb, notSet("trustedBlockhashStoreBatchSize")
+	if err = ValidateStores(spec.Stores()); err != nil {
+		return jb, err
+	}
+	for _, store := range spec.AdditionalStores {
+		if store.BatchBlockhashStoreAddress != nil {
+			return jb, errors.New(`"batchBlockhashStoreAddress" is not supported in "additionalStores" of blockhash store jobs`)
+		}
+	}
+	allTrusted := true
+	for _, store := range spec.Stores() {
+		if !isTrusted(store) {
+			allTrusted = false
+		} else if spec.TrustedBlockhashStoreBatchSize == 0 {
+			return jb, notSet("trustedBlockhashStoreBatchSize")
+		}
 	}
 
 	// Defaults
@@ -77,10 +90,10 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 	if spec.WaitBlocks >= spec.LookbackBlocks {
 		return jb, errors.New(`"waitBlocks" must be less than "lookbackBlocks"`)
 	}
-	if (spec.TrustedBlockhashStoreAddress == nil || spec.TrustedBlockhashStoreAddress.Hex() == EmptyAddress) && spec.WaitBlocks >= 256 {
+	if !allTrusted && spec.WaitBlocks >= 256 {
 		return jb, errors.New(`"waitBlocks" must be less than 256`)
 	}
-	if (spec.TrustedBlockhashStoreAddress == nil || spec.TrustedBlockhashStoreAddress.Hex() == EmptyAddress) && spec.LookbackBlocks >= 256 {
+	if !allTrusted && spec.LookbackBlocks >= 256 {
 		return jb, errors.New(`"lookbackBlocks" must be less than 256`)
 	}
 
@@ -89,6 +102,31 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 	return jb, nil
 }
 
+// ValidateStores checks that every store of a job has an address and at least one coordinator,
+// and that no store is maintained twice by the same job.
+func ValidateStores(stores job.BlockhashStoreTargets) error {
+	seen := make(map[string]struct{}, len(stores))
+	for _, store := range stores {
+		if store.BlockhashStoreAddress == "" {
+			return errors.New(`"blockhashStoreAddress" must be set for every store`)
+		}
+		if store.CoordinatorV1Address == nil && store.CoordinatorV2Address == nil && store.CoordinatorV2PlusAddress == nil {
+			return errors.Errorf(
+				`at least one of "coordinatorV1Address", "coordinatorV2Address" and "coordinatorV2PlusAddress" must be set for store %s`,
+				store.BlockhashStoreAddress)
+		}
+		if _, ok := seen[store.BlockhashStoreAddress.Hex()]; ok {
+			return errors.Errorf("store %s is listed more than once", store.BlockhashStoreAddress)
+		}
+		seen[store.BlockhashStoreAddress.Hex()] = struct{}{}
+	}
+	return nil
+}
+
+func isTrusted(store job.BlockhashStoreTarget) bool {
+	return store.TrustedBlockhashStoreAddress != nil && store.TrustedBlockhashStoreAddress.Hex() != EmptyAddress
+}
+
 func notSet(field string) error {
 	return errors.Errorf("%q must be set", field)
 }
@@ -220,6 +220,59 @@ evmChainID = "4"`,
 				require.EqualError(t, err, `"trustedBlockhashStoreBatchSize" must be set`)
 			},
 		},
+		{
+			name: "additional stores",
+			toml: `
+type = "blockhashstore"
+name = "additional-stores-test"
+coordinatorV1Address = "0x1F72B4A5DCf7CC6d2E38423bF2f4BFA7db97d139"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+
+[[additionalStores]]
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x469aA2CD13e037DC5236320783dCfd0e641c0559"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.Len(t, os.BlockhashStoreSpec.AdditionalStores, 1)
+				require.Equal(t, &v2Coordinator, os.BlockhashStoreSpec.AdditionalStores[0].CoordinatorV2Address)
+				stores := os.BlockhashStoreSpec.Stores()
+				require.Len(t, stores, 2)
+				require.Equal(t, types.EIP55Address("0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"), stores[0].BlockhashStoreAddress)
+				require.Equal(t, types.EIP55Address("0x469aA2CD13e037DC5236320783dCfd0e641c0559"), stores[1].BlockhashStoreAddress)
+			},
+		},
+		{
+			name: "additional store without coordinators",
+			toml: `
+type = "blockhashstore"
+name = "additional-stores-test"
+coordinatorV1Address = "0x1F72B4A5DCf7CC6d2E38423bF2f4BFA7db97d139"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+
+[[additionalStores]]
+blockhashStoreAddress = "0x469aA2CD13e037DC5236320783dCfd0e641c0559"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, `at least one of "coordinatorV1Address", "coordinatorV2Address" and "coordinatorV2PlusAddress" must be set for store`)
+			},
+		},
+		{
+			name: "additional store listed twice",
+			toml: `
+type = "blockhashstore"
+name = "additional-stores-test"
+coordinatorV1Address = "0x1F72B4A5DCf7CC6d2E38423bF2f4BFA7db97d139"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+
+[[additionalStores]]
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, "is listed more than once")
+			},
+		},
 		{
 			name: "invalid toml",
 			toml: `
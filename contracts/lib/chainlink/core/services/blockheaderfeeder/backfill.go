@@ -0,0 +1,248 @@
+package blockheaderfeeder
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+)
+
+// MaxBlocksToStore is the most block headers a single backfill stores. Larger gaps have to be backfilled in several
+// narrower block ranges, from the most recent one down, so that each run is bounded in time and cost.
+const MaxBlocksToStore = 10_000
+
+// CoverageReport describes how well a BlockhashStore covers the blocks of unfulfilled VRF requests
+// within a block range, and what it would take to backfill the missing blockhashes.
+type CoverageReport struct {
+	FromBlock   uint64
+	ToBlock     uint64
+	LatestBlock uint64
+
+	// RequestBlocks is the number of blocks in range that have unfulfilled requests.
+	RequestBlocks int
+	// StoredBlocks is the number of RequestBlocks whose blockhash is already stored.
+	StoredBlocks int
+	// MissingBlocks are the RequestBlocks whose blockhash is not stored, in ascending order.
+	MissingBlocks []uint64
+	// UnfulfilledRequests is the number of unfulfilled requests in range.
+	UnfulfilledRequests int
+
+	// AnchorBlock is the earliest block after the lowest missing block whose blockhash is stored.
+	// Headers are stored backwards from it, as each header proves the hash of its parent.
+	// It is nil when no such block is stored, in which case StoreEarliest must run first.
+	AnchorBlock *uint64
+	// BlocksToStore is the number of headers that have to be stored to cover every missing block.
+	BlocksToStore int
+	// Batches is the number of storeVerifyHeader transactions needed.
+	Batches int
+	// EstimatedGas is an estimate of the total gas for all batches, extrapolated from the first one.
+	EstimatedGas uint64
+}
+
+// Covered returns the ratio of request blocks whose blockhash is stored.
+func (r CoverageReport) Covered() float64 {
+	if r.RequestBlocks == 0 {
+		return 1
+	}
+	return float64(r.StoredBlocks) / float64(r.RequestBlocks)
+}
+
+// Backfiller reports on and fills gaps in BlockhashStore coverage for an arbitrary block range,
+// unlike BlockHeaderFeeder which only looks back a bounded window from the latest block.
+type Backfiller struct {
+	lggr                      logger.Logger
+	coordinator               blockhashstore.Coordinator
+	bhs                       blockhashstore.BHS
+	batchBHS                  BatchBHS
+	blockHeaderProvider       BlockHeaderProvider
+	latestBlock               func(ctx context.Context) (uint64, error)
+	estimateGas               func(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) (uint64, error)
+	getBlockhashesBatchSize   uint16
+	storeBlockhashesBatchSize uint16
+}
+
+// NewBackfiller creates a new Backfiller instance.
+func NewBackfiller(
+	lggr logger.Logger,
+	coordinator blockhashstore.Coordinator,
+	bhs blockhashstore.BHS,
+	batchBHS BatchBHS,
+	blockHeaderProvider BlockHeaderProvider,
+	latestBlock func(ctx context.Context) (uint64, error),
+	estimateGas func(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) (uint64, error),
+	getBlockhashesBatchSize uint16,
+	storeBlockhashesBatchSize uint16,
+) *Backfiller {
+	return &Backfiller{
+		lggr:                      lggr.Named("BHSBackfiller"),
+		coordinator:               coordinator,
+		bhs:                       bhs,
+		batchBHS:                  batchBHS,
+		blockHeaderProvider:       blockHeaderProvider,
+		latestBlock:               latestBlock,
+		estimateGas:               estimateGas,
+		getBlockhashesBatchSize:   getBlockhashesBatchSize,
+		storeBlockhashesBatchSize: storeBlockhashesBatchSize,
+	}
+}
+
+// Coverage scans [fromBlock, toBlock] for unfulfilled VRF requests and reports which of their
+// blockhashes are missing from the BlockhashStore, along with the cost of backfilling them.
+func (b *Backfiller) Coverage(ctx context.Context, fromBlock, toBlock uint64, fromAddress common.Address) (*CoverageReport, error) {
+	if fromBlock > toBlock {
+		return nil, errors.Errorf("fromBlock (%d) must not be greater than toBlock (%d)", fromBlock, toBlock)
+	}
+	latest, err := b.latestBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching latest block")
+	}
+	if toBlock >= latest {
+		return nil, errors.Errorf("toBlock (%d) must be lower than the latest block (%d)", toBlock, latest)
+	}
+
+	lggr := b.lggr.With("fromBlock", fromBlock, "toBlock", toBlock, "latestBlock", latest)
+	blockToRequests, err := blockhashstore.GetUnfulfilledBlocksAndRequests(ctx, lggr, b.coordinator, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &CoverageReport{FromBlock: fromBlock, ToBlock: toBlock, LatestBlock: latest}
+	for block, reqs := range blockToRequests {
+		if len(reqs) == 0 {
+			continue
+		}
+		report.RequestBlocks++
+		report.UnfulfilledRequests += len(reqs)
+		stored, err := b.bhs.IsStored(ctx, block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "checking if block %d is stored", block)
+		}
+		if stored {
+			report.StoredBlocks++
+		} else {
+			report.MissingBlocks = append(report.MissingBlocks, block)
+		}
+	}
+	slices.Sort(report.MissingBlocks)
+	if len(report.MissingBlocks) == 0 {
+		return report, nil
+	}
+
+	minBlock := report.MissingBlocks[0]
+	anchor, err := b.earliestStoredBlock(ctx, minBlock+1, latest)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding earliest block number with blockhash")
+	}
+	report.AnchorBlock = anchor
+
+	// Without an anchor, StoreEarliest stores the blockhash of latest - 256, which then
+	// becomes the anchor for the next run.
+	var top uint64
+	if anchor != nil {
+		top = *anchor
+	} else if latest > 256 {
+		top = latest - 256
+	}
+	if top <= minBlock {
+		return report, nil
+	}
+	report.BlocksToStore = int(top - minBlock)
+	report.Batches = (report.BlocksToStore + int(b.storeBlockhashesBatchSize) - 1) / int(b.storeBlockhashesBatchSize)
+
+	if anchor != nil && b.estimateGas != nil {
+		blocks, err := b.blocksToStore(report)
+		if err != nil {
+			return nil, err
+		}
+		firstBatch := blocks[:min(len(blocks), int(b.storeBlockhashesBatchSize))]
+		headers, err := b.blockHeaderProvider.RlpHeadersBatch(ctx, firstBatch)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetching block headers")
+		}
+		gas, err := b.estimateGas(ctx, firstBatch, headers, fromAddress)
+		if err != nil {
+			return nil, errors.Wrap(err, "estimating gas")
+		}
+		report.EstimatedGas = gas * uint64(report.BlocksToStore) / uint64(len(firstBatch))
+	}
+
+	return report, nil
+}
+
+// Backfill stores the blockhashes needed to cover every missing block of the report, in batches,
+// using a single sending key since the order of storeVerifyHeader transactions matters.
+// If the report has no anchor, only the earliest blockhash is stored, and Backfill has to be run
+// again once that transaction is confirmed. It returns the number of blockhashes enqueued.
+func (b *Backfiller) Backfill(ctx context.Context, report *CoverageReport, fromAddress common.Address) (int, error) {
+	if len(report.MissingBlocks) == 0 {
+		return 0, nil
+	}
+	if report.AnchorBlock == nil {
+		if err := b.bhs.StoreEarliest(ctx); err != nil {
+			return 0, errors.Wrap(err, "storing earliest")
+		}
+		b.lggr.Infow("Stored earliest blockhash, run the backfill again once it is confirmed")
+		return 1, nil
+	}
+
+	if report.BlocksToStore > MaxBlocksToStore {
+		return 0, errors.Errorf("backfill would store %d block headers, more than the limit of %d: narrow the block range", report.BlocksToStore, MaxBlocksToStore)
+	}
+
+	blocks, err := b.blocksToStore(report)
+	if err != nil {
+		return 0, err
+	}
+	var enqueued int
+	for i := 0; i < len(blocks); i += int(b.storeBlockhashesBatchSize) {
+		j := min(i+int(b.storeBlockhashesBatchSize), len(blocks))
+		blockRange := blocks[i:j]
+		blockHeaders, err := b.blockHeaderProvider.RlpHeadersBatch(ctx, blockRange)
+		if err != nil {
+			return enqueued, errors.Wrap(err, "fetching block headers")
+		}
+		b.lggr.Debugw("storing block headers", "blockRange", blockRange)
+		if err = b.batchBHS.StoreVerifyHeader(ctx, blockRange, blockHeaders, fromAddress); err != nil {
+			return enqueued, errors.Wrap(err, "store block headers")
+		}
+		enqueued += len(blockRange)
+	}
+	b.lggr.Infow("Enqueued blockhash backfill", "blocks", enqueued, "fromAddress", fromAddress)
+	return enqueued, nil
+}
+
+// blocksToStore returns the blocks from (anchor - 1) down to the lowest missing block.
+func (b *Backfiller) blocksToStore(report *CoverageReport) ([]*big.Int, error) {
+	return blockhashstore.DecreasingBlockRange(
+		new(big.Int).SetUint64(*report.AnchorBlock-1),
+		new(big.Int).SetUint64(report.MissingBlocks[0]))
+}
+
+// earliestStoredBlock searches [startBlock, toBlock) and returns the first block that has its
+// blockhash stored, or nil if there is none.
+func (b *Backfiller) earliestStoredBlock(ctx context.Context, startBlock, toBlock uint64) (*uint64, error) {
+	for i := startBlock; i < toBlock; i += uint64(b.getBlockhashesBatchSize) {
+		j := min(i+uint64(b.getBlockhashesBatchSize), toBlock)
+		var blocks []*big.Int
+		for n := i; n < j; n++ {
+			blocks = append(blocks, new(big.Int).SetUint64(n))
+		}
+		blockhashes, err := b.batchBHS.GetBlockhashes(ctx, blocks)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetching blockhashes")
+		}
+		for idx, bh := range blockhashes {
+			if !bytes.Equal(bh[:], zeroHash[:]) {
+				earliest := i + uint64(idx)
+				return &earliest, nil
+			}
+		}
+	}
+	return nil, nil
+}
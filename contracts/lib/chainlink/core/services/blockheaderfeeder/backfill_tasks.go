@@ -0,0 +1,123 @@
+package blockheaderfeeder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// BackfillTaskState is the state of a BackfillTask.
+type BackfillTaskState string
+
+const (
+	BackfillTaskRunning   BackfillTaskState = "running"
+	BackfillTaskCompleted BackfillTaskState = "completed"
+	BackfillTaskErrored   BackfillTaskState = "errored"
+)
+
+const (
+	// backfillTaskTimeout bounds a backfill task, which outlives the request starting it.
+	backfillTaskTimeout = time.Hour
+	// finishedBackfillTasksKept is how many finished tasks are kept for their outcome to be read.
+	finishedBackfillTasksKept = 20
+)
+
+// ErrBackfillTaskNotFound is returned for tasks which are unknown, or finished long enough ago to be forgotten.
+var ErrBackfillTaskNotFound = errors.New("backfill task not found")
+
+// BackfillTask is a coverage report of a BlockhashStore, followed by a backfill unless DryRun, run in the background.
+type BackfillTask struct {
+	ID         string
+	EVMChainID string
+	BHSAddress common.Address
+	DryRun     bool
+
+	State      BackfillTaskState
+	Error      string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	// Report is set once the coverage report is computed.
+	Report *CoverageReport
+	// Enqueued is the number of blockhashes enqueued by the backfill.
+	Enqueued int
+}
+
+// BackfillFunc computes the coverage report of a task and backfills the missing blockhashes unless it is a dry run.
+type BackfillFunc func(ctx context.Context) (report *CoverageReport, enqueued int, err error)
+
+// BackfillTasks runs backfill tasks in the background and keeps their outcome for a while. Only one task runs at a
+// time for a given BlockhashStore, since the order of its storeVerifyHeader transactions matters.
+type BackfillTasks struct {
+	mu       sync.Mutex
+	tasks    map[string]*BackfillTask
+	finished []string // IDs of the finished tasks, oldest first
+}
+
+// NewBackfillTasks creates a new BackfillTasks instance.
+func NewBackfillTasks() *BackfillTasks {
+	return &BackfillTasks{tasks: make(map[string]*BackfillTask)}
+}
+
+// Start runs backfill in the background for the BlockhashStore at bhsAddress, and returns the running task.
+func (t *BackfillTasks) Start(evmChainID string, bhsAddress common.Address, dryRun bool, backfill BackfillFunc) (BackfillTask, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, task := range t.tasks {
+		if task.State == BackfillTaskRunning && task.EVMChainID == evmChainID && task.BHSAddress == bhsAddress {
+			return BackfillTask{}, errors.Errorf("backfill task %s is already running for BHS %s", task.ID, bhsAddress)
+		}
+	}
+
+	task := &BackfillTask{
+		ID:         uuid.NewString(),
+		EVMChainID: evmChainID,
+		BHSAddress: bhsAddress,
+		DryRun:     dryRun,
+		State:      BackfillTaskRunning,
+		StartedAt:  time.Now(),
+	}
+	t.tasks[task.ID] = task
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), backfillTaskTimeout)
+		defer cancel()
+		report, enqueued, err := backfill(ctx)
+		t.finish(task.ID, report, enqueued, err)
+	}()
+	return *task, nil
+}
+
+func (t *BackfillTasks) finish(id string, report *CoverageReport, enqueued int, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	task := t.tasks[id]
+	now := time.Now()
+	task.FinishedAt = &now
+	task.Report = report
+	task.Enqueued = enqueued
+	task.State = BackfillTaskCompleted
+	if err != nil {
+		task.State = BackfillTaskErrored
+		task.Error = err.Error()
+	}
+
+	t.finished = append(t.finished, id)
+	if len(t.finished) > finishedBackfillTasksKept {
+		delete(t.tasks, t.finished[0])
+		t.finished = t.finished[1:]
+	}
+}
+
+// Get returns the task with the given ID.
+func (t *BackfillTasks) Get(id string) (BackfillTask, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	task, ok := t.tasks[id]
+	if !ok {
+		return BackfillTask{}, ErrBackfillTaskNotFound
+	}
+	return *task, nil
+}
@@ -0,0 +1,79 @@
+package blockheaderfeeder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestBackfillTasks(t *testing.T) {
+	bhsAddress := testutils.NewAddress()
+
+	t.Run("runs in the background", func(t *testing.T) {
+		tasks := NewBackfillTasks()
+		release := make(chan struct{})
+		task, err := tasks.Start("1", bhsAddress, false, func(ctx context.Context) (*CoverageReport, int, error) {
+			<-release
+			return &CoverageReport{BlocksToStore: 7}, 7, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, BackfillTaskRunning, task.State)
+
+		_, err = tasks.Start("1", bhsAddress, true, func(ctx context.Context) (*CoverageReport, int, error) {
+			return &CoverageReport{}, 0, nil
+		})
+		require.ErrorContains(t, err, "already running")
+
+		close(release)
+		require.Eventually(t, func() bool {
+			task, err = tasks.Get(task.ID)
+			require.NoError(t, err)
+			return task.State != BackfillTaskRunning
+		}, testutils.WaitTimeout(t), testutils.TestInterval)
+		assert.Equal(t, BackfillTaskCompleted, task.State)
+		assert.Equal(t, 7, task.Report.BlocksToStore)
+		assert.Equal(t, 7, task.Enqueued)
+		assert.NotNil(t, task.FinishedAt)
+	})
+
+	t.Run("records errors", func(t *testing.T) {
+		tasks := NewBackfillTasks()
+		task, err := tasks.Start("1", bhsAddress, true, func(ctx context.Context) (*CoverageReport, int, error) {
+			return nil, 0, errors.New("boom")
+		})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			task, err = tasks.Get(task.ID)
+			require.NoError(t, err)
+			return task.State == BackfillTaskErrored
+		}, testutils.WaitTimeout(t), testutils.TestInterval)
+		assert.Equal(t, "boom", task.Error)
+	})
+
+	t.Run("forgets the oldest finished tasks", func(t *testing.T) {
+		tasks := NewBackfillTasks()
+		var ids []string
+		for range finishedBackfillTasksKept + 1 {
+			task, err := tasks.Start("1", bhsAddress, true, func(ctx context.Context) (*CoverageReport, int, error) {
+				return &CoverageReport{}, 0, nil
+			})
+			require.NoError(t, err)
+			require.Eventually(t, func() bool {
+				task, err = tasks.Get(task.ID)
+				require.NoError(t, err)
+				return task.State == BackfillTaskCompleted
+			}, testutils.WaitTimeout(t), testutils.TestInterval)
+			ids = append(ids, task.ID)
+		}
+
+		_, err := tasks.Get(ids[0])
+		require.ErrorIs(t, err, ErrBackfillTaskNotFound)
+		_, err = tasks.Get(ids[1])
+		require.NoError(t, err)
+	})
+}
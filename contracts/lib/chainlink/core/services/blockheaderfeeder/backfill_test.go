@@ -0,0 +1,124 @@
+package blockheaderfeeder
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+)
+
+func newTestBackfiller(t *testing.T, bhs *blockhashstore.TestBHS, batchBHS *blockhashstore.TestBatchBHS, latest uint64) *Backfiller {
+	coordinator := &blockhashstore.TestCoordinator{
+		RequestEvents: []blockhashstore.Event{
+			{Block: 150, ID: "request1"},
+			{Block: 148, ID: "request2"},
+			{Block: 160, ID: "request3"},
+			{Block: 170, ID: "request4"},
+		},
+		FulfillmentEvents: []blockhashstore.Event{{Block: 171, ID: "request4"}},
+	}
+	return NewBackfiller(
+		logger.TestLogger(t),
+		coordinator,
+		bhs,
+		batchBHS,
+		&blockhashstore.TestBlockHeaderProvider{},
+		func(ctx context.Context) (uint64, error) {
+			return latest, nil
+		},
+		func(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) (uint64, error) {
+			return uint64(100 * len(blockNumbers)), nil
+		},
+		10,
+		3,
+	)
+}
+
+func TestBackfiller(t *testing.T) {
+	fromAddress := testutils.NewAddress()
+
+	t.Run("backfills from the earliest stored blockhash", func(t *testing.T) {
+		bhs := &blockhashstore.TestBHS{Stored: []uint64{160}}
+		batchBHS := &blockhashstore.TestBatchBHS{Stored: []uint64{155}}
+		backfiller := newTestBackfiller(t, bhs, batchBHS, 450)
+
+		report, err := backfiller.Coverage(testutils.Context(t), 100, 200, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.RequestBlocks)
+		assert.Equal(t, 1, report.StoredBlocks)
+		assert.Equal(t, 3, report.UnfulfilledRequests)
+		assert.Equal(t, []uint64{148, 150}, report.MissingBlocks)
+		assert.InDelta(t, 1.0/3, report.Covered(), 0.001)
+		require.NotNil(t, report.AnchorBlock)
+		assert.Equal(t, uint64(155), *report.AnchorBlock)
+		assert.Equal(t, 7, report.BlocksToStore)
+		assert.Equal(t, 3, report.Batches)
+		assert.Equal(t, uint64(700), report.EstimatedGas)
+
+		enqueued, err := backfiller.Backfill(testutils.Context(t), report, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 7, enqueued)
+		assert.Equal(t, uint16(3), batchBHS.StoreVerifyHeaderCallCounter)
+		assert.ElementsMatch(t, []uint64{148, 149, 150, 151, 152, 153, 154, 155}, batchBHS.Stored)
+		assert.False(t, bhs.StoredEarliest)
+	})
+
+	t.Run("stores earliest without an anchor", func(t *testing.T) {
+		bhs := &blockhashstore.TestBHS{}
+		batchBHS := &blockhashstore.TestBatchBHS{}
+		backfiller := newTestBackfiller(t, bhs, batchBHS, 450)
+
+		report, err := backfiller.Coverage(testutils.Context(t), 100, 200, fromAddress)
+		require.NoError(t, err)
+		assert.Nil(t, report.AnchorBlock)
+		assert.Equal(t, int(450-256-148), report.BlocksToStore)
+		assert.Zero(t, report.EstimatedGas)
+
+		enqueued, err := backfiller.Backfill(testutils.Context(t), report, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 1, enqueued)
+		assert.True(t, bhs.StoredEarliest)
+		assert.Zero(t, batchBHS.StoreVerifyHeaderCallCounter)
+	})
+
+	t.Run("fully covered", func(t *testing.T) {
+		bhs := &blockhashstore.TestBHS{Stored: []uint64{148, 150, 160}}
+		batchBHS := &blockhashstore.TestBatchBHS{}
+		backfiller := newTestBackfiller(t, bhs, batchBHS, 450)
+
+		report, err := backfiller.Coverage(testutils.Context(t), 100, 200, fromAddress)
+		require.NoError(t, err)
+		assert.Empty(t, report.MissingBlocks)
+		assert.Equal(t, float64(1), report.Covered())
+
+		enqueued, err := backfiller.Backfill(testutils.Context(t), report, fromAddress)
+		require.NoError(t, err)
+		assert.Zero(t, enqueued)
+	})
+
+	t.Run("too many blocks to store", func(t *testing.T) {
+		bhs := &blockhashstore.TestBHS{}
+		batchBHS := &blockhashstore.TestBatchBHS{}
+		backfiller := newTestBackfiller(t, bhs, batchBHS, 450)
+
+		anchor := uint64(155)
+		report := &CoverageReport{MissingBlocks: []uint64{148}, AnchorBlock: &anchor, BlocksToStore: MaxBlocksToStore + 1}
+		_, err := backfiller.Backfill(testutils.Context(t), report, fromAddress)
+		require.ErrorContains(t, err, "narrow the block range")
+		assert.Zero(t, batchBHS.StoreVerifyHeaderCallCounter)
+	})
+
+	t.Run("range must be before the latest block", func(t *testing.T) {
+		backfiller := newTestBackfiller(t, &blockhashstore.TestBHS{}, &blockhashstore.TestBatchBHS{}, 150)
+
+		_, err := backfiller.Coverage(testutils.Context(t), 100, 200, fromAddress)
+		require.ErrorContains(t, err, "must be lower than the latest block")
+	})
+}
@@ -14,9 +14,6 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/batch_blockhash_store"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/blockhash_store"
-	v1 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/solidity_vrf_coordinator_interface"
-	v2 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2"
-	v2plus "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2plus_interface"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -91,7 +88,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 			chain.Config().EVM().FinalityDepth(), jb.BlockHeaderFeederSpec.LookbackBlocks)
 	}
 
-	ks := keys.NewChainStore(keystore.NewEthSigner(d.ks, cid), cid)
+	ks := keys.NewChainStore(keystore.NewEthSigner(d.ks, cid).WithCaller("BlockHeaderFeeder", &jb.ID), cid)
 	enabled, err := ks.EnabledAddresses(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "getting sending keys")
@@ -104,114 +101,89 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	}
 	fromAddresses := jb.BlockHeaderFeederSpec.FromAddresses
 
-	bhs, err := blockhash_store.NewBlockhashStore(
-		jb.BlockHeaderFeederSpec.BlockhashStoreAddress.Address(), chain.Client())
-	if err != nil {
-		return nil, errors.Wrap(err, "building BHS")
-	}
-
-	batchBlockhashStore, err := batch_blockhash_store.NewBatchBlockhashStore(
-		jb.BlockHeaderFeederSpec.BatchBlockhashStoreAddress.Address(), chain.Client())
-	if err != nil {
-		return nil, errors.Wrap(err, "building batch BHS")
-	}
-
-	lp := chain.LogPoller()
-	var coordinators []blockhashstore.Coordinator
-	if jb.BlockHeaderFeederSpec.CoordinatorV1Address != nil {
-		var c *v1.VRFCoordinator
-		if c, err = v1.NewVRFCoordinator(
-			jb.BlockHeaderFeederSpec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
-			return nil, errors.Wrap(err, "building V1 coordinator")
-		}
-		var coord *blockhashstore.V1Coordinator
-		coord, err = blockhashstore.NewV1Coordinator(ctx, c, lp)
+	log := d.logger.Named("BlockHeaderFeeder").With(
+		"jobID", jb.ID,
+		"externalJobID", jb.ExternalJobID,
+	)
+	scanCache := blockhashstore.NewScanCache(func(ctx context.Context) (uint64, error) {
+		head, err := chain.Client().HeadByNumber(ctx, nil)
 		if err != nil {
-			return nil, errors.Wrap(err, "building V1 coordinator")
+			return 0, errors.Wrap(err, "getting chain head")
 		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockHeaderFeederSpec.CoordinatorV2Address != nil {
-		var c *v2.VRFCoordinatorV2
-		if c, err = v2.NewVRFCoordinatorV2(
-			jb.BlockHeaderFeederSpec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
-			return nil, errors.Wrap(err, "building V2 coordinator")
+		return uint64(head.Number), nil
+	})
+	blockHeaderProvider := NewGethBlockHeaderProvider(chain.Client())
+
+	// Coordinators are built once per address, so that a coordinator feeding several stores
+	// registers a single log poller filter and is scanned once per poll cycle.
+	coordinators := make(map[common.Address]blockhashstore.Coordinator)
+	var feeders []*BlockHeaderFeeder
+	for _, store := range jb.BlockHeaderFeederSpec.Stores() {
+		var storeCoordinators []blockhashstore.Coordinator
+		storeCoordinators, err = blockhashstore.BuildStoreCoordinators(ctx, chain, scanCache, coordinators, store)
+		if err != nil {
+			return nil, err
 		}
-		var coord *blockhashstore.V2Coordinator
-		coord, err = blockhashstore.NewV2Coordinator(ctx, c, lp)
+
+		var bhs *blockhash_store.BlockhashStore
+		bhs, err = blockhash_store.NewBlockhashStore(store.BlockhashStoreAddress.Address(), chain.Client())
 		if err != nil {
-			return nil, errors.Wrap(err, "building V2 coordinator")
+			return nil, errors.Wrap(err, "building BHS")
 		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockHeaderFeederSpec.CoordinatorV2PlusAddress != nil {
-		var c v2plus.IVRFCoordinatorV2PlusInternalInterface
-		if c, err = v2plus.NewIVRFCoordinatorV2PlusInternal(
-			jb.BlockHeaderFeederSpec.CoordinatorV2PlusAddress.Address(), chain.Client()); err != nil {
-			return nil, errors.Wrap(err, "building V2 plus coordinator")
+
+		var batchBlockhashStore *batch_blockhash_store.BatchBlockhashStore
+		batchBlockhashStore, err = batch_blockhash_store.NewBatchBlockhashStore(
+			store.BatchBlockhashStoreAddress.Address(), chain.Client())
+		if err != nil {
+			return nil, errors.Wrap(err, "building batch BHS")
 		}
-		var coord *blockhashstore.V2PlusCoordinator
-		coord, err = blockhashstore.NewV2PlusCoordinator(ctx, c, lp)
+
+		var bpBHS *blockhashstore.BulletproofBHS
+		bpBHS, err = blockhashstore.NewBulletproofBHS(
+			chain.Config().EVM().GasEstimator(),
+			d.cfg.Database(),
+			fromAddresses,
+			chain.TxManager(),
+			bhs,
+			nil,
+			ks,
+		)
 		if err != nil {
-			return nil, errors.Wrap(err, "building V2 plus coordinator")
+			return nil, errors.Wrap(err, "building bulletproof bhs")
 		}
-		coordinators = append(coordinators, coord)
-	}
 
-	bpBHS, err := blockhashstore.NewBulletproofBHS(
-		chain.Config().EVM().GasEstimator(),
-		d.cfg.Database(),
-		fromAddresses,
-		chain.TxManager(),
-		bhs,
-		nil,
-		ks,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "building bulletproof bhs")
-	}
+		var batchBHS *blockhashstore.BatchBlockhashStore
+		batchBHS, err = blockhashstore.NewBatchBHS(
+			chain.Config().EVM().GasEstimator(),
+			chain.TxManager(),
+			batchBlockhashStore,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "building batchBHS")
+		}
 
-	batchBHS, err := blockhashstore.NewBatchBHS(
-		chain.Config().EVM().GasEstimator(),
-		chain.TxManager(),
-		batchBlockhashStore,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "building batchBHS")
+		feeders = append(feeders, NewBlockHeaderFeeder(
+			log.With(
+				"bhsAddress", bhs.Address(),
+				"batchBHSAddress", batchBlockhashStore.Address(),
+			),
+			blockhashstore.NewMultiCoordinator(storeCoordinators...),
+			bpBHS,
+			batchBHS,
+			blockHeaderProvider,
+			int(jb.BlockHeaderFeederSpec.WaitBlocks),
+			int(jb.BlockHeaderFeederSpec.LookbackBlocks),
+			scanCache.LatestBlock,
+			ks,
+			jb.BlockHeaderFeederSpec.GetBlockhashesBatchSize,
+			jb.BlockHeaderFeederSpec.StoreBlockhashesBatchSize,
+			fromAddresses,
+		))
 	}
 
-	log := d.logger.Named("BlockHeaderFeeder").With(
-		"jobID", jb.ID,
-		"externalJobID", jb.ExternalJobID,
-		"bhsAddress", bhs.Address(),
-		"batchBHSAddress", batchBlockhashStore.Address(),
-	)
-
-	blockHeaderProvider := NewGethBlockHeaderProvider(chain.Client())
-
-	feeder := NewBlockHeaderFeeder(
-		log,
-		blockhashstore.NewMultiCoordinator(coordinators...),
-		bpBHS,
-		batchBHS,
-		blockHeaderProvider,
-		int(jb.BlockHeaderFeederSpec.WaitBlocks),
-		int(jb.BlockHeaderFeederSpec.LookbackBlocks),
-		func(ctx context.Context) (uint64, error) {
-			head, err := chain.Client().HeadByNumber(ctx, nil)
-			if err != nil {
-				return 0, errors.Wrap(err, "getting chain head")
-			}
-			return uint64(head.Number), nil
-		},
-		ks,
-		jb.BlockHeaderFeederSpec.GetBlockhashesBatchSize,
-		jb.BlockHeaderFeederSpec.StoreBlockhashesBatchSize,
-		fromAddresses,
-	)
-
 	services := []job.ServiceCtx{&service{
-		feeder:     feeder,
+		feeders:    feeders,
+		scanCache:  scanCache,
 		pollPeriod: jb.BlockHeaderFeederSpec.PollPeriod,
 		runTimeout: jb.BlockHeaderFeederSpec.RunTimeout,
 		logger:     log,
@@ -232,10 +204,11 @@ func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
 // OnDeleteJob satisfies the job.Delegate interface.
 func (d *Delegate) OnDeleteJob(context.Context, job.Job) error { return nil }
 
-// service is a job.Service that runs the BHS feeder every pollPeriod.
+// service is a job.Service that runs the block header feeders of a job every pollPeriod.
 type service struct {
 	services.StateMachine
-	feeder     *BlockHeaderFeeder
+	feeders    []*BlockHeaderFeeder
+	scanCache  *blockhashstore.ScanCache
 	done       chan struct{}
 	pollPeriod time.Duration
 	runTimeout time.Duration
@@ -246,7 +219,7 @@ type service struct {
 // Start the BHS feeder service, satisfying the job.Service interface.
 func (s *service) Start(context.Context) error {
 	return s.StartOnce("Block Header Feeder Service", func() error {
-		s.logger.Infow("Starting BlockHeaderFeeder")
+		s.logger.Infow("Starting BlockHeaderFeeder", "stores", len(s.feeders))
 		s.stopCh = make(chan struct{})
 		go func() {
 			defer close(s.done)
@@ -279,9 +252,8 @@ func (s *service) Close() error {
 
 func (s *service) runFeeder(ctx context.Context) {
 	s.logger.Debugw("Running BlockHeaderFeeder")
-	ctx, cancel := context.WithTimeout(ctx, s.runTimeout)
-	defer cancel()
-	err := s.feeder.Run(ctx)
+	s.scanCache.Reset()
+	err := blockhashstore.RunFeeders(ctx, s.runTimeout, s.feeders)
 	if err == nil {
 		s.logger.Debugw("BlockHeaderFeeder run completed successfully")
 	} else {
@@ -7,6 +7,7 @@ import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -57,6 +58,18 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 		return jb, err
 	}
 
+	if err = blockhashstore.ValidateStores(spec.Stores()); err != nil {
+		return jb, err
+	}
+	for _, store := range spec.AdditionalStores {
+		if store.BatchBlockhashStoreAddress == nil || *store.BatchBlockhashStoreAddress == "" {
+			return jb, errors.Errorf(`"batchBlockhashStoreAddress" must be set for store %s`, store.BlockhashStoreAddress)
+		}
+		if store.TrustedBlockhashStoreAddress != nil {
+			return jb, errors.New(`"trustedBlockhashStoreAddress" is not supported in "additionalStores" of block header feeder jobs`)
+		}
+	}
+
 	// Defaults
 	if spec.WaitBlocks == 0 {
 		spec.WaitBlocks = 256
@@ -95,6 +95,49 @@ fromAddresses = ["0x469aA2CD13e037DC5236320783dCfd0e641c0559"]
 					os.BlockHeaderFeederSpec.StoreBlockhashesBatchSize)
 			},
 		},
+		{
+			name: "additional stores",
+			toml: `
+type = "blockheaderfeeder"
+name = "additional-stores-test"
+coordinatorV1Address = "0x1F72B4A5DCf7CC6d2E38423bF2f4BFA7db97d139"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+batchBlockhashStoreAddress = "0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63"
+evmChainID = "4"
+
+[[additionalStores]]
+coordinatorV2PlusAddress = "0x92B5e28Ac583812874e4271380c7d070C5FB6E6b"
+blockhashStoreAddress = "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+batchBlockhashStoreAddress = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.Len(t, os.BlockHeaderFeederSpec.AdditionalStores, 1)
+				stores := os.BlockHeaderFeederSpec.Stores()
+				require.Len(t, stores, 2)
+				require.Equal(t, types.EIP55Address("0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63"), *stores[0].BatchBlockhashStoreAddress)
+				require.Equal(t, &v2PlusCoordinator, stores[1].CoordinatorV2PlusAddress)
+				require.Equal(t, types.EIP55Address("0x2be990eE17832b59E0086534c5ea2459Aa75E38F"), *stores[1].BatchBlockhashStoreAddress)
+			},
+		},
+		{
+			name: "additional store without batch blockhash store",
+			toml: `
+type = "blockheaderfeeder"
+name = "additional-stores-test"
+coordinatorV1Address = "0x1F72B4A5DCf7CC6d2E38423bF2f4BFA7db97d139"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+batchBlockhashStoreAddress = "0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63"
+evmChainID = "4"
+
+[[additionalStores]]
+coordinatorV2PlusAddress = "0x92B5e28Ac583812874e4271380c7d070C5FB6E6b"
+blockhashStoreAddress = "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.ErrorContains(t, err, `"batchBlockhashStoreAddress" must be set for store`)
+			},
+		},
 		{
 			name: "invalid-job-type",
 			toml: `
@@ -8,7 +8,9 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -64,6 +66,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
@@ -71,7 +74,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyusage"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
+	"github.com/smartcontractkit/chainlink/v2/core/services/loglevel"
 	"github.com/smartcontractkit/chainlink/v2/core/services/nodestatusreporter/bridgestatus"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
@@ -91,6 +96,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	artifactsV1 "github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
@@ -104,9 +110,11 @@ import (
 	wftypes "github.com/smartcontractkit/chainlink/v2/core/services/workflows/types"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/services/workflows/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
@@ -121,6 +129,7 @@ type Application interface {
 	GetDB() sqlutil.DataSource
 	GetConfig() GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	ReloadConfig(ctx context.Context) (ConfigReload, error)
 	GetKeyStore() keystore.Master
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
@@ -137,6 +146,16 @@ type Application interface {
 	BridgeORM() bridges.ORM
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	// CustomRolesORM stores the custom roles granting users permissions on top of their base role.
+	CustomRolesORM() rbac.ORM
+	// APITokensORM stores the named API tokens of users.
+	APITokensORM() apitokens.ORM
+	// KeyUsageORM stores the signatures produced with the keys of the keystore.
+	KeyUsageORM() keyusage.ORM
+	// LogLevelOverrides manages the log levels of the loggers of single jobs or job types.
+	LogLevelOverrides() *loglevel.Manager
+	// Nurse gathers the pprof profiles of the node, or is nil if AutoPprof is disabled.
+	Nurse() *services.Nurse
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
@@ -148,6 +167,9 @@ type Application interface {
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// VRFRequestInspectors returns the request inspectors of the running VRF v2 and v2.5 jobs.
+	VRFRequestInspectors() *vrfv2.InspectorRegistry
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast (evm only)
 	// is set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error
@@ -161,6 +183,14 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.Block, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+
+	// Drain stops starting new pipeline runs and lets the in-flight ones complete for up to timeout, before planned
+	// maintenance.
+	Drain(timeout time.Duration) (DrainStatus, error)
+	// Undrain starts new pipeline runs again.
+	Undrain() DrainStatus
+	// DrainStatus returns the drain mode of the node.
+	DrainStatus() DrainStatus
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -175,9 +205,15 @@ type ChainlinkApplication struct {
 	bridgeORM                bridges.ORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider // Note: this will be OIDC instance
+	customRolesORM           rbac.ORM
+	apiTokensORM             apitokens.ORM
+	keyUsageORM              keyusage.ORM
+	logLevelOverrides        *loglevel.Manager
+	nurse                    *services.Nurse
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
+	telemetryManager         *telemetry.Manager
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
@@ -193,9 +229,14 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 	loopRegistry             *plugins.LoopRegistry
 	loopRegistrarConfig      plugins.RegistrarConfig
+	vrfRequestInspectors     *vrfv2.InspectorRegistry
 
 	started     bool
 	startStopMu sync.Mutex
+
+	drainMu  sync.Mutex
+	drain    DrainStatus
+	drainGen int // identifies the current drain, to ignore the outcome of a cancelled one
 }
 
 type ApplicationOpts struct {
@@ -254,7 +295,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		opts.DonTimeStore = dontime.NewStore(dontime.DefaultRequestTimeout)
 	}
 
-	csaKeystore := &keystore.CSASigner{CSA: keyStore.CSA()}
+	csaKeystore := &keystore.CSASigner{CSA: keyStore.CSA(), Subsystem: "Node"}
 	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(ctx, keyStore.CSA())
 	if err != nil {
 		return nil, fmt.Errorf("failed to build Beholder auth: %w", err)
@@ -383,6 +424,21 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		srvcs = append(srvcs, auditLogger)
 	}
 
+	// Record the signatures produced with the keystore, as audit events and optionally in the key usage log
+	keyUsageORM := keyusage.NewORM(opts.DS)
+	if auditLogger.Ready() == nil || cfg.AuditLogger().KeyUsageStore() {
+		var store keyusage.ORM
+		if cfg.AuditLogger().KeyUsageStore() {
+			store = keyUsageORM
+		}
+		keyUsageRecorder := keyusage.NewRecorder(auditLogger, store, globalLogger)
+		keyStore.SetKeyUsageRecorder(keyUsageRecorder.Record)
+		srvcs = append(srvcs, keyUsageRecorder)
+	}
+
+	logLevelOverrides := loglevel.NewManager(loglevel.NewORM(opts.DS), globalLogger)
+	srvcs = append(srvcs, logLevelOverrides)
+
 	var profiler *pyroscope.Profiler
 	if cfg.Pyroscope().ServerAddress() != "" {
 		globalLogger.Debug("Pyroscope (automatic pprof profiling) is enabled")
@@ -395,10 +451,12 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		globalLogger.Debug("Pyroscope (automatic pprof profiling) is disabled")
 	}
 
+	var nurse *services.Nurse
 	ap := cfg.AutoPprof()
 	if ap.Enabled() {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is enabled")
-		srvcs = append(srvcs, services.NewNurse(ap, globalLogger))
+		nurse = services.NewNurse(ap, globalLogger)
+		srvcs = append(srvcs, nurse)
 	} else {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is disabled")
 	}
@@ -443,7 +501,8 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	// Initialize Local Users ORM and Authentication Provider specified in config
 	// BasicAdminUsersORM is initialized and required regardless of separate Authentication Provider
-	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
+	loginLockout := localauth.NewLockoutConfig(cfg.WebServer().LoginLockout())
+	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger, loginLockout)
 
 	// Initialize Sessions ORM based on environment configured authenticator
 	// localDB auth, LDAP auth, or OIDC auth
@@ -473,7 +532,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		}
 		sessionReaper = oidcauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	case sessions.LocalAuth:
-		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
+		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger, loginLockout)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
 		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
@@ -499,7 +558,11 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	legacyEVMTelemReporter := headreporter.NewLegacyEVMTelemetryReporter(telemetryManager, globalLogger, evmChainIDs...)
 	loopTelemReporter := headreporter.NewTelemetryReporter(telemetryManager, globalLogger, relayChainInterops.GetIDToRelayerMap())
-	headReporter := headreporter.NewHeadReporterService(opts.DS, globalLogger, promReporter, legacyEVMTelemReporter, loopTelemReporter)
+	headReporters := []headreporter.HeadReporter{promReporter, legacyEVMTelemReporter, loopTelemReporter}
+	if summaryBackends := headreporter.NewSummaryBackends(cfg.HeadReport()); len(summaryBackends) > 0 {
+		headReporters = append(headReporters, headreporter.NewLegacyEVMSummaryReporter(legacyEVMChains, summaryBackends...))
+	}
+	headReporter := headreporter.NewHeadReporterService(opts.DS, globalLogger, headReporters...)
 	srvcs = append(srvcs, headReporter)
 	for _, chain := range legacyEVMChains.Slice() {
 		legacyChain, ok := chain.(legacyevm.Chain)
@@ -514,6 +577,15 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, loopRegistry.Register, loopRegistry.Unregister)
 
+	vrfDelegate := vrf.NewDelegate(
+		opts.DS,
+		keyStore,
+		pipelineRunner,
+		pipelineORM,
+		legacyEVMChains,
+		globalLogger,
+		mailMon)
+
 	var (
 		delegates = map[job.Type]job.Delegate{
 			job.DirectRequest: directrequest.NewDelegate(
@@ -530,14 +602,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				globalLogger,
 				legacyEVMChains,
 				mailMon),
-			job.VRF: vrf.NewDelegate(
-				opts.DS,
-				keyStore,
-				pipelineRunner,
-				pipelineORM,
-				legacyEVMChains,
-				globalLogger,
-				mailMon),
+			job.VRF: vrfDelegate,
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
@@ -545,6 +610,12 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
 				globalLogger),
+			job.EVMLog: evmlog.NewDelegate(
+				cfg,
+				opts.DS,
+				globalLogger,
+				legacyEVMChains,
+				pipelineRunner),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
 				globalLogger,
@@ -787,10 +858,16 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		customRolesORM:           rbac.NewORM(opts.DS),
+		apiTokensORM:             apitokens.NewORM(opts.DS),
+		keyUsageORM:              keyUsageORM,
+		logLevelOverrides:        logLevelOverrides,
+		nurse:                    nurse,
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
+		telemetryManager:         telemetryManager,
 		KeyStore:                 keyStore,
 		SessionReaper:            sessionReaper,
 		ExternalInitiatorManager: externalInitiatorManager,
@@ -802,8 +879,10 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
 		loopRegistrarConfig:      loopRegistrarConfig,
+		vrfRequestInspectors:     vrfDelegate.RequestInspectors(),
 
-		ds: opts.DS,
+		ds:    opts.DS,
+		drain: DrainStatus{State: DrainStateActive},
 
 		// NOTE: Can keep things clean by putting more things in srvcs instead of manually start/closing
 		srvcs: srvcs,
@@ -923,7 +1002,7 @@ func newCREServices(
 		}
 		gatewayConnectorWrapper = gatewayconnector.NewGatewayConnectorServiceWrapper(
 			capCfg.GatewayConnector(),
-			keys.NewStore(keystore.NewEthSigner(keyStore.Eth(), chainID)),
+			keys.NewStore(keystore.NewEthSigner(keyStore.Eth(), chainID).WithCaller("GatewayConnector", nil)),
 			clockwork.NewRealClock(),
 			globalLogger)
 		srvcs = append(srvcs, gatewayConnectorWrapper)
@@ -1210,6 +1289,30 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// ReloadConfig reloads the configuration, and applies the changed reloadable keys to the running services.
+func (app *ChainlinkApplication) ReloadConfig(ctx context.Context) (ConfigReload, error) {
+	reload, err := app.Config.Reload()
+	if err != nil {
+		return reload, err
+	}
+	if slices.Contains(reload.Applied, "Log.Level") {
+		app.logger.SetLogLevel(app.Config.Log().Level())
+	}
+	if slices.ContainsFunc(reload.Applied, func(key string) bool { return strings.HasPrefix(key, "TelemetryIngress.Endpoints") }) {
+		if err = app.telemetryManager.ReloadEndpoints(ctx, app.Config.TelemetryIngress()); err != nil {
+			return reload, err
+		}
+	}
+
+	if len(reload.RestartRequired) > 0 {
+		app.logger.Warnw("Reloaded configuration, but some changes require a restart", "applied", reload.Applied, "restartRequired", reload.RestartRequired)
+	} else {
+		app.logger.Infow("Reloaded configuration", "applied", reload.Applied)
+	}
+	app.AuditLogger.Audit(audit.ConfigReloaded, map[string]interface{}{"applied": reload.Applied, "restartRequired": reload.RestartRequired})
+	return reload, nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -1275,6 +1378,10 @@ func (app *ChainlinkApplication) GetLoopRegistrarConfig() plugins.RegistrarConfi
 	return app.loopRegistrarConfig
 }
 
+func (app *ChainlinkApplication) VRFRequestInspectors() *vrfv2.InspectorRegistry {
+	return app.vrfRequestInspectors
+}
+
 // Stop allows the application to exit by halting schedules, closing
 // logs, and closing the DB connection.
 func (app *ChainlinkApplication) Stop() error {
@@ -1366,6 +1473,26 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) CustomRolesORM() rbac.ORM {
+	return app.customRolesORM
+}
+
+func (app *ChainlinkApplication) APITokensORM() apitokens.ORM {
+	return app.apiTokensORM
+}
+
+func (app *ChainlinkApplication) KeyUsageORM() keyusage.ORM {
+	return app.keyUsageORM
+}
+
+func (app *ChainlinkApplication) LogLevelOverrides() *loglevel.Manager {
+	return app.logLevelOverrides
+}
+
+func (app *ChainlinkApplication) Nurse() *services.Nurse {
+	return app.nurse
+}
+
 func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
@@ -388,6 +388,14 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "Pyroscope"))
 	}
 
+	if err2 := s.AutoPprof.SetFrom(&f.AutoPprof); err2 != nil {
+		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "AutoPprof"))
+	}
+
+	if err2 := s.AuditLogger.SetFrom(&f.AuditLogger); err2 != nil {
+		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "AuditLogger"))
+	}
+
 	if err2 := s.Prometheus.SetFrom(&f.Prometheus); err2 != nil {
 		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "Prometheus"))
 	}
@@ -1,14 +1,21 @@
 package chainlink
 
 import (
+	"path/filepath"
+	"time"
+
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type auditLoggerConfig struct {
-	c toml.AuditLogger
+	c       toml.AuditLogger
+	s       toml.AuditLoggerSecrets
+	rootDir func() string
 }
 
 func (a auditLoggerConfig) Enabled() bool {
@@ -33,3 +40,85 @@ func (a auditLoggerConfig) JsonWrapperKey() string {
 func (a auditLoggerConfig) Headers() (models.ServiceHeaders, error) {
 	return *a.c.Headers, nil
 }
+
+func (a auditLoggerConfig) KeyUsageStore() bool {
+	return *a.c.KeyUsageStore
+}
+
+func (a auditLoggerConfig) BatchSize() uint32 {
+	return *a.c.BatchSize
+}
+
+func (a auditLoggerConfig) BatchInterval() time.Duration {
+	return a.c.BatchInterval.Duration()
+}
+
+// RetryQueueDir is where the events that could not be forwarded are queued.
+func (a auditLoggerConfig) RetryQueueDir() string {
+	return filepath.Join(a.rootDir(), "audit", "retry")
+}
+
+func (a auditLoggerConfig) RetryQueueMaxSize() utils.FileSize {
+	return *a.c.RetryQueueMaxSize
+}
+
+func (a auditLoggerConfig) File() config.AuditLoggerFile {
+	return auditLoggerFileConfig{c: a.c.File, s: a.s, rootDir: a.rootDir}
+}
+
+func (a auditLoggerConfig) Syslog() config.AuditLoggerSyslog {
+	return auditLoggerSyslogConfig{c: a.c.Syslog}
+}
+
+type auditLoggerFileConfig struct {
+	c       toml.AuditLoggerFile
+	s       toml.AuditLoggerSecrets
+	rootDir func() string
+}
+
+func (f auditLoggerFileConfig) Enabled() bool {
+	return *f.c.Enabled
+}
+
+func (f auditLoggerFileConfig) Dir() string {
+	s := *f.c.Dir
+	if s == "" {
+		s = filepath.Join(f.rootDir(), "audit")
+	}
+	return s
+}
+
+func (f auditLoggerFileConfig) MaxSize() utils.FileSize {
+	return *f.c.MaxSize
+}
+
+func (f auditLoggerFileConfig) MaxBackups() int64 {
+	return *f.c.MaxBackups
+}
+
+func (f auditLoggerFileConfig) ChainKey() string {
+	if f.s.FileChainKey == nil {
+		return ""
+	}
+	return string(*f.s.FileChainKey)
+}
+
+type auditLoggerSyslogConfig struct {
+	c toml.AuditLoggerSyslog
+}
+
+func (s auditLoggerSyslogConfig) Enabled() bool {
+	return *s.c.Enabled
+}
+
+func (s auditLoggerSyslogConfig) Network() string {
+	return *s.c.Network
+}
+
+func (s auditLoggerSyslogConfig) Address() string {
+	return *s.c.Address
+}
+
+func (s auditLoggerSyslogConfig) Tag() string {
+	return *s.c.Tag
+}
@@ -2,13 +2,18 @@ package chainlink
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestAuditLoggerConfig(t *testing.T) {
 	opts := GeneralConfigOpts{
 		ConfigStrings: []string{fullTOML},
+		SecretsStrings: []string{`[AuditLogger]
+FileChainKey = "audit-chain-key-0123456789abcdef0123"`},
 	}
 	cfg, err := opts.New()
 	require.NoError(t, err)
@@ -17,6 +22,7 @@ func TestAuditLoggerConfig(t *testing.T) {
 
 	require.True(t, auditConfig.Enabled())
 	require.Equal(t, "event", auditConfig.JsonWrapperKey())
+	require.True(t, auditConfig.KeyUsageStore())
 
 	fUrl, err := auditConfig.ForwardToUrl()
 	require.NoError(t, err)
@@ -30,4 +36,21 @@ func TestAuditLoggerConfig(t *testing.T) {
 	require.Equal(t, "token", headers[0].Value)
 	require.Equal(t, "X-SomeOther-Header", headers[1].Header)
 	require.Equal(t, "value with spaces | and a bar+*", headers[1].Value)
+
+	require.Equal(t, uint32(100), auditConfig.BatchSize())
+	require.Equal(t, 5*time.Second, auditConfig.BatchInterval())
+	require.Equal(t, utils.FileSize(10*utils.MB), auditConfig.RetryQueueMaxSize())
+
+	file := auditConfig.File()
+	require.True(t, file.Enabled())
+	require.Equal(t, "/var/log/chainlink/audit", file.Dir())
+	require.Equal(t, utils.FileSize(100*utils.MB), file.MaxSize())
+	require.Equal(t, int64(10), file.MaxBackups())
+	require.Equal(t, "audit-chain-key-0123456789abcdef0123", file.ChainKey())
+
+	syslog := auditConfig.Syslog()
+	require.True(t, syslog.Enabled())
+	require.Equal(t, "udp", syslog.Network())
+	require.Equal(t, "localhost:514", syslog.Address())
+	require.Equal(t, "chainlink", syslog.Tag())
 }
@@ -1,7 +1,9 @@
 package chainlink
 
 import (
+	"net/url"
 	"path/filepath"
+	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -13,6 +15,7 @@ var _ config.AutoPprof = (*autoPprofConfig)(nil)
 
 type autoPprofConfig struct {
 	c       toml.AutoPprof
+	s       toml.AutoPprofSecrets
 	rootDir func() string
 }
 
@@ -67,3 +70,50 @@ func (a *autoPprofConfig) ProfileRoot() string {
 	}
 	return s
 }
+
+func (a *autoPprofConfig) Upload() config.AutoPprofUpload {
+	return &autoPprofUploadConfig{c: a.c.Upload, s: a.s}
+}
+
+type autoPprofUploadConfig struct {
+	c toml.AutoPprofUpload
+	s toml.AutoPprofSecrets
+}
+
+func (u *autoPprofUploadConfig) Enabled() bool {
+	return *u.c.Enabled
+}
+
+func (u *autoPprofUploadConfig) Endpoint() *url.URL {
+	return u.c.Endpoint.URL()
+}
+
+func (u *autoPprofUploadConfig) Bucket() string {
+	return *u.c.Bucket
+}
+
+func (u *autoPprofUploadConfig) Region() string {
+	return *u.c.Region
+}
+
+func (u *autoPprofUploadConfig) Prefix() string {
+	return *u.c.Prefix
+}
+
+func (u *autoPprofUploadConfig) Timeout() time.Duration {
+	return u.c.Timeout.Duration()
+}
+
+func (u *autoPprofUploadConfig) AccessKeyID() string {
+	if u.s.UploadAccessKeyID == nil {
+		return ""
+	}
+	return string(*u.s.UploadAccessKeyID)
+}
+
+func (u *autoPprofUploadConfig) SecretAccessKey() string {
+	if u.s.UploadSecretAccessKey == nil {
+		return ""
+	}
+	return string(*u.s.UploadSecretAccessKey)
+}
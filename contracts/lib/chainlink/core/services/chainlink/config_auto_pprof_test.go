@@ -13,6 +13,9 @@ import (
 func TestAutoPprofTest(t *testing.T) {
 	opts := GeneralConfigOpts{
 		ConfigStrings: []string{fullTOML},
+		SecretsStrings: []string{`[AutoPprof]
+UploadAccessKeyID = "profile-access-key"
+UploadSecretAccessKey = "profile-secret-key"`},
 	}
 	cfg, err := opts.New()
 	require.NoError(t, err)
@@ -30,4 +33,14 @@ func TestAutoPprofTest(t *testing.T) {
 	assert.Equal(t, 2, ap.MutexProfileFraction())
 	assert.Equal(t, utils.FileSize(1*utils.GB), ap.MemThreshold())
 	assert.Equal(t, 999, ap.GoroutineThreshold())
+
+	upload := ap.Upload()
+	assert.True(t, upload.Enabled())
+	assert.Equal(t, "https://s3.us-east-1.amazonaws.com", upload.Endpoint().String())
+	assert.Equal(t, "chainlink-profiles", upload.Bucket())
+	assert.Equal(t, "us-east-1", upload.Region())
+	assert.Equal(t, "node-1/", upload.Prefix())
+	assert.Equal(t, time.Minute, upload.Timeout())
+	assert.Equal(t, "profile-access-key", upload.AccessKeyID())
+	assert.Equal(t, "profile-secret-key", upload.SecretAccessKey())
 }
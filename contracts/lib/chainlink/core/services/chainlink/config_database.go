@@ -33,6 +33,65 @@ func (b *backupConfig) URL() *url.URL {
 	return b.s.BackupURL.URL()
 }
 
+func (b *backupConfig) EncryptionPublicKey() string {
+	return *b.c.EncryptionPublicKey
+}
+
+func (b *backupConfig) MaxBackups() uint32 {
+	return *b.c.MaxBackups
+}
+
+func (b *backupConfig) MaxAge() time.Duration {
+	return b.c.MaxAge.Duration()
+}
+
+func (b *backupConfig) Upload() config.BackupUpload {
+	return &backupUploadConfig{c: b.c.Upload, s: b.s}
+}
+
+type backupUploadConfig struct {
+	c toml.DatabaseBackupUpload
+	s toml.DatabaseSecrets
+}
+
+func (u *backupUploadConfig) Enabled() bool {
+	return *u.c.Enabled
+}
+
+func (u *backupUploadConfig) Endpoint() *url.URL {
+	return u.c.Endpoint.URL()
+}
+
+func (u *backupUploadConfig) Bucket() string {
+	return *u.c.Bucket
+}
+
+func (u *backupUploadConfig) Region() string {
+	return *u.c.Region
+}
+
+func (u *backupUploadConfig) Prefix() string {
+	return *u.c.Prefix
+}
+
+func (u *backupUploadConfig) Timeout() time.Duration {
+	return u.c.Timeout.Duration()
+}
+
+func (u *backupUploadConfig) AccessKeyID() string {
+	if u.s.BackupUploadAccessKeyID == nil {
+		return ""
+	}
+	return string(*u.s.BackupUploadAccessKeyID)
+}
+
+func (u *backupUploadConfig) SecretAccessKey() string {
+	if u.s.BackupUploadSecretAccessKey == nil {
+		return ""
+	}
+	return string(*u.s.BackupUploadSecretAccessKey)
+}
+
 type lockConfig struct {
 	c toml.DatabaseLock
 }
@@ -44,6 +44,9 @@ type generalConfig struct {
 
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
+	reloadMu   sync.RWMutex                      // for the fields applied by Reload, other than the log fields
+	reloadOpts func() (GeneralConfigOpts, error) // re-reads the configuration sources, nil if unknown
+
 	passwordMu sync.RWMutex // passwords are set after initialization
 }
 
@@ -61,6 +64,11 @@ type GeneralConfigOpts struct {
 	OverrideFn func(*Config, *Secrets)
 
 	SkipEnv bool
+
+	// configFiles and secretsFiles are the files read by Setup, kept to reload the configuration.
+	configFiles  []string
+	secretsFiles []string
+	setUp        bool
 }
 
 func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) error {
@@ -89,6 +97,7 @@ func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) e
 	}
 
 	o.SecretsStrings = secrets
+	o.configFiles, o.secretsFiles, o.setUp = configFiles, secretsFiles, true
 	return nil
 }
 
@@ -164,6 +173,7 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		c:             &o.Config,
 		secrets:       &o.Secrets,
 		warning:       warning,
+		reloadOpts:    o.reloadOpts,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -172,6 +182,19 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 	return cfg, nil
 }
 
+// reloadOpts returns fresh options reading the same sources as o. Files are read again, while the strings of options
+// which were not set up from files are reused as-is.
+func (o GeneralConfigOpts) reloadOpts() (GeneralConfigOpts, error) {
+	next := GeneralConfigOpts{OverrideFn: o.OverrideFn, SkipEnv: o.SkipEnv}
+	if o.setUp {
+		err := next.Setup(o.configFiles, o.secretsFiles)
+		return next, err
+	}
+	next.ConfigStrings = o.ConfigStrings
+	next.SecretsStrings = o.SecretsStrings
+	return next, nil
+}
+
 func (o *GeneralConfigOpts) parse() (err error) {
 	for _, c := range o.ConfigStrings {
 		err := o.parseConfig(c)
@@ -280,6 +303,8 @@ func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
 
 // ConfigTOML implements chainlink.ConfigV2
 func (g *generalConfig) ConfigTOML() (user, effective string) {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
 	return g.inputTOML, g.effectiveTOML
 }
 
@@ -308,7 +333,7 @@ func (g *generalConfig) FeatureUICSAKeys() bool {
 }
 
 func (g *generalConfig) AutoPprof() config.AutoPprof {
-	return &autoPprofConfig{c: g.c.AutoPprof, rootDir: g.RootDir}
+	return &autoPprofConfig{c: g.c.AutoPprof, s: g.secrets.AutoPprof, rootDir: g.RootDir}
 }
 
 func (g *generalConfig) EVMEnabled() bool {
@@ -370,7 +395,9 @@ func (g *generalConfig) TONEnabled() bool {
 }
 
 func (g *generalConfig) WebServer() config.WebServer {
-	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir}
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
+	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir, rateLimit: g.webServerRateLimit}
 }
 
 func (g *generalConfig) AutoPprofBlockProfileRate() int {
@@ -450,15 +477,15 @@ func (g *generalConfig) InsecurePPROFHeap() bool {
 }
 
 func (g *generalConfig) JobPipelineReaperInterval() time.Duration {
-	return g.c.JobPipeline.ReaperInterval.Duration()
+	return g.jobPipeline().ReaperInterval.Duration()
 }
 
 func (g *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
-	return uint64(*g.c.JobPipeline.ResultWriteQueueDepth)
+	return uint64(*g.jobPipeline().ResultWriteQueueDepth)
 }
 
 func (g *generalConfig) JobPipeline() coreconfig.JobPipeline {
-	return &jobPipelineConfig{c: g.c.JobPipeline}
+	return &jobPipelineConfig{c: g.jobPipeline}
 }
 
 func (g *generalConfig) Keeper() config.Keeper {
@@ -507,13 +534,16 @@ func (g *generalConfig) RootDir() string {
 }
 
 func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
 	return &telemetryIngressConfig{
-		c: g.c.TelemetryIngress,
+		c:       g.c.TelemetryIngress,
+		rootDir: g.RootDir,
 	}
 }
 
 func (g *generalConfig) AuditLogger() coreconfig.AuditLogger {
-	return auditLoggerConfig{c: g.c.AuditLogger}
+	return auditLoggerConfig{c: g.c.AuditLogger, s: g.secrets.AuditLogger, rootDir: g.RootDir}
 }
 
 func (g *generalConfig) Insecure() config.Insecure {
@@ -575,4 +605,12 @@ func (g *generalConfig) BridgeStatusReporter() coreconfig.BridgeStatusReporter {
 	return &bridgeStatusReporterConfig{c: g.c.BridgeStatusReporter}
 }
 
+func (g *generalConfig) RemoteSigner() coreconfig.RemoteSigner {
+	return &remoteSignerConfig{c: g.c.RemoteSigner}
+}
+
+func (g *generalConfig) HeadReport() coreconfig.HeadReport {
+	return &headReportConfig{c: g.c.HeadReport}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
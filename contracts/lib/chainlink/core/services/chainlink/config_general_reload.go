@@ -0,0 +1,169 @@
+package chainlink
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	gotoml "github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+// reloadableKeys are the config keys which take effect without a restart. A key ending in a dot matches all the keys
+// of its table.
+var reloadableKeys = []string{
+	"Database.LogQueries",
+	"JobPipeline.HTTPRequest.",
+	"JobPipeline.MaxRunDuration",
+	"JobPipeline.ReaperThreshold",
+	"JobPipeline.VerboseLogging",
+	"Log.Level",
+	"TelemetryIngress.Endpoints[",
+	"WebServer.RateLimit.",
+}
+
+// ConfigReload is the outcome of a configuration reload: the changed keys, in dotted notation, split between those
+// applied to the running node and those which require a restart.
+type ConfigReload struct {
+	Applied         []string
+	RestartRequired []string
+}
+
+// Reload re-reads the configuration from its original sources, validates it, and applies the changes of the
+// reloadable keys. The changes of the other keys are reported, but only take effect after a restart. Nothing is
+// applied if the configuration is invalid. Secrets are never reloaded.
+func (g *generalConfig) Reload() (reload ConfigReload, err error) {
+	if g.reloadOpts == nil {
+		return reload, errors.New("configuration cannot be reloaded: unknown configuration sources")
+	}
+	opts, err := g.reloadOpts()
+	if err != nil {
+		return reload, err
+	}
+	next, err := opts.New()
+	if err != nil {
+		return reload, err
+	}
+	n := next.(*generalConfig)
+	if err = n.validate(func() error { return nil }); err != nil {
+		return reload, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	g.reloadMu.Lock()
+	defer g.reloadMu.Unlock()
+	g.logMu.Lock()
+	defer g.logMu.Unlock()
+
+	current, err := g.c.TOMLString()
+	if err != nil {
+		return reload, err
+	}
+	changed, err := diffTOML(current, n.effectiveTOML)
+	if err != nil {
+		return reload, err
+	}
+	endpointsReloadable := sameTelemetryEndpoints(g.c.TelemetryIngress.Endpoints, n.c.TelemetryIngress.Endpoints)
+	for _, key := range changed {
+		if isReloadableKey(key) && (endpointsReloadable || !strings.HasPrefix(key, "TelemetryIngress.Endpoints")) {
+			reload.Applied = append(reload.Applied, key)
+		} else {
+			reload.RestartRequired = append(reload.RestartRequired, key)
+		}
+	}
+	if len(reload.Applied) == 0 {
+		return reload, nil
+	}
+
+	g.c.Log.Level = n.c.Log.Level
+	g.c.Database.LogQueries = n.c.Database.LogQueries
+	g.c.JobPipeline.HTTPRequest = n.c.JobPipeline.HTTPRequest
+	g.c.JobPipeline.MaxRunDuration = n.c.JobPipeline.MaxRunDuration
+	g.c.JobPipeline.ReaperThreshold = n.c.JobPipeline.ReaperThreshold
+	g.c.JobPipeline.VerboseLogging = n.c.JobPipeline.VerboseLogging
+	g.c.WebServer.RateLimit = n.c.WebServer.RateLimit
+	if endpointsReloadable {
+		g.c.TelemetryIngress.Endpoints = n.c.TelemetryIngress.Endpoints
+	}
+
+	g.effectiveTOML, err = g.c.TOMLString()
+	return reload, err
+}
+
+func isReloadableKey(key string) bool {
+	for _, k := range reloadableKeys {
+		if key == k || ((strings.HasSuffix(k, ".") || strings.HasSuffix(k, "[")) && strings.HasPrefix(key, k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameTelemetryEndpoints returns true if both lists have the same networks and chain IDs, in the same order. Only
+// the URLs and public keys of the existing endpoints can be changed at runtime, as the jobs bind to the endpoint of
+// their network and chain when they start.
+func sameTelemetryEndpoints(a, b []toml.TelemetryIngressEndpoint) bool {
+	return slices.EqualFunc(a, b, func(x, y toml.TelemetryIngressEndpoint) bool {
+		return reflect.DeepEqual(x.Network, y.Network) && reflect.DeepEqual(x.ChainID, y.ChainID)
+	})
+}
+
+// diffTOML returns the sorted keys, in dotted notation, whose values differ between the two TOML documents.
+func diffTOML(a, b string) ([]string, error) {
+	var am, bm map[string]any
+	if err := gotoml.Unmarshal([]byte(a), &am); err != nil {
+		return nil, fmt.Errorf("failed to decode config TOML: %w", err)
+	}
+	if err := gotoml.Unmarshal([]byte(b), &bm); err != nil {
+		return nil, fmt.Errorf("failed to decode config TOML: %w", err)
+	}
+	af, bf := map[string]any{}, map[string]any{}
+	flattenTOML("", am, af)
+	flattenTOML("", bm, bf)
+
+	var changed []string
+	for k, v := range af {
+		if w, ok := bf[k]; !ok || !reflect.DeepEqual(v, w) {
+			changed = append(changed, k)
+		}
+	}
+	for k := range bf {
+		if _, ok := af[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	slices.Sort(changed)
+	return changed, nil
+}
+
+// flattenTOML flattens the tables and arrays of tables of v into out, keyed by their dotted path. Arrays of values
+// are kept whole.
+func flattenTOML(prefix string, v any, out map[string]any) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			flattenTOML(key, e, out)
+		}
+	case []any:
+		if len(t) == 0 || !isTable(t[0]) {
+			out[prefix] = t
+			return
+		}
+		for i, e := range t {
+			flattenTOML(fmt.Sprintf("%s[%d]", prefix, i), e, out)
+		}
+	default:
+		out[prefix] = v
+	}
+}
+
+func isTable(v any) bool {
+	_, ok := v.(map[string]any)
+	return ok
+}
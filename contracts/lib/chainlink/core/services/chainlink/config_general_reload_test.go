@@ -0,0 +1,106 @@
+package chainlink
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const reloadTOML = `[Log]
+Level = '%s'
+
+[JobPipeline]
+MaxSuccessfulRuns = %d
+
+[WebServer]
+HTTPPort = %d
+
+[WebServer.RateLimit]
+Authenticated = %d
+
+[[TelemetryIngress.Endpoints]]
+Network = 'EVM'
+ChainID = '1'
+URL = '%s'
+ServerPubKey = 'test-pub-key'
+`
+
+func TestGeneralConfig_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	writeConfig := func(t *testing.T, s string) {
+		require.NoError(t, os.WriteFile(path, []byte(s), 0600))
+	}
+	writeConfig(t, fmt.Sprintf(reloadTOML, "info", 10, 6688, 100, "https://a.telemetry.test"))
+
+	var opts GeneralConfigOpts
+	require.NoError(t, opts.Setup([]string{path}, nil))
+	cfg, err := opts.New()
+	require.NoError(t, err)
+	jp, ws := cfg.JobPipeline(), cfg.WebServer()
+
+	t.Run("no changes", func(t *testing.T) {
+		reload, err := cfg.Reload()
+		require.NoError(t, err)
+		assert.Empty(t, reload.Applied)
+		assert.Empty(t, reload.RestartRequired)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		writeConfig(t, fmt.Sprintf(reloadTOML, "loud", 20, 6688, 100, "https://a.telemetry.test"))
+		_, err := cfg.Reload()
+		require.Error(t, err)
+		assert.Equal(t, uint64(10), jp.MaxSuccessfulRuns())
+		assert.Equal(t, zapcore.InfoLevel, cfg.Log().Level())
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		writeConfig(t, fmt.Sprintf(reloadTOML, "debug", 20, 6689, 200, "https://b.telemetry.test"))
+		reload, err := cfg.Reload()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Log.Level",
+			"TelemetryIngress.Endpoints[0].URL",
+			"WebServer.RateLimit.Authenticated",
+		}, reload.Applied)
+		// the pipeline ORM and the run savers are created with the limit
+		assert.Equal(t, []string{"JobPipeline.MaxSuccessfulRuns", "WebServer.HTTPPort"}, reload.RestartRequired)
+
+		assert.Equal(t, uint64(10), jp.MaxSuccessfulRuns())
+		assert.Equal(t, int64(200), ws.RateLimit().Authenticated())
+		assert.Equal(t, zapcore.DebugLevel, cfg.Log().Level())
+		assert.Equal(t, "https://b.telemetry.test", cfg.TelemetryIngress().Endpoints()[0].URL().String())
+		assert.Equal(t, uint16(6688), cfg.WebServer().HTTPPort())
+		_, effective := cfg.ConfigTOML()
+		assert.Contains(t, effective, "MaxSuccessfulRuns = 10")
+	})
+
+	t.Run("new telemetry endpoint", func(t *testing.T) {
+		writeConfig(t, fmt.Sprintf(reloadTOML, "debug", 20, 6689, 200, "https://b.telemetry.test")+`
+[[TelemetryIngress.Endpoints]]
+Network = 'EVM'
+ChainID = '2'
+URL = 'https://c.telemetry.test'
+ServerPubKey = 'test-pub-key'
+`)
+		reload, err := cfg.Reload()
+		require.NoError(t, err)
+		assert.Empty(t, reload.Applied)
+		assert.Contains(t, reload.RestartRequired, "TelemetryIngress.Endpoints[1].URL")
+		assert.Len(t, cfg.TelemetryIngress().Endpoints(), 1)
+	})
+}
+
+func TestGeneralConfig_Reload_strings(t *testing.T) {
+	cfg, err := GeneralConfigOpts{ConfigStrings: []string{"[Log]\nLevel = 'warn'"}}.New()
+	require.NoError(t, err)
+
+	reload, err := cfg.Reload()
+	require.NoError(t, err)
+	assert.Empty(t, reload.Applied)
+	assert.Empty(t, reload.RestartRequired)
+}
@@ -45,6 +45,18 @@ func (g *generalConfig) SetLogSQL(logSQL bool) {
 	g.logMu.Unlock()
 }
 
+func (g *generalConfig) jobPipeline() toml.JobPipeline {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
+	return g.c.JobPipeline
+}
+
+func (g *generalConfig) webServerRateLimit() toml.WebServerRateLimit {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
+	return g.c.WebServer.RateLimit
+}
+
 func (g *generalConfig) SetPasswords(keystore, vrf *string) {
 	g.passwordMu.Lock()
 	defer g.passwordMu.Unlock()
@@ -0,0 +1,27 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.HeadReport = (*headReportConfig)(nil)
+
+type headReportConfig struct {
+	c toml.HeadReport
+}
+
+func (h *headReportConfig) WebhookURL() *url.URL {
+	return h.c.WebhookURL.URL()
+}
+
+func (h *headReportConfig) WebhookTimeout() time.Duration {
+	return h.c.WebhookTimeout.Duration()
+}
+
+func (h *headReportConfig) FilePath() string {
+	return *h.c.FilePath
+}
@@ -0,0 +1,22 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadReportConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	h := cfg.HeadReport()
+	assert.Equal(t, "https://heads.example.com/report", h.WebhookURL().String())
+	assert.Equal(t, 3*time.Second, h.WebhookTimeout())
+	assert.Equal(t, "heads.jsonl", h.FilePath())
+}
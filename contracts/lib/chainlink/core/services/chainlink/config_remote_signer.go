@@ -0,0 +1,27 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.RemoteSigner = (*remoteSignerConfig)(nil)
+
+type remoteSignerConfig struct {
+	c toml.RemoteSigner
+}
+
+func (r *remoteSignerConfig) Enabled() bool {
+	return *r.c.Enabled
+}
+
+func (r *remoteSignerConfig) URL() *url.URL {
+	return r.c.URL.URL()
+}
+
+func (r *remoteSignerConfig) Timeout() time.Duration {
+	return r.c.Timeout.Duration()
+}
@@ -0,0 +1,22 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteSignerConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	r := cfg.RemoteSigner()
+	assert.True(t, r.Enabled())
+	assert.Equal(t, "http://localhost:9000", r.URL().String())
+	assert.Equal(t, 7*time.Second, r.Timeout())
+}
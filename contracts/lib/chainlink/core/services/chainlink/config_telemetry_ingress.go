@@ -2,16 +2,19 @@ package chainlink
 
 import (
 	"net/url"
+	"path/filepath"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
 
 type telemetryIngressConfig struct {
-	c toml.TelemetryIngress
+	c       toml.TelemetryIngress
+	rootDir func() string
 }
 
 type telemetryIngressEndpointConfig struct {
@@ -46,6 +49,15 @@ func (t *telemetryIngressConfig) UseBatchSend() bool {
 	return *t.c.UseBatchSend
 }
 
+// SpoolDir is where the telemetry batches which could not be sent are spooled.
+func (t *telemetryIngressConfig) SpoolDir() string {
+	return filepath.Join(t.rootDir(), "telemetry-spool")
+}
+
+func (t *telemetryIngressConfig) SpoolMaxSize() utils.FileSize {
+	return *t.c.SpoolMaxSize
+}
+
 func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	var endpoints []config.TelemetryIngressEndpoint
 	for _, e := range t.c.Endpoints {
@@ -274,10 +274,26 @@ func TestConfig_Marshal(t *testing.T) {
 		{Header: "X-SomeOther-Header", Value: "value with spaces | and a bar+*"},
 	}
 	full.AuditLogger = toml.AuditLogger{
-		Enabled:        ptr(true),
-		ForwardToUrl:   mustURL("http://localhost:9898"),
-		Headers:        ptr(serviceHeaders),
-		JsonWrapperKey: ptr("event"),
+		Enabled:           ptr(true),
+		ForwardToUrl:      mustURL("http://localhost:9898"),
+		Headers:           ptr(serviceHeaders),
+		JsonWrapperKey:    ptr("event"),
+		KeyUsageStore:     ptr(true),
+		BatchSize:         ptr[uint32](100),
+		BatchInterval:     commoncfg.MustNewDuration(5 * time.Second),
+		RetryQueueMaxSize: ptr[utils.FileSize](10 * utils.MB),
+		File: toml.AuditLoggerFile{
+			Enabled:    ptr(true),
+			Dir:        ptr("/var/log/chainlink/audit"),
+			MaxSize:    ptr[utils.FileSize](100 * utils.MB),
+			MaxBackups: ptr[int64](10),
+		},
+		Syslog: toml.AuditLoggerSyslog{
+			Enabled: ptr(true),
+			Network: ptr("udp"),
+			Address: ptr("localhost:514"),
+			Tag:     ptr("chainlink"),
+		},
 	}
 
 	full.Feature = toml.Feature{
@@ -306,10 +322,21 @@ func TestConfig_Marshal(t *testing.T) {
 			LeaseRefreshInterval: &second,
 		},
 		Backup: toml.DatabaseBackup{
-			Dir:              ptr("test/backup/dir"),
-			Frequency:        &hour,
-			Mode:             &config.DatabaseBackupModeFull,
-			OnVersionUpgrade: ptr(true),
+			Dir:                 ptr("test/backup/dir"),
+			Frequency:           &hour,
+			Mode:                &config.DatabaseBackupModeFull,
+			OnVersionUpgrade:    ptr(true),
+			EncryptionPublicKey: ptr("age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"),
+			MaxBackups:          ptr[uint32](5),
+			MaxAge:              commoncfg.MustNewDuration(7 * 24 * time.Hour),
+			Upload: toml.DatabaseBackupUpload{
+				Enabled:  ptr(true),
+				Endpoint: mustURL("https://s3.us-east-1.amazonaws.com"),
+				Bucket:   ptr("chainlink-backups"),
+				Region:   ptr("us-east-1"),
+				Prefix:   ptr("node-1/"),
+				Timeout:  commoncfg.MustNewDuration(10 * time.Minute),
+			},
 		},
 	}
 	full.TelemetryIngress = toml.TelemetryIngress{
@@ -320,6 +347,7 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: commoncfg.MustNewDuration(time.Minute),
 		SendTimeout:  commoncfg.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		SpoolMaxSize: ptr[utils.FileSize](10 * utils.MB),
 		Endpoints: []toml.TelemetryIngressEndpoint{{
 			Network:      ptr("EVM"),
 			ChainID:      ptr("1"),
@@ -394,6 +422,12 @@ func TestConfig_Marshal(t *testing.T) {
 			Unauthenticated:       ptr[int64](7),
 			UnauthenticatedPeriod: commoncfg.MustNewDuration(time.Minute),
 		},
+		LoginLockout: toml.WebServerLoginLockout{
+			MaxFailures:      ptr[uint32](5),
+			MaxFailuresPerIP: ptr[uint32](20),
+			LockoutDuration:  commoncfg.MustNewDuration(time.Hour),
+			MaxDelay:         commoncfg.MustNewDuration(10 * time.Second),
+		},
 		TLS: toml.WebServerTLS{
 			CertPath:      ptr("tls/cert/path"),
 			Host:          ptr("tls-host"),
@@ -570,6 +604,14 @@ func TestConfig_Marshal(t *testing.T) {
 		MutexProfileFraction: ptr[int64](2),
 		MemThreshold:         ptr[utils.FileSize](utils.GB),
 		GoroutineThreshold:   ptr[int64](999),
+		Upload: toml.AutoPprofUpload{
+			Enabled:  ptr(true),
+			Endpoint: mustURL("https://s3.us-east-1.amazonaws.com"),
+			Bucket:   ptr("chainlink-profiles"),
+			Region:   ptr("us-east-1"),
+			Prefix:   ptr("node-1/"),
+			Timeout:  commoncfg.MustNewDuration(time.Minute),
+		},
 	}
 	full.Pyroscope = toml.Pyroscope{
 		ServerAddress: ptr("http://localhost:4040"),
@@ -616,6 +658,16 @@ func TestConfig_Marshal(t *testing.T) {
 		IgnoreInvalidBridges: ptr(true),
 		IgnoreJoblessBridges: ptr(false),
 	}
+	full.RemoteSigner = toml.RemoteSigner{
+		Enabled: ptr(true),
+		URL:     mustURL("http://localhost:9000"),
+		Timeout: commoncfg.MustNewDuration(7 * time.Second),
+	}
+	full.HeadReport = toml.HeadReport{
+		WebhookURL:     mustURL("https://heads.example.com/report"),
+		WebhookTimeout: commoncfg.MustNewDuration(3 * time.Second),
+		FilePath:       ptr("heads.jsonl"),
+	}
 	full.JobDistributor = toml.JobDistributor{
 		DisplayName: ptr("test-node"),
 	}
@@ -913,6 +965,22 @@ Enabled = true
 ForwardToUrl = 'http://localhost:9898'
 JsonWrapperKey = 'event'
 Headers = ['Authorization: token', 'X-SomeOther-Header: value with spaces | and a bar+*']
+KeyUsageStore = true
+BatchSize = 100
+BatchInterval = '5s'
+RetryQueueMaxSize = '10.00mb'
+
+[AuditLogger.File]
+Enabled = true
+Dir = '/var/log/chainlink/audit'
+MaxSize = '100.00mb'
+MaxBackups = 10
+
+[AuditLogger.Syslog]
+Enabled = true
+Network = 'udp'
+Address = 'localhost:514'
+Tag = 'chainlink'
 `},
 		{"Feature", Config{Core: toml.Core{Feature: full.Feature}}, `[Feature]
 FeedsManager = true
@@ -935,6 +1003,17 @@ Dir = 'test/backup/dir'
 Frequency = '1h0m0s'
 Mode = 'full'
 OnVersionUpgrade = true
+EncryptionPublicKey = 'age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p'
+MaxBackups = 5
+MaxAge = '168h0m0s'
+
+[Database.Backup.Upload]
+Enabled = true
+Endpoint = 'https://s3.us-east-1.amazonaws.com'
+Bucket = 'chainlink-backups'
+Region = 'us-east-1'
+Prefix = 'node-1/'
+Timeout = '10m0s'
 
 [Database.Listener]
 MaxReconnectDuration = '1m0s'
@@ -954,6 +1033,7 @@ MaxBatchSize = 4321
 SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
+SpoolMaxSize = '10.00mb'
 
 [[TelemetryIngress.Endpoints]]
 Network = 'EVM'
@@ -1029,6 +1109,12 @@ AuthenticatedPeriod = '1s'
 Unauthenticated = 7
 UnauthenticatedPeriod = '1m0s'
 
+[WebServer.LoginLockout]
+MaxFailures = 5
+MaxFailuresPerIP = 20
+LockoutDuration = '1h0m0s'
+MaxDelay = '10s'
+
 [WebServer.TLS]
 CertPath = 'tls/cert/path'
 ForceRedirect = true
@@ -1130,6 +1216,14 @@ BlockProfileRate = 5
 MutexProfileFraction = 2
 MemThreshold = '1.00gb'
 GoroutineThreshold = 999
+
+[AutoPprof.Upload]
+Enabled = true
+Endpoint = 'https://s3.us-east-1.amazonaws.com'
+Bucket = 'chainlink-profiles'
+Region = 'us-east-1'
+Prefix = 'node-1/'
+Timeout = '1m0s'
 `},
 		{"Pyroscope", Config{Core: toml.Core{Pyroscope: full.Pyroscope}}, `[Pyroscope]
 ServerAddress = 'http://localhost:4040'
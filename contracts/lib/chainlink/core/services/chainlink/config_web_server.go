@@ -84,6 +84,26 @@ func (r *rateLimitConfig) UnauthenticatedPeriod() time.Duration {
 	return r.c.UnauthenticatedPeriod.Duration()
 }
 
+type loginLockoutConfig struct {
+	c toml.WebServerLoginLockout
+}
+
+func (l *loginLockoutConfig) MaxFailures() uint32 {
+	return *l.c.MaxFailures
+}
+
+func (l *loginLockoutConfig) MaxFailuresPerIP() uint32 {
+	return *l.c.MaxFailuresPerIP
+}
+
+func (l *loginLockoutConfig) LockoutDuration() time.Duration {
+	return l.c.LockoutDuration.Duration()
+}
+
+func (l *loginLockoutConfig) MaxDelay() time.Duration {
+	return l.c.MaxDelay.Duration()
+}
+
 type mfaConfig struct {
 	c toml.WebServerMFA
 }
@@ -100,6 +120,8 @@ type webServerConfig struct {
 	c       toml.WebServer
 	s       toml.WebServerSecrets
 	rootDir func() string
+
+	rateLimit func() toml.WebServerRateLimit // reloadable
 }
 
 func (w *webServerConfig) TLS() config.TLS {
@@ -107,7 +129,11 @@ func (w *webServerConfig) TLS() config.TLS {
 }
 
 func (w *webServerConfig) RateLimit() config.RateLimit {
-	return &rateLimitConfig{c: w.c.RateLimit}
+	return &rateLimitConfig{c: w.rateLimit()}
+}
+
+func (w *webServerConfig) LoginLockout() config.LoginLockout {
+	return &loginLockoutConfig{c: w.c.LoginLockout}
 }
 
 func (w *webServerConfig) MFA() config.MFA {
@@ -0,0 +1,102 @@
+package chainlink
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DrainState is the state of the drain mode of the node.
+type DrainState string
+
+const (
+	// DrainStateActive is the normal state, in which new pipeline runs are started.
+	DrainStateActive DrainState = "active"
+	// DrainStateDraining is the state in which new pipeline runs are refused while the in-flight ones complete.
+	DrainStateDraining DrainState = "draining"
+	// DrainStateDrained is the state in which no pipeline runs are in flight anymore, so the node can be stopped.
+	DrainStateDrained DrainState = "drained"
+)
+
+// DrainStatus describes the drain mode of the node.
+type DrainStatus struct {
+	State DrainState
+	// StartedAt is when draining started, unset when active.
+	StartedAt time.Time
+	// Deadline is when the runs still in flight are cancelled, unset when active.
+	Deadline time.Time
+	// TimedOut is whether runs were still in flight at the deadline, and were cancelled.
+	TimedOut bool
+	// InFlightRuns is the number of pipeline runs being executed.
+	InFlightRuns int
+}
+
+// ErrDrainTimeout is returned when draining is requested without a positive timeout.
+var ErrDrainTimeout = errors.New("the drain timeout must be positive")
+
+// Drain puts the node in drain mode before planned maintenance: new pipeline runs are refused, so that webhook and
+// cron triggers are rejected or skipped and the readiness check fails, while the in-flight runs complete for up to
+// timeout, after which they are cancelled. Once drained, the node can be stopped without interrupting runs. Draining
+// a node which is already draining keeps the original deadline.
+func (app *ChainlinkApplication) Drain(timeout time.Duration) (DrainStatus, error) {
+	if timeout <= 0 {
+		return DrainStatus{}, ErrDrainTimeout
+	}
+	app.drainMu.Lock()
+	defer app.drainMu.Unlock()
+	if app.drain.State == DrainStateActive {
+		now := time.Now()
+		app.drainGen++
+		app.drain = DrainStatus{State: DrainStateDraining, StartedAt: now, Deadline: now.Add(timeout)}
+		app.logger.Infow("Draining the node", "deadline", app.drain.Deadline)
+		go app.awaitDrained(app.drainGen, app.drain.Deadline)
+	}
+	return app.drainStatus(), nil
+}
+
+// awaitDrained waits for the pipeline runs in flight to complete, or to be cancelled at the deadline, and records it
+// unless draining was cancelled meanwhile.
+func (app *ChainlinkApplication) awaitDrained(gen int, deadline time.Time) {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	err := app.pipelineRunner.Drain(ctx)
+
+	app.drainMu.Lock()
+	defer app.drainMu.Unlock()
+	if app.drainGen != gen || app.drain.State != DrainStateDraining {
+		return
+	}
+	app.drain.State = DrainStateDrained
+	app.drain.TimedOut = err != nil
+	if err != nil {
+		app.logger.Warnw("Drained the node, the runs still in flight at the deadline were cancelled", "err", err)
+	} else {
+		app.logger.Info("Drained the node, it can be stopped")
+	}
+}
+
+// Undrain leaves drain mode, so that new pipeline runs are started again.
+func (app *ChainlinkApplication) Undrain() DrainStatus {
+	app.drainMu.Lock()
+	defer app.drainMu.Unlock()
+	if app.drain.State != DrainStateActive {
+		app.pipelineRunner.Undrain()
+		app.drain = DrainStatus{State: DrainStateActive}
+		app.logger.Info("Stopped draining the node")
+	}
+	return app.drainStatus()
+}
+
+// DrainStatus returns the drain mode of the node.
+func (app *ChainlinkApplication) DrainStatus() DrainStatus {
+	app.drainMu.Lock()
+	defer app.drainMu.Unlock()
+	return app.drainStatus()
+}
+
+// drainStatus must be called with drainMu held.
+func (app *ChainlinkApplication) drainStatus() DrainStatus {
+	status := app.drain
+	status.InFlightRuns = app.pipelineRunner.InFlightRuns()
+	return status
+}
@@ -833,6 +833,53 @@ func (_c *GeneralConfig_FluxMonitor_Call) RunAndReturn(run func() config.FluxMon
 	return _c
 }
 
+// HeadReport provides a mock function with no fields
+func (_m *GeneralConfig) HeadReport() config.HeadReport {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeadReport")
+	}
+
+	var r0 config.HeadReport
+	if rf, ok := ret.Get(0).(func() config.HeadReport); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.HeadReport)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_HeadReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HeadReport'
+type GeneralConfig_HeadReport_Call struct {
+	*mock.Call
+}
+
+// HeadReport is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) HeadReport() *GeneralConfig_HeadReport_Call {
+	return &GeneralConfig_HeadReport_Call{Call: _e.mock.On("HeadReport")}
+}
+
+func (_c *GeneralConfig_HeadReport_Call) Run(run func()) *GeneralConfig_HeadReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_HeadReport_Call) Return(_a0 config.HeadReport) *GeneralConfig_HeadReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_HeadReport_Call) RunAndReturn(run func() config.HeadReport) *GeneralConfig_HeadReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ImportedEthKeys provides a mock function with no fields
 func (_m *GeneralConfig) ImportedEthKeys() config.ImportableChainKeyLister {
 	ret := _m.Called()
@@ -1662,6 +1709,108 @@ func (_c *GeneralConfig_Pyroscope_Call) RunAndReturn(run func() config.Pyroscope
 	return _c
 }
 
+// Reload provides a mock function with no fields
+func (_m *GeneralConfig) Reload() (chainlink.ConfigReload, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reload")
+	}
+
+	var r0 chainlink.ConfigReload
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (chainlink.ConfigReload, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() chainlink.ConfigReload); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.ConfigReload)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GeneralConfig_Reload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reload'
+type GeneralConfig_Reload_Call struct {
+	*mock.Call
+}
+
+// Reload is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) Reload() *GeneralConfig_Reload_Call {
+	return &GeneralConfig_Reload_Call{Call: _e.mock.On("Reload")}
+}
+
+func (_c *GeneralConfig_Reload_Call) Run(run func()) *GeneralConfig_Reload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_Reload_Call) Return(_a0 chainlink.ConfigReload, _a1 error) *GeneralConfig_Reload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GeneralConfig_Reload_Call) RunAndReturn(run func() (chainlink.ConfigReload, error)) *GeneralConfig_Reload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoteSigner provides a mock function with no fields
+func (_m *GeneralConfig) RemoteSigner() config.RemoteSigner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteSigner")
+	}
+
+	var r0 config.RemoteSigner
+	if rf, ok := ret.Get(0).(func() config.RemoteSigner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.RemoteSigner)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_RemoteSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteSigner'
+type GeneralConfig_RemoteSigner_Call struct {
+	*mock.Call
+}
+
+// RemoteSigner is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) RemoteSigner() *GeneralConfig_RemoteSigner_Call {
+	return &GeneralConfig_RemoteSigner_Call{Call: _e.mock.On("RemoteSigner")}
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) Run(run func()) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) Return(_a0 config.RemoteSigner) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) RunAndReturn(run func() config.RemoteSigner) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootDir provides a mock function with no fields
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
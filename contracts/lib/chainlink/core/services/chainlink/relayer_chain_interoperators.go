@@ -142,7 +142,7 @@ func InitEVM(factory RelayerFactory, config EVMFactoryConfig) CoreRelayerChainIn
 func InitCosmos(factory RelayerFactory, ks keystore.Cosmos, csaKS keystore.CSA, chainCfgs RawConfigs) CoreRelayerChainInitFunc {
 	return func(op *CoreRelayerChainInteroperators) (err error) {
 		loopKs := &keystore.CosmosLoopSigner{Cosmos: ks}
-		relayers, err := factory.NewCosmos(loopKs, &keystore.CSASigner{CSA: csaKS}, chainCfgs)
+		relayers, err := factory.NewCosmos(loopKs, &keystore.CSASigner{CSA: csaKS, Subsystem: "Relayer"}, chainCfgs)
 		if err != nil {
 			return fmt.Errorf("failed to setup Cosmos relayer: %w", err)
 		}
@@ -158,7 +158,7 @@ func InitCosmos(factory RelayerFactory, ks keystore.Cosmos, csaKS keystore.CSA,
 // InitSolana is a option for instantiating Solana relayers
 func InitSolana(factory RelayerFactory, ks keystore.Solana, csaKS keystore.CSA, config SolanaFactoryConfig) CoreRelayerChainInitFunc {
 	return func(op *CoreRelayerChainInteroperators) error {
-		solRelayers, err := factory.NewSolana(&keystore.SolanaLooppSigner{Solana: ks}, &keystore.CSASigner{CSA: csaKS}, config)
+		solRelayers, err := factory.NewSolana(&keystore.SolanaLooppSigner{Solana: ks}, &keystore.CSASigner{CSA: csaKS, Subsystem: "Relayer"}, config)
 		if err != nil {
 			return fmt.Errorf("failed to setup Solana relayer: %w", err)
 		}
@@ -176,7 +176,7 @@ func InitSolana(factory RelayerFactory, ks keystore.Solana, csaKS keystore.CSA,
 func InitStarknet(factory RelayerFactory, ks keystore.StarkNet, csaKS keystore.CSA, chainCfgs RawConfigs) CoreRelayerChainInitFunc {
 	return func(op *CoreRelayerChainInteroperators) (err error) {
 		loopKs := &keystore.StarknetLooppSigner{StarkNet: ks}
-		starkRelayers, err := factory.NewStarkNet(loopKs, &keystore.CSASigner{CSA: csaKS}, chainCfgs)
+		starkRelayers, err := factory.NewStarkNet(loopKs, &keystore.CSASigner{CSA: csaKS, Subsystem: "Relayer"}, chainCfgs)
 		if err != nil {
 			return fmt.Errorf("failed to setup StarkNet relayer: %w", err)
 		}
@@ -194,7 +194,7 @@ func InitStarknet(factory RelayerFactory, ks keystore.StarkNet, csaKS keystore.C
 func InitAptos(factory RelayerFactory, ks keystore.Aptos, csaKS keystore.CSA, chainCfgs RawConfigs) CoreRelayerChainInitFunc {
 	return func(op *CoreRelayerChainInteroperators) (err error) {
 		loopKs := &keystore.AptosLooppSigner{Aptos: ks}
-		relayers, err := factory.NewAptos(loopKs, &keystore.CSASigner{CSA: csaKS}, chainCfgs)
+		relayers, err := factory.NewAptos(loopKs, &keystore.CSASigner{CSA: csaKS, Subsystem: "Relayer"}, chainCfgs)
 		if err != nil {
 			return fmt.Errorf("failed to setup aptos relayer: %w", err)
 		}
@@ -212,7 +212,7 @@ func InitAptos(factory RelayerFactory, ks keystore.Aptos, csaKS keystore.CSA, ch
 func InitTron(factory RelayerFactory, ks keystore.Tron, csaKS keystore.CSA, chainCfgs RawConfigs) CoreRelayerChainInitFunc {
 	return func(op *CoreRelayerChainInteroperators) error {
 		loopKs := &keystore.TronLOOPSigner{Tron: ks}
-		tronRelayers, err := factory.NewTron(loopKs, &keystore.CSASigner{CSA: csaKS}, chainCfgs)
+		tronRelayers, err := factory.NewTron(loopKs, &keystore.CSASigner{CSA: csaKS, Subsystem: "Relayer"}, chainCfgs)
 		if err != nil {
 			return fmt.Errorf("failed to setup Tron relayer: %w", err)
 		}
@@ -230,7 +230,7 @@ func InitTron(factory RelayerFactory, ks keystore.Tron, csaKS keystore.CSA, chai
 func InitTON(factory RelayerFactory, ks keystore.TON, csaKS keystore.CSA, chainCfgs RawConfigs) CoreRelayerChainInitFunc {
 	return func(op *CoreRelayerChainInteroperators) error {
 		loopKs := &keystore.TONLooppSigner{TON: ks}
-		tonRelayers, err := factory.NewTON(loopKs, &keystore.CSASigner{CSA: csaKS}, chainCfgs)
+		tonRelayers, err := factory.NewTON(loopKs, &keystore.CSASigner{CSA: csaKS, Subsystem: "Relayer"}, chainCfgs)
 		if err != nil {
 			return fmt.Errorf("failed to setup TON relayer: %w", err)
 		}
@@ -99,7 +99,7 @@ func (r *RelayerFactory) NewEVM(config EVMFactoryConfig) (map[types.RelayID]evmr
 				return nil, fmt.Errorf("failed to create EVM LOOP command: %w", err)
 			}
 
-			ks := keystore.NewEthSigner(config.EthKeystore, chain.ChainID.ToInt())
+			ks := keystore.NewEthSigner(config.EthKeystore, chain.ChainID.ToInt()).WithCaller("Relayer", nil)
 			relayers[relayID] = evmrelay.NewLOOPAdapter(loop.NewRelayerService(logger.Named(lggr, relayID.ChainID), r.GRPCOpts, solCmdFn, string(cfgTOML), ks, config.CSAKeystore, r.CapabilitiesRegistry))
 		}
 		return relayers, nil
@@ -19,6 +19,8 @@ type GeneralConfig interface {
 	TONConfigs() RawConfigs
 	// ConfigTOML returns both the user provided and effective configuration as TOML.
 	ConfigTOML() (user, effective string)
+	// Reload re-reads the configuration and applies the changes which do not require a restart.
+	Reload() (ConfigReload, error)
 	ImportedSecretConfig
 }
 
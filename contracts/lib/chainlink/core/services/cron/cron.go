@@ -2,6 +2,7 @@ package cron
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/robfig/cron/v3"
@@ -89,7 +90,9 @@ func (cr *Cron) runPipeline() {
 	run := pipeline.NewRun(*cr.jobSpec.PipelineSpec, vars)
 
 	_, err := cr.pipelineRunner.Run(ctx, run, false, nil)
-	if err != nil {
+	if errors.Is(err, pipeline.ErrDraining) {
+		cr.logger.Infow("Skipping scheduled run, the node is draining", "jobID", cr.jobSpec.ID)
+	} else if err != nil {
 		cr.logger.Errorf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID)
 	}
 }
@@ -0,0 +1,107 @@
+package evmlog
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+type Config interface {
+	Feature() config.Feature
+}
+
+// Delegate creates evmlog jobs.
+type Delegate struct {
+	cfg            Config
+	ds             sqlutil.DataSource
+	logger         logger.Logger
+	legacyChains   legacyevm.LegacyChainContainer
+	pipelineRunner pipeline.Runner
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+// NewDelegate creates a new Delegate.
+func NewDelegate(
+	cfg Config,
+	ds sqlutil.DataSource,
+	logger logger.Logger,
+	legacyChains legacyevm.LegacyChainContainer,
+	pipelineRunner pipeline.Runner,
+) *Delegate {
+	return &Delegate{
+		cfg:            cfg,
+		ds:             ds,
+		logger:         logger,
+		legacyChains:   legacyChains,
+		pipelineRunner: pipelineRunner,
+	}
+}
+
+// JobType satisfies the job.Delegate interface.
+func (d *Delegate) JobType() job.Type {
+	return job.EVMLog
+}
+
+func (d *Delegate) BeforeJobCreated(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job)  {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// OnDeleteJob unregisters the log poller filter of the job, which is kept across restarts.
+func (d *Delegate) OnDeleteJob(ctx context.Context, jb job.Job) error {
+	if jb.EVMLogSpec == nil {
+		return errors.Errorf(
+			"evmlog.Delegate expects an EVMLogSpec to be present, got %+v", jb)
+	}
+	chain, err := d.chain(jb)
+	if err != nil {
+		return err
+	}
+	return chain.LogPoller().UnregisterFilter(ctx, filterName(jb))
+}
+
+func (d *Delegate) chain(jb job.Job) (legacyevm.Chain, error) {
+	cid := jb.EVMLogSpec.EVMChainID.ToInt()
+	chainService, err := d.legacyChains.Get(cid.String())
+	if err != nil {
+		return nil, fmt.Errorf(
+			"getting chain ID %s: %w", cid, err)
+	}
+	chain, ok := chainService.(legacyevm.Chain)
+	if !ok {
+		return nil, fmt.Errorf("evmlog is not available in LOOP Plugin mode: %w", stderrors.ErrUnsupported)
+	}
+	return chain, nil
+}
+
+// ServicesForSpec satisfies the job.Delegate interface.
+func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.ServiceCtx, error) {
+	if jb.EVMLogSpec == nil {
+		return nil, errors.Errorf(
+			"evmlog.Delegate expects an EVMLogSpec to be present, got %+v", jb)
+	}
+
+	chain, err := d.chain(jb)
+	if err != nil {
+		return nil, err
+	}
+
+	if !d.cfg.Feature().LogPoller() {
+		return nil, errors.New("log poller must be enabled to run evmlog")
+	}
+
+	l, err := newListener(jb, chain.LogPoller(), job.NewKVStore(jb.ID, d.ds), d.pipelineRunner, d.logger)
+	if err != nil {
+		return nil, err
+	}
+	return []job.ServiceCtx{l}, nil
+}
@@ -0,0 +1,362 @@
+package evmlog
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/logevent"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// cursorKey is the job KV store key of the cursor.
+const cursorKey = "evmlog_cursor"
+
+var _ job.ServiceCtx = &listener{}
+
+// cursor is the last processed log of a job. It is persisted, so that restarts neither run the
+// logs of the lookback window again nor miss the logs emitted while the node was down.
+type cursor struct {
+	BlockNumber int64 `json:"blockNumber"`
+	// LogIndex is the index of the last processed log of the block, or math.MaxInt64 once all
+	// its logs are processed.
+	LogIndex int64 `json:"logIndex"`
+}
+
+// processed reports whether lg is at or before the cursor.
+func (c cursor) processed(lg logpoller.Log) bool {
+	return lg.BlockNumber < c.BlockNumber || (lg.BlockNumber == c.BlockNumber && lg.LogIndex <= c.LogIndex)
+}
+
+// fromBlock is the first block whose logs may not all have been processed yet.
+func (c cursor) fromBlock() int64 {
+	if c.LogIndex == math.MaxInt64 {
+		return c.BlockNumber + 1
+	}
+	return c.BlockNumber
+}
+
+// filterName is the name of the log poller filter of the job, which outlives the listener so
+// that the logs emitted while the job is not running are indexed too.
+func filterName(jb job.Job) string {
+	return logpoller.FilterName("EVMLog", jb.ID, jb.EVMLogSpec.ContractAddress.Address())
+}
+
+// listener polls the log poller for the logs of the job's event and triggers a pipeline run for
+// each of them, with the decoded event fields in jobRun.meta and the log event trigger output
+// in jobRun.logEvent.
+type listener struct {
+	services.StateMachine
+	jb             job.Job
+	lp             logpoller.LogPoller
+	kv             job.KVStore
+	pipelineRunner pipeline.Runner
+	lggr           logger.Logger
+
+	decoder  *pipeline.ETHABILogDecoder
+	eventSig common.Hash
+	address  common.Address
+	topics   map[int][]common.Hash
+	filter   logpoller.Filter
+
+	cursor cursor
+	// replayFrom is the block the logs are replayed from before polling, or 0 once they are.
+	replayFrom int64
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func newListener(jb job.Job, lp logpoller.LogPoller, kv job.KVStore, pipelineRunner pipeline.Runner, lggr logger.Logger) (*listener, error) {
+	spec := jb.EVMLogSpec
+	decoder, err := pipeline.NewETHABILogDecoder(spec.EventABI)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing event ABI")
+	}
+	topics, err := topicFilters(decoder, spec.TopicFilters)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing topic filters")
+	}
+
+	l := &listener{
+		jb:             jb,
+		lp:             lp,
+		kv:             kv,
+		pipelineRunner: pipelineRunner,
+		lggr: lggr.Named("EVMLog").With(
+			"jobID", jb.ID,
+			"externalJobID", jb.ExternalJobID,
+			"contractAddress", spec.ContractAddress,
+			"event", decoder.Name(),
+		),
+		decoder:  decoder,
+		eventSig: decoder.EventSig(),
+		address:  spec.ContractAddress.Address(),
+		topics:   topics,
+		stopCh:   make(services.StopChan),
+	}
+	l.filter = logpoller.Filter{
+		Name:      filterName(jb),
+		EventSigs: []common.Hash{l.eventSig},
+		Addresses: []common.Address{l.address},
+	}
+	for i, t := range topics {
+		switch i {
+		case 1:
+			l.filter.Topic2 = t
+		case 2:
+			l.filter.Topic3 = t
+		case 3:
+			l.filter.Topic4 = t
+		default:
+			return nil, errors.Errorf("unsupported topic index %d", i)
+		}
+	}
+	return l, nil
+}
+
+// Start resumes polling after the persisted cursor, or from lookbackBlocks before the latest block
+// when the job runs for the first time. The log poller filter is registered if it is missing, and
+// the logs from the cursor on are replayed before polling since the log poller only indexes the new
+// ones. They are replayed again when the job was stopped before its first poll.
+func (l *listener) Start(ctx context.Context) error {
+	return l.StartOnce("EVMLog", func() error {
+		cur, found, err := l.loadCursor(ctx)
+		if err != nil {
+			return err
+		}
+		if !found {
+			latest, err := l.lp.LatestBlock(ctx)
+			if err != nil {
+				return errors.Wrap(err, "getting latest block")
+			}
+			cur = cursor{
+				BlockNumber: max(latest.BlockNumber-int64(l.jb.EVMLogSpec.LookbackBlocks), 0) - 1,
+				LogIndex:    math.MaxInt64,
+			}
+		}
+		l.cursor = cur
+
+		hasFilter := l.lp.HasFilter(l.filter.Name)
+		if !hasFilter {
+			if err := l.lp.RegisterFilter(ctx, l.filter); err != nil {
+				return errors.Wrap(err, "registering log poller filter")
+			}
+		}
+		if !hasFilter || !found {
+			l.replayFrom = max(l.cursor.fromBlock(), 1)
+		}
+
+		l.wg.Add(1)
+		go l.run()
+		return nil
+	})
+}
+
+// Close stops polling. The log poller filter is kept until the job is deleted.
+func (l *listener) Close() error {
+	return l.StopOnce("EVMLog", func() error {
+		close(l.stopCh)
+		l.wg.Wait()
+		return nil
+	})
+}
+
+func (l *listener) loadCursor(ctx context.Context) (cursor, bool, error) {
+	b, err := l.kv.Get(ctx, cursorKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return cursor{}, false, nil
+	} else if err != nil {
+		return cursor{}, false, errors.Wrap(err, "loading cursor")
+	}
+	var cur cursor
+	if err = json.Unmarshal(b, &cur); err != nil {
+		return cursor{}, false, errors.Wrap(err, "decoding cursor")
+	}
+	return cur, true, nil
+}
+
+func (l *listener) saveCursor(ctx context.Context, cur cursor) error {
+	b, err := json.Marshal(cur)
+	if err != nil {
+		return errors.Wrap(err, "encoding cursor")
+	}
+	if err = l.kv.Store(ctx, cursorKey, b); err != nil {
+		return errors.Wrap(err, "saving cursor")
+	}
+	l.cursor = cur
+	return nil
+}
+
+func (l *listener) run() {
+	defer l.wg.Done()
+	ctx, cancel := l.stopCh.NewCtx()
+	defer cancel()
+
+	if err := l.replay(ctx); err != nil {
+		l.lggr.Errorw("Failed to replay logs, retrying on the next poll", "err", err)
+	}
+
+	ticker := time.NewTicker(l.jb.EVMLogSpec.PollPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.replay(ctx); err != nil {
+				l.lggr.Errorw("Failed to replay logs, retrying on the next poll", "err", err)
+				continue
+			}
+			if err := l.poll(ctx); err != nil {
+				l.lggr.Errorw("Failed to poll logs", "err", err, "fromBlock", l.cursor.fromBlock())
+			}
+		}
+	}
+}
+
+// replay waits for the log poller to index the logs from replayFrom, if they are not yet. Polling
+// waits for it, since the cursor would otherwise move past the logs which are not indexed yet.
+func (l *listener) replay(ctx context.Context) error {
+	if l.replayFrom == 0 {
+		return nil
+	}
+	if err := l.lp.Replay(ctx, l.replayFrom); err != nil {
+		return errors.Wrapf(err, "replaying logs from block %d", l.replayFrom)
+	}
+	l.replayFrom = 0
+	return nil
+}
+
+// poll triggers a run for every matching log after the cursor, up to the latest block with enough
+// confirmations. The cursor is saved after every run, so that a log is not run twice. When a run
+// cannot be started or saved, polling stops before its log, which is run again on the next poll:
+// logs are delivered at least once, and twice only if the cursor could not be saved after the run.
+// The runs whose tasks fail are saved as errored and not retried, like the runs of the other job
+// types, and logs which cannot be decoded with the event ABI are skipped with an error.
+func (l *listener) poll(ctx context.Context) error {
+	latest, err := l.lp.LatestBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting latest block")
+	}
+	toBlock := latest.FinalizedBlockNumber
+	if confs := l.jb.EVMLogSpec.MinConfirmations; confs.Valid {
+		toBlock = latest.BlockNumber - int64(confs.Uint32)
+	}
+	fromBlock := l.cursor.fromBlock()
+	if toBlock < fromBlock {
+		return nil
+	}
+
+	logs, err := l.lp.LogsWithSigs(ctx, fromBlock, toBlock, []common.Hash{l.eventSig}, l.address)
+	if err != nil {
+		return errors.Wrap(err, "fetching logs")
+	}
+	l.lggr.Debugw("Fetched logs", "fromBlock", fromBlock, "toBlock", toBlock, "logs", len(logs))
+	for _, lg := range logs {
+		if l.cursor.processed(lg) || !l.matches(lg) {
+			continue
+		}
+		if err = l.runPipeline(ctx, lg); err != nil {
+			return err
+		}
+		if err = l.saveCursor(ctx, cursor{BlockNumber: lg.BlockNumber, LogIndex: lg.LogIndex}); err != nil {
+			return err
+		}
+	}
+	return l.saveCursor(ctx, cursor{BlockNumber: toBlock, LogIndex: math.MaxInt64})
+}
+
+// matches reports whether the indexed arguments of lg match the topic filters of the job.
+func (l *listener) matches(lg logpoller.Log) bool {
+	topics := lg.GetTopics()
+	for i, allowed := range l.topics {
+		if i >= len(topics) {
+			return false
+		}
+		found := false
+		for _, t := range allowed {
+			if topics[i] == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *listener) runPipeline(ctx context.Context, lg logpoller.Log) error {
+	lggr := l.lggr.With("txHash", lg.TxHash, "logIndex", lg.LogIndex, "blockNumber", lg.BlockNumber)
+	topics := lg.GetTopics()
+	meta, err := l.decoder.Decode(lg.Data, topics)
+	if err != nil {
+		// the log would fail to decode again
+		lggr.Errorw("Failed to decode log, skipping", "err", err)
+		return nil
+	}
+
+	jobSpec := map[string]interface{}{
+		"databaseID":    l.jb.ID,
+		"externalJobID": l.jb.ExternalJobID,
+		"name":          l.jb.Name.ValueOrZero(),
+		"evmChainID":    l.jb.EVMLogSpec.EVMChainID.String(),
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": jobSpec,
+		"jobRun": map[string]interface{}{
+			"meta":           meta,
+			"logBlockHash":   lg.BlockHash,
+			"logBlockNumber": lg.BlockNumber,
+			"logTxHash":      lg.TxHash,
+			"logIndex":       lg.LogIndex,
+			"logAddress":     lg.Address,
+			"logTopics":      topics,
+			"logData":        lg.Data,
+			"logEvent":       logEvent(lg, meta),
+		},
+	})
+
+	run := pipeline.NewRun(*l.jb.PipelineSpec, vars)
+	if _, err = l.pipelineRunner.Run(ctx, run, false, nil); err != nil {
+		return errors.Wrapf(err, "executing new run for log %s:%d of block %d", lg.TxHash, lg.LogIndex, lg.BlockNumber)
+	}
+	return nil
+}
+
+// logEvent returns the output the log event trigger capability gives workflows for lg, as pipeline
+// variables.
+func logEvent(lg logpoller.Log, data map[string]interface{}) map[string]interface{} {
+	out := logevent.NewOutput(types.Sequence{
+		Cursor: logpoller.FormatContractReaderCursor(lg),
+		TxHash: lg.TxHash.Bytes(),
+		Head: types.Head{
+			Height:    strconv.FormatInt(lg.BlockNumber, 10),
+			Hash:      lg.BlockHash.Bytes(),
+			Timestamp: uint64(lg.BlockTimestamp.Unix()), //nolint:gosec // block timestamps are positive
+		},
+	}, data)
+	return map[string]interface{}{
+		"Cursor": out.Cursor,
+		"Data":   map[string]interface{}(out.Data),
+		"Head": map[string]interface{}{
+			"Hash":      out.Head.Hash,
+			"Height":    out.Head.Height,
+			"Timestamp": out.Head.Timestamp,
+		},
+	}
+}
@@ -0,0 +1,261 @@
+package evmlog
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/common/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+const betPlacedABI = "BetPlaced(uint256 indexed marketId, address indexed bettor, uint256 amount)"
+
+func newTestListener(t *testing.T, lp logpoller.LogPoller, kv job.KVStore, runner pipeline.Runner, spec job.EVMLogSpec) *listener {
+	spec.EVMChainID = ubig.New(testutils.FixtureChainID)
+	spec.EventABI = betPlacedABI
+	jb := job.Job{
+		ID:            1,
+		ExternalJobID: uuid.New(),
+		Type:          job.EVMLog,
+		EVMLogSpec:    &spec,
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	l, err := newListener(jb, lp, kv, runner, logger.TestLogger(t))
+	require.NoError(t, err)
+	return l
+}
+
+func expectCursor(t *testing.T, kv *jobmocks.KVStore, cur cursor) {
+	b, err := json.Marshal(cur)
+	require.NoError(t, err)
+	kv.On("Store", mock.Anything, cursorKey, b).Return(nil).Once()
+}
+
+func betPlacedLog(t *testing.T, l *listener, block int64, marketID int64, bettor common.Address) logpoller.Log {
+	marketTopic, err := l.decoder.Topics("marketId", []interface{}{marketID})
+	require.NoError(t, err)
+	return logpoller.Log{
+		BlockNumber: block,
+		Address:     l.address,
+		EventSig:    l.eventSig,
+		Topics: pq.ByteaArray{
+			l.eventSig.Bytes(),
+			marketTopic[0].Bytes(),
+			common.BytesToHash(bettor.Bytes()).Bytes(),
+		},
+		Data: common.BigToHash(big.NewInt(42)).Bytes(),
+	}
+}
+
+func TestListener_Start(t *testing.T) {
+	spec := job.EVMLogSpec{
+		ContractAddress: cltest.NewEIP55Address(),
+		LookbackBlocks:  10,
+		PollPeriod:      time.Hour,
+	}
+
+	t.Run("starts from the lookback window and replays it", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		l := newTestListener(t, lp, kv, pipelinemocks.NewRunner(t), spec)
+
+		kv.On("Get", mock.Anything, cursorKey).Return(nil, fmt.Errorf("failed to get value: %w", sql.ErrNoRows)).Once()
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 30, FinalizedBlockNumber: 20}, nil).Once()
+		lp.On("HasFilter", l.filter.Name).Return(false).Once()
+		lp.On("RegisterFilter", mock.Anything, l.filter).Return(nil).Once()
+		replayed := make(chan struct{})
+		lp.On("Replay", mock.Anything, int64(20)).Return(nil).Run(func(mock.Arguments) { close(replayed) }).Once()
+
+		require.NoError(t, l.Start(testutils.Context(t)))
+		<-replayed
+		require.NoError(t, l.Close())
+		assert.Equal(t, int64(20), l.cursor.fromBlock())
+		assert.Zero(t, l.replayFrom)
+	})
+
+	t.Run("replays again when stopped before the first poll", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		l := newTestListener(t, lp, kv, pipelinemocks.NewRunner(t), spec)
+
+		kv.On("Get", mock.Anything, cursorKey).Return(nil, fmt.Errorf("failed to get value: %w", sql.ErrNoRows)).Once()
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 30, FinalizedBlockNumber: 20}, nil).Once()
+		lp.On("HasFilter", l.filter.Name).Return(true).Once()
+		replayed := make(chan struct{})
+		lp.On("Replay", mock.Anything, int64(20)).Return(nil).Run(func(mock.Arguments) { close(replayed) }).Once()
+
+		require.NoError(t, l.Start(testutils.Context(t)))
+		<-replayed
+		require.NoError(t, l.Close())
+	})
+
+	t.Run("resumes after the persisted cursor", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		l := newTestListener(t, lp, kv, pipelinemocks.NewRunner(t), spec)
+
+		b, err := json.Marshal(cursor{BlockNumber: 5, LogIndex: 3})
+		require.NoError(t, err)
+		kv.On("Get", mock.Anything, cursorKey).Return(b, nil).Once()
+		lp.On("HasFilter", l.filter.Name).Return(true).Once()
+
+		require.NoError(t, l.Start(testutils.Context(t)))
+		require.NoError(t, l.Close())
+		assert.Equal(t, cursor{BlockNumber: 5, LogIndex: 3}, l.cursor)
+		assert.Equal(t, int64(5), l.cursor.fromBlock())
+	})
+}
+
+func TestListener_Poll(t *testing.T) {
+	bettor := testutils.NewAddress()
+
+	t.Run("runs the pipeline for matching logs", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		runner := pipelinemocks.NewRunner(t)
+		l := newTestListener(t, lp, kv, runner, job.EVMLogSpec{
+			ContractAddress: cltest.NewEIP55Address(),
+			TopicFilters:    job.EVMLogTopicFilters{"marketId": {"1"}},
+		})
+		require.Len(t, l.filter.Topic2, 1)
+		assert.Empty(t, l.filter.Topic3)
+		assert.Empty(t, l.filter.Topic4)
+		l.cursor = cursor{BlockNumber: 9, LogIndex: math.MaxInt64}
+
+		matching := betPlacedLog(t, l, 12, 1, bettor)
+		matching.LogIndex = 4
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 30, FinalizedBlockNumber: 20}, nil).Once()
+		lp.On("LogsWithSigs", mock.Anything, int64(10), int64(20), []common.Hash{l.eventSig}, l.address).
+			Return([]logpoller.Log{
+				matching,
+				betPlacedLog(t, l, 15, 2, bettor),
+			}, nil).Once()
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+			Run(func(args mock.Arguments) {
+				run := args.Get(1).(*pipeline.Run)
+				vars := run.Inputs.Val.(map[string]interface{})
+				jobRun := vars["jobRun"].(map[string]interface{})
+				meta := map[string]interface{}{
+					"marketId": big.NewInt(1),
+					"bettor":   bettor,
+					"amount":   big.NewInt(42),
+				}
+				assert.Equal(t, meta, jobRun["meta"])
+				assert.Equal(t, int64(12), jobRun["logBlockNumber"])
+				logEvent := jobRun["logEvent"].(map[string]interface{})
+				assert.Equal(t, logpoller.FormatContractReaderCursor(matching), logEvent["Cursor"])
+				assert.Equal(t, meta, logEvent["Data"])
+				assert.Equal(t, "12", logEvent["Head"].(map[string]interface{})["Height"])
+			}).
+			Return(false, nil).Once()
+		expectCursor(t, kv, cursor{BlockNumber: 12, LogIndex: 4})
+		expectCursor(t, kv, cursor{BlockNumber: 20, LogIndex: math.MaxInt64})
+
+		require.NoError(t, l.poll(testutils.Context(t)))
+		assert.Equal(t, int64(21), l.cursor.fromBlock())
+	})
+
+	t.Run("skips processed logs", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		runner := pipelinemocks.NewRunner(t)
+		l := newTestListener(t, lp, kv, runner, job.EVMLogSpec{ContractAddress: cltest.NewEIP55Address()})
+		l.cursor = cursor{BlockNumber: 12, LogIndex: 1}
+
+		processed := betPlacedLog(t, l, 12, 1, bettor)
+		processed.LogIndex = 1
+		next := betPlacedLog(t, l, 12, 1, bettor)
+		next.LogIndex = 2
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 30, FinalizedBlockNumber: 20}, nil).Once()
+		lp.On("LogsWithSigs", mock.Anything, int64(12), int64(20), []common.Hash{l.eventSig}, l.address).
+			Return([]logpoller.Log{processed, next}, nil).Once()
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+			Return(false, nil).Once()
+		expectCursor(t, kv, cursor{BlockNumber: 12, LogIndex: 2})
+		expectCursor(t, kv, cursor{BlockNumber: 20, LogIndex: math.MaxInt64})
+
+		require.NoError(t, l.poll(testutils.Context(t)))
+	})
+
+	t.Run("uses min confirmations instead of finality", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		runner := pipelinemocks.NewRunner(t)
+		l := newTestListener(t, lp, kv, runner, job.EVMLogSpec{
+			ContractAddress:  cltest.NewEIP55Address(),
+			MinConfirmations: clnull.Uint32From(5),
+		})
+		l.cursor = cursor{BlockNumber: 9, LogIndex: math.MaxInt64}
+
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 30, FinalizedBlockNumber: 20}, nil).Once()
+		lp.On("LogsWithSigs", mock.Anything, int64(10), int64(25), []common.Hash{l.eventSig}, l.address).
+			Return([]logpoller.Log{betPlacedLog(t, l, 25, 7, bettor)}, nil).Once()
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+			Return(false, nil).Once()
+		expectCursor(t, kv, cursor{BlockNumber: 25, LogIndex: 0})
+		expectCursor(t, kv, cursor{BlockNumber: 25, LogIndex: math.MaxInt64})
+
+		require.NoError(t, l.poll(testutils.Context(t)))
+		assert.Equal(t, int64(26), l.cursor.fromBlock())
+	})
+
+	t.Run("stops before a log whose run fails", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		runner := pipelinemocks.NewRunner(t)
+		l := newTestListener(t, lp, kv, runner, job.EVMLogSpec{ContractAddress: cltest.NewEIP55Address()})
+		l.cursor = cursor{BlockNumber: 9, LogIndex: math.MaxInt64}
+
+		first := betPlacedLog(t, l, 12, 1, bettor)
+		failing := betPlacedLog(t, l, 12, 1, bettor)
+		failing.LogIndex = 1
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 30, FinalizedBlockNumber: 20}, nil).Once()
+		lp.On("LogsWithSigs", mock.Anything, int64(10), int64(20), []common.Hash{l.eventSig}, l.address).
+			Return([]logpoller.Log{first, failing, betPlacedLog(t, l, 15, 1, bettor)}, nil).Once()
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+			Return(false, nil).Once()
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+			Return(false, errors.New("db is down")).Once()
+		expectCursor(t, kv, cursor{BlockNumber: 12, LogIndex: 0})
+
+		require.ErrorContains(t, l.poll(testutils.Context(t)), "db is down")
+		// the failed log is run again on the next poll
+		assert.Equal(t, cursor{BlockNumber: 12, LogIndex: 0}, l.cursor)
+		assert.False(t, l.cursor.processed(failing))
+	})
+
+	t.Run("waits for new blocks", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		kv := jobmocks.NewKVStore(t)
+		runner := pipelinemocks.NewRunner(t)
+		l := newTestListener(t, lp, kv, runner, job.EVMLogSpec{ContractAddress: cltest.NewEIP55Address()})
+		l.cursor = cursor{BlockNumber: 20, LogIndex: math.MaxInt64}
+
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 30, FinalizedBlockNumber: 20}, nil).Once()
+
+		require.NoError(t, l.poll(testutils.Context(t)))
+		assert.Equal(t, int64(21), l.cursor.fromBlock())
+	})
+}
@@ -0,0 +1,118 @@
+package evmlog
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const (
+	defaultPollPeriod     = 15 * time.Second
+	defaultLookbackBlocks = 100
+)
+
+// ValidatedEVMLogSpec validates and converts the given toml string to a job.Job.
+func ValidatedEVMLogSpec(tomlString string) (job.Job, error) {
+	jb := job.Job{
+		// Default to generating a UUID, can be overwritten by the specified one in tomlString.
+		ExternalJobID: uuid.New(),
+	}
+
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, errors.Wrap(err, "loading toml")
+	}
+
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, errors.Wrap(err, "unmarshalling toml spec")
+	}
+
+	if jb.Type != job.EVMLog {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+
+	var spec job.EVMLogSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "unmarshalling toml job")
+	}
+
+	// Required fields
+	if spec.ContractAddress == "" {
+		return jb, notSet("contractAddress")
+	}
+	if spec.EventABI == "" {
+		return jb, notSet("eventABI")
+	}
+	if spec.EVMChainID == nil {
+		return jb, notSet("evmChainID")
+	}
+	decoder, err := pipeline.NewETHABILogDecoder(spec.EventABI)
+	if err != nil {
+		return jb, errors.Wrap(err, `invalid "eventABI"`)
+	}
+	if decoder.Name() == "" {
+		return jb, errors.New(`"eventABI" must include the event name`)
+	}
+	if _, err = topicFilters(decoder, spec.TopicFilters); err != nil {
+		return jb, errors.Wrap(err, `invalid "topicFilters"`)
+	}
+
+	// Defaults
+	if spec.PollPeriod == 0 {
+		spec.PollPeriod = defaultPollPeriod
+	}
+	if spec.LookbackBlocks == 0 {
+		spec.LookbackBlocks = defaultLookbackBlocks
+	}
+
+	// Other validations
+	if spec.LookbackBlocks < 0 {
+		return jb, errors.New(`"lookbackBlocks" must be positive`)
+	}
+	if spec.PollPeriod < 0 {
+		return jb, errors.New(`"pollPeriod" must be positive`)
+	}
+	if spec.MinConfirmations.Valid && spec.MinConfirmations.Uint32 == 0 {
+		return jb, errors.New(`"minConfirmations" must be at least 1`)
+	}
+
+	jb.EVMLogSpec = &spec
+
+	return jb, nil
+}
+
+// topicFilters converts the topic filters of a spec to the topics they match, keyed by topic index.
+func topicFilters(decoder *pipeline.ETHABILogDecoder, filters job.EVMLogTopicFilters) (map[int][]common.Hash, error) {
+	out := make(map[int][]common.Hash)
+	for argName, values := range filters {
+		if len(values) == 0 {
+			return nil, errors.Errorf("no values given for %s", argName)
+		}
+		i, err := decoder.TopicIndex(argName)
+		if err != nil {
+			return nil, err
+		}
+		var vals []interface{}
+		for _, v := range values {
+			vals = append(vals, v)
+		}
+		topics, err := decoder.Topics(argName, vals)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = topics
+	}
+	return out, nil
+}
+
+func notSet(field string) error {
+	return errors.Errorf("%q must be set", field)
+}
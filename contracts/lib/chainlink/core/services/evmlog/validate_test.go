@@ -0,0 +1,121 @@
+package evmlog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestValidatedEVMLogSpec(t *testing.T) {
+	var tt = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, jb job.Job, err error)
+	}{
+		{
+			name: "valid spec",
+			toml: `
+type            = "evmlog"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "BetPlaced(uint256 indexed marketId, address indexed bettor, bool outcome, uint256 amount)"
+minConfirmations = 3
+topicFilters    = { marketId = ["1", "2"] }
+observationSource = """
+ds [type=http method=POST url="https://example.com" requestData="{\\"bettor\\": $(jobRun.meta.bettor)}"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, jb.EVMLogSpec)
+				assert.Equal(t, job.EVMLog, jb.Type)
+				assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", jb.EVMLogSpec.ContractAddress.String())
+				assert.Equal(t, job.EVMLogTopicFilters{"marketId": {"1", "2"}}, jb.EVMLogSpec.TopicFilters)
+				assert.True(t, jb.EVMLogSpec.MinConfirmations.Valid)
+				assert.Equal(t, uint32(3), jb.EVMLogSpec.MinConfirmations.Uint32)
+				assert.Equal(t, 15*time.Second, jb.EVMLogSpec.PollPeriod)
+				assert.Equal(t, int32(100), jb.EVMLogSpec.LookbackBlocks)
+			},
+		},
+		{
+			name: "missing contract address",
+			toml: `
+type            = "evmlog"
+schemaVersion   = 1
+evmChainID      = 1337
+eventABI        = "MarketCreated(uint256 indexed marketId, string question)"
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.ErrorContains(t, err, `"contractAddress" must be set`)
+			},
+		},
+		{
+			name: "missing event name",
+			toml: `
+type            = "evmlog"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "(uint256 indexed marketId, string question)"
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.ErrorContains(t, err, `"eventABI" must include the event name`)
+			},
+		},
+		{
+			name: "filter on non-indexed argument",
+			toml: `
+type            = "evmlog"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "MarketCreated(uint256 indexed marketId, string question)"
+topicFilters    = { question = ["foo"] }
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.ErrorContains(t, err, "question is not an indexed argument of MarketCreated")
+			},
+		},
+		{
+			name: "bad filter value",
+			toml: `
+type            = "evmlog"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "MarketCreated(uint256 indexed marketId, string question)"
+topicFilters    = { marketId = ["foo"] }
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.ErrorContains(t, err, "bad value for marketId")
+			},
+		},
+		{
+			name: "zero min confirmations",
+			toml: `
+type            = "evmlog"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "MarketCreated(uint256 indexed marketId, string question)"
+minConfirmations = 0
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.ErrorContains(t, err, `"minConfirmations" must be at least 1`)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			jb, err := evmlog.ValidatedEVMLogSpec(tc.toml)
+			tc.assertion(t, jb, err)
+		})
+	}
+}
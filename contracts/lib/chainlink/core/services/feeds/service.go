@@ -1206,7 +1206,7 @@ func (s *service) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		s.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: s.csaKeyStore}.Sign)
+		s.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: s.csaKeyStore, Subsystem: "JobDistributor"}.Sign)
 		if err != nil {
 			return err
 		}
@@ -5,8 +5,6 @@ import (
 	stderrors "errors"
 	"fmt"
 	"math/big"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -16,7 +14,6 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
-	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
 )
 
 type (
@@ -69,15 +66,7 @@ func NewLegacyEVMPrometheusReporter(ds sqlutil.DataSource, chainContainer legacy
 }
 
 func (pr *prometheusReporter) getTxm(evmChainID *big.Int) (txmgr.TxManager, error) {
-	chainService, err := pr.chains.Get(evmChainID.String())
-	if err != nil {
-		return nil, fmt.Errorf("failed to get chain: %w", err)
-	}
-	chain, ok := chainService.(legacyevm.Chain)
-	if !ok {
-		return nil, fmt.Errorf("txm is not available in LOOP Plugin mode: %w", stderrors.ErrUnsupported)
-	}
-	return chain.TxManager(), nil
+	return getLegacyEVMTxm(pr.chains, evmChainID)
 }
 
 func (pr *prometheusReporter) ReportNewHead(ctx context.Context, head *evmtypes.Head) error {
@@ -95,14 +84,11 @@ func (pr *prometheusReporter) reportPendingEthTxes(ctx context.Context, evmChain
 		return fmt.Errorf("failed to get txm: %w", err)
 	}
 
-	unconfirmed, err := txm.CountTransactionsByState(ctx, txmgrcommon.TxUnconfirmed)
+	unconfirmed, err := countUnconfirmedTransactions(ctx, txm)
 	if err != nil {
-		if strings.Contains(err.Error(), "disabled") {
-			return nil
-		}
-		return fmt.Errorf("failed to query for unconfirmed eth_tx count: %w", err)
+		return ignoreTxmDisabled(err)
 	}
-	pr.backend.SetUnconfirmedTransactions(evmChainID, int64(unconfirmed))
+	pr.backend.SetUnconfirmedTransactions(evmChainID, unconfirmed)
 	return nil
 }
 
@@ -112,17 +98,9 @@ func (pr *prometheusReporter) reportMaxUnconfirmedAge(ctx context.Context, evmCh
 		return fmt.Errorf("failed to get txm: %w", err)
 	}
 
-	broadcastAt, err := txm.FindEarliestUnconfirmedBroadcastTime(ctx)
+	seconds, err := maxUnconfirmedAge(ctx, txm)
 	if err != nil {
-		if strings.Contains(err.Error(), "disabled") {
-			return nil
-		}
-		return fmt.Errorf("failed to query for min broadcast time: %w", err)
-	}
-
-	var seconds float64
-	if broadcastAt.Valid {
-		seconds = time.Since(broadcastAt.ValueOrZero()).Seconds()
+		return ignoreTxmDisabled(err)
 	}
 	pr.backend.SetMaxUnconfirmedAge(evmChainID, seconds)
 	return nil
@@ -134,17 +112,9 @@ func (pr *prometheusReporter) reportMaxUnconfirmedBlocks(ctx context.Context, he
 		return fmt.Errorf("failed to get txm: %w", err)
 	}
 
-	earliestUnconfirmedTxBlock, err := txm.FindEarliestUnconfirmedTxAttemptBlock(ctx)
+	blocksUnconfirmed, err := maxUnconfirmedBlocks(ctx, txm, head)
 	if err != nil {
-		if strings.Contains(err.Error(), "disabled") {
-			return nil
-		}
-		return fmt.Errorf("failed to query for earliest unconfirmed tx block: %w", err)
-	}
-
-	var blocksUnconfirmed int64
-	if !earliestUnconfirmedTxBlock.IsZero() {
-		blocksUnconfirmed = head.Number - earliestUnconfirmedTxBlock.ValueOrZero()
+		return ignoreTxmDisabled(err)
 	}
 	pr.backend.SetMaxUnconfirmedBlocks(head.EVMChainID.ToInt(), blocksUnconfirmed)
 	return nil
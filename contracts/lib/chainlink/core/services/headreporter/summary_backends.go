@@ -0,0 +1,88 @@
+package headreporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// NewSummaryBackends returns the backends of the head summaries enabled by cfg.
+func NewSummaryBackends(cfg config.HeadReport) (backends []SummaryBackend) {
+	if u := cfg.WebhookURL(); u != nil && u.String() != "" {
+		backends = append(backends, NewWebhookBackend(u, cfg.WebhookTimeout()))
+	}
+	if path := cfg.FilePath(); path != "" {
+		backends = append(backends, NewFileBackend(path))
+	}
+	return
+}
+
+type webhookBackend struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookBackend returns a SummaryBackend which POSTs every head summary to u as JSON.
+func NewWebhookBackend(u *url.URL, timeout time.Duration) SummaryBackend {
+	return &webhookBackend{url: u.String(), client: &http.Client{Timeout: timeout}}
+}
+
+func (w *webhookBackend) ReportHeadSummary(ctx context.Context, summary HeadSummary) (err error) {
+	body, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal head summary: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create head summary request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send head summary: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, resp.Body.Close())
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("head summary webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type fileBackend struct {
+	path string
+}
+
+// NewFileBackend returns a SummaryBackend which appends every head summary to the file at path as a JSON line. The
+// file is opened for every summary, so that it can be rotated by external tools.
+func NewFileBackend(path string) SummaryBackend {
+	return &fileBackend{path: path}
+}
+
+func (f *fileBackend) ReportHeadSummary(_ context.Context, summary HeadSummary) (err error) {
+	line, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal head summary: %w", err)
+	}
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open head summary file: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, file.Close())
+	}()
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,81 @@
+package headreporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+type (
+	// HeadSummary summarizes the unconfirmed transactions of a chain at a new head.
+	HeadSummary struct {
+		EVMChainID  string    `json:"evmChainID"`
+		BlockNumber int64     `json:"blockNumber"`
+		BlockHash   string    `json:"blockHash"`
+		Timestamp   time.Time `json:"timestamp"`
+		// UnconfirmedTransactions is the number of unconfirmed transactions.
+		UnconfirmedTransactions int64 `json:"unconfirmedTransactions"`
+		// MaxUnconfirmedAge is how long the oldest unconfirmed transaction has been broadcast for, in seconds.
+		MaxUnconfirmedAge float64 `json:"maxUnconfirmedAgeSeconds"`
+		// MaxUnconfirmedBlocks is for how many blocks the oldest unconfirmed transaction has been unconfirmed.
+		MaxUnconfirmedBlocks int64 `json:"maxUnconfirmedBlocks"`
+	}
+
+	// SummaryBackend receives the summary of every new head.
+	SummaryBackend interface {
+		ReportHeadSummary(ctx context.Context, summary HeadSummary) error
+	}
+
+	summaryReporter struct {
+		chains   legacyevm.LegacyChainContainer
+		backends []SummaryBackend
+	}
+)
+
+// NewLegacyEVMSummaryReporter returns a HeadReporter which sends the summary of every new head to backends.
+func NewLegacyEVMSummaryReporter(chainContainer legacyevm.LegacyChainContainer, backends ...SummaryBackend) HeadReporter {
+	return &summaryReporter{chains: chainContainer, backends: backends}
+}
+
+func (sr *summaryReporter) ReportNewHead(ctx context.Context, head *evmtypes.Head) error {
+	summary, err := sr.summarize(ctx, head)
+	if err != nil {
+		return err
+	}
+	for _, backend := range sr.backends {
+		err = errors.Join(err, backend.ReportHeadSummary(ctx, summary))
+	}
+	return err
+}
+
+func (sr *summaryReporter) summarize(ctx context.Context, head *evmtypes.Head) (summary HeadSummary, err error) {
+	summary = HeadSummary{
+		EVMChainID:  head.EVMChainID.String(),
+		BlockNumber: head.Number,
+		BlockHash:   head.Hash.Hex(),
+		Timestamp:   head.Timestamp.UTC(),
+	}
+	txm, err := getLegacyEVMTxm(sr.chains, head.EVMChainID.ToInt())
+	if err != nil {
+		return summary, fmt.Errorf("failed to get txm: %w", err)
+	}
+
+	var unconfirmedErr, ageErr, blocksErr error
+	summary.UnconfirmedTransactions, unconfirmedErr = countUnconfirmedTransactions(ctx, txm)
+	summary.MaxUnconfirmedAge, ageErr = maxUnconfirmedAge(ctx, txm)
+	summary.MaxUnconfirmedBlocks, blocksErr = maxUnconfirmedBlocks(ctx, txm, head)
+	for _, e := range []error{unconfirmedErr, ageErr, blocksErr} {
+		if e != nil {
+			err = errors.Join(err, ignoreTxmDisabled(e))
+		}
+	}
+	return summary, err
+}
+
+func (sr *summaryReporter) ReportPeriodic(context.Context) error {
+	return nil
+}
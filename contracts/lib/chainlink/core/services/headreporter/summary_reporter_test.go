@@ -0,0 +1,112 @@
+package headreporter_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/txmgrtest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/headreporter"
+)
+
+type summaryRecorder []headreporter.HeadSummary
+
+func (r *summaryRecorder) ReportHeadSummary(_ context.Context, summary headreporter.HeadSummary) error {
+	*r = append(*r, summary)
+	return nil
+}
+
+func Test_SummaryReporter(t *testing.T) {
+	t.Run("with unconfirmed evm.txes", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		txStore := txmgrtest.NewTestTxStore(t, db)
+		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+		etx := txmgrtest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+		txmgrtest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		require.NoError(t, txStore.UpdateTxAttemptBroadcastBeforeBlockNum(testutils.Context(t), etx.ID, 7))
+
+		var recorder summaryRecorder
+		reporter := headreporter.NewLegacyEVMSummaryReporter(newLegacyChainContainer(t, db), &recorder)
+
+		head := headreporter.NewHead()
+		require.NoError(t, reporter.ReportNewHead(testutils.Context(t), &head))
+		require.Len(t, recorder, 1)
+		assert.Equal(t, "0", recorder[0].EVMChainID)
+		assert.Equal(t, int64(42), recorder[0].BlockNumber)
+		assert.Equal(t, int64(2), recorder[0].UnconfirmedTransactions)
+		assert.Positive(t, recorder[0].MaxUnconfirmedAge)
+		assert.Equal(t, int64(35), recorder[0].MaxUnconfirmedBlocks)
+	})
+
+	t.Run("with null txm", func(t *testing.T) {
+		var recorder summaryRecorder
+		reporter := headreporter.NewLegacyEVMSummaryReporter(newLegacyChainContainerWithNullTxm(t), &recorder)
+
+		head := headreporter.NewHead()
+		require.NoError(t, reporter.ReportNewHead(testutils.Context(t), &head))
+		require.Len(t, recorder, 1)
+		assert.Zero(t, recorder[0].UnconfirmedTransactions)
+	})
+}
+
+func Test_WebhookBackend(t *testing.T) {
+	received := make(chan headreporter.HeadSummary, 1)
+	status := http.StatusOK
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var summary headreporter.HeadSummary
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&summary))
+		received <- summary
+		w.WriteHeader(status)
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	backend := headreporter.NewWebhookBackend(u, time.Second)
+	summary := headreporter.HeadSummary{EVMChainID: "1", BlockNumber: 42, UnconfirmedTransactions: 3}
+	require.NoError(t, backend.ReportHeadSummary(testutils.Context(t), summary))
+	assert.Equal(t, summary, <-received)
+
+	status = http.StatusInternalServerError
+	require.EqualError(t, backend.ReportHeadSummary(testutils.Context(t), summary), "head summary webhook responded with status 500")
+	<-received
+}
+
+func Test_FileBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "heads.jsonl")
+	backend := headreporter.NewFileBackend(path)
+	for i := int64(1); i <= 2; i++ {
+		require.NoError(t, backend.ReportHeadSummary(testutils.Context(t), headreporter.HeadSummary{EVMChainID: "1", BlockNumber: i}))
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var numbers []int64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var summary headreporter.HeadSummary
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &summary))
+		numbers = append(numbers, summary.BlockNumber)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []int64{1, 2}, numbers)
+}
@@ -0,0 +1,70 @@
+package headreporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+)
+
+func getLegacyEVMTxm(chains legacyevm.LegacyChainContainer, evmChainID *big.Int) (txmgr.TxManager, error) {
+	chainService, err := chains.Get(evmChainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain: %w", err)
+	}
+	chain, ok := chainService.(legacyevm.Chain)
+	if !ok {
+		return nil, fmt.Errorf("txm is not available in LOOP Plugin mode: %w", errors.ErrUnsupported)
+	}
+	return chain.TxManager(), nil
+}
+
+// ignoreTxmDisabled returns nil if err is returned by a disabled txm, which has no transactions to report.
+func ignoreTxmDisabled(err error) error {
+	if strings.Contains(err.Error(), "disabled") {
+		return nil
+	}
+	return err
+}
+
+// countUnconfirmedTransactions returns the number of unconfirmed transactions of txm.
+func countUnconfirmedTransactions(ctx context.Context, txm txmgr.TxManager) (int64, error) {
+	unconfirmed, err := txm.CountTransactionsByState(ctx, txmgrcommon.TxUnconfirmed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query for unconfirmed eth_tx count: %w", err)
+	}
+	return int64(unconfirmed), nil
+}
+
+// maxUnconfirmedAge returns how long the oldest unconfirmed transaction of txm has been broadcast for, in seconds, or
+// 0 if there are none.
+func maxUnconfirmedAge(ctx context.Context, txm txmgr.TxManager) (float64, error) {
+	broadcastAt, err := txm.FindEarliestUnconfirmedBroadcastTime(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query for min broadcast time: %w", err)
+	}
+	if !broadcastAt.Valid {
+		return 0, nil
+	}
+	return time.Since(broadcastAt.ValueOrZero()).Seconds(), nil
+}
+
+// maxUnconfirmedBlocks returns for how many blocks before head the oldest unconfirmed transaction of txm has been
+// unconfirmed, or 0 if there are none.
+func maxUnconfirmedBlocks(ctx context.Context, txm txmgr.TxManager, head *evmtypes.Head) (int64, error) {
+	earliestUnconfirmedTxBlock, err := txm.FindEarliestUnconfirmedTxAttemptBlock(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query for earliest unconfirmed tx block: %w", err)
+	}
+	if earliestUnconfirmedTxBlock.IsZero() {
+		return 0, nil
+	}
+	return head.Number - earliestUnconfirmedTxBlock.ValueOrZero(), nil
+}
@@ -1148,6 +1148,30 @@ func Test_FindJobs(t *testing.T) {
 			assert.Equal(t, exp.ID, jobs[i].ID)
 		}
 	})
+
+	t.Run("jobs in scopes are filtered and paginated", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		either := [][]job.JobScope{{{Types: []string{string(job.DirectRequest)}}, {IDs: []int32{jb1.ID}}}}
+		jobs, count, err2 := orm.FindJobsInScopes(ctx, either, 0, 1)
+		require.NoError(t, err2)
+		assert.Equal(t, 2, count)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, jb2.ID, jobs[0].ID)
+
+		both := [][]job.JobScope{{{IDs: []int32{jb1.ID, jb2.ID}}}, {{Types: []string{string(job.OffchainReporting)}}}}
+		jobs, count, err2 = orm.FindJobsInScopes(ctx, both, 0, 10)
+		require.NoError(t, err2)
+		assert.Equal(t, 1, count)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, jb1.ID, jobs[0].ID)
+
+		for _, none := range [][][]job.JobScope{{{}}, {{{Tags: []string{"missing"}}}}} {
+			jobs, count, err2 = orm.FindJobsInScopes(ctx, none, 0, 10)
+			require.NoError(t, err2)
+			assert.Zero(t, count)
+			assert.Empty(t, jobs)
+		}
+	})
 }
 
 func Test_FindJob(t *testing.T) {
@@ -1737,6 +1761,51 @@ func Test_FindPipelineRunsByIDs(t *testing.T) {
 	})
 }
 
+func Test_FindRecentErroredPipelineRuns(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+	orm := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.GetDirectRequestSpec())
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(ctx, &jb))
+
+	t.Run("with no errored pipeline runs", func(t *testing.T) {
+		mustInsertPipelineRun(t, pipelineORM, jb)
+
+		runs, err2 := orm.FindRecentErroredPipelineRuns(testutils.Context(t), 10)
+		require.NoError(t, err2)
+		assert.Empty(t, runs)
+	})
+
+	t.Run("with errored pipeline runs", func(t *testing.T) {
+		var errored []int64
+		for i := 0; i < 3; i++ {
+			run := mustInsertPipelineRun(t, pipelineORM, jb)
+			_, err2 := db.ExecContext(testutils.Context(t), `UPDATE pipeline_runs SET state = 'errored', finished_at = NOW(),
+				fatal_errors = '["boom"]', all_errors = '["boom"]' WHERE id = $1`, run.ID)
+			require.NoError(t, err2)
+			errored = append(errored, run.ID)
+			mustInsertPipelineRun(t, pipelineORM, jb)
+		}
+
+		runs, err2 := orm.FindRecentErroredPipelineRuns(testutils.Context(t), 2)
+		require.NoError(t, err2)
+		require.Len(t, runs, 2)
+		assert.Equal(t, errored[2], runs[0].ID)
+		assert.Equal(t, errored[1], runs[1].ID)
+		assert.Equal(t, pipeline.RunStatusErrored, runs[0].State)
+		assert.Equal(t, jb.ID, runs[0].PipelineSpec.JobID)
+	})
+}
+
 func Test_FindPipelineRunByID(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,109 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/custmsg"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+)
+
+// livenessCheckInterval is how often the liveness of jobs is evaluated, which matches the health checker.
+const livenessCheckInterval = 15 * time.Second
+
+// livenessCondition is the health condition set while a liveness check fails.
+const livenessCondition = "liveness"
+
+// livenessCheck is a job service failing its health check while the job has not completed a successful run for longer
+// than its maxTimeBetweenSuccessfulRuns, or while its last maxConsecutiveErrors runs failed. It emits a custom message
+// when the check starts or stops failing.
+type livenessCheck struct {
+	services.Service
+	eng *services.Engine
+
+	jobID       int32
+	jobName     string
+	maxInterval time.Duration
+	maxErrors   uint32
+	emitter     custmsg.MessageEmitter
+
+	failing bool
+}
+
+func newLivenessCheck(jb Job, lggr logger.Logger) *livenessCheck {
+	c := &livenessCheck{
+		jobID:       jb.ID,
+		jobName:     jb.Name.ValueOrZero(),
+		maxInterval: jb.MaxTimeBetweenSuccessfulRuns.Duration(),
+		maxErrors:   jb.MaxConsecutiveErrors,
+		emitter: custmsg.NewLabeler().WithMapLabels(map[string]string{
+			"system":        "Job",
+			"version":       static.Version,
+			"commit":        static.Sha,
+			"jobID":         strconv.Itoa(int(jb.ID)),
+			"jobName":       jb.Name.ValueOrZero(),
+			"jobType":       string(jb.Type),
+			"externalJobID": jb.ExternalJobID.String(),
+		}),
+	}
+	c.Service, c.eng = services.Config{
+		Name:  fmt.Sprintf("Job%dLiveness", jb.ID),
+		Start: c.start,
+	}.NewServiceEngine(lggr)
+	return c
+}
+
+func (c *livenessCheck) start(context.Context) error {
+	c.eng.GoTick(timeutil.NewTicker(func() time.Duration { return livenessCheckInterval }), c.update)
+	return nil
+}
+
+// update evaluates the liveness of the job and emits a message when it changed.
+func (c *livenessCheck) update(ctx context.Context) {
+	stats, ok := pipeline.GetJobRunStats(c.jobID)
+	if !ok {
+		return
+	}
+	err := c.check(stats, time.Now())
+	if err != nil {
+		c.eng.SetHealthCond(livenessCondition, err)
+	} else {
+		c.eng.ClearHealthCond(livenessCondition)
+	}
+	failing := err != nil
+	if failing == c.failing {
+		return
+	}
+	c.failing = failing
+
+	msg := "job liveness check recovered"
+	if failing {
+		c.eng.Warnw("Job liveness check failing", "err", err)
+		msg = "job liveness check failing: " + err.Error()
+	} else {
+		c.eng.Info("Job liveness check recovered")
+	}
+	if err := c.emitter.Emit(ctx, msg); err != nil {
+		c.eng.Errorw("Failed to emit job liveness message", "err", err)
+	}
+}
+
+// check returns an error if the stats of the job do not meet its liveness expectations at now.
+func (c *livenessCheck) check(stats pipeline.JobRunStats, now time.Time) error {
+	if c.maxErrors > 0 && stats.ConsecutiveErrors >= c.maxErrors {
+		return fmt.Errorf("the last %d runs of job %d (%s) failed", stats.ConsecutiveErrors, c.jobID, c.jobName)
+	}
+	if c.maxInterval > 0 && !stats.LastSuccess.IsZero() {
+		if since := now.Sub(stats.LastSuccess); since > c.maxInterval {
+			return fmt.Errorf("job %d (%s) has not run successfully for %s, more than %s", c.jobID, c.jobName, since.Round(time.Second), c.maxInterval)
+		}
+	}
+	return nil
+}
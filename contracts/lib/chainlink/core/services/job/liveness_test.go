@@ -0,0 +1,51 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestLivenessCheck_check(t *testing.T) {
+	t.Parallel()
+
+	jb := Job{
+		ID:                           42,
+		Name:                         null.StringFrom("liveness"),
+		Type:                         Cron,
+		ExternalJobID:                uuid.New(),
+		MaxTimeBetweenSuccessfulRuns: models.Interval(time.Hour),
+		MaxConsecutiveErrors:         3,
+	}
+	assert.True(t, jb.HasLivenessChecks())
+	assert.False(t, Job{}.HasLivenessChecks())
+
+	c := newLivenessCheck(jb, logger.TestLogger(t))
+	now := time.Now()
+	for _, tt := range []struct {
+		name  string
+		stats pipeline.JobRunStats
+		err   string
+	}{
+		{"healthy", pipeline.JobRunStats{LastSuccess: now.Add(-time.Minute), ConsecutiveErrors: 2}, ""},
+		{"never started", pipeline.JobRunStats{ConsecutiveErrors: 1}, ""},
+		{"consecutive errors", pipeline.JobRunStats{LastSuccess: now, ConsecutiveErrors: 3}, "the last 3 runs of job 42 (liveness) failed"},
+		{"overdue", pipeline.JobRunStats{LastSuccess: now.Add(-2 * time.Hour)}, "job 42 (liveness) has not run successfully for 2h0m0s, more than 1h0m0s"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.check(tt.stats, now)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
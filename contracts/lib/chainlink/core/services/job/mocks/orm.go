@@ -1014,6 +1014,74 @@ func (_c *ORM_FindJobsByPipelineSpecIDs_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// FindJobsInScopes provides a mock function with given fields: ctx, scopes, offset, limit
+func (_m *ORM) FindJobsInScopes(ctx context.Context, scopes [][]job.JobScope, offset int, limit int) ([]job.Job, int, error) {
+	ret := _m.Called(ctx, scopes, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobsInScopes")
+	}
+
+	var r0 []job.Job
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, [][]job.JobScope, int, int) ([]job.Job, int, error)); ok {
+		return rf(ctx, scopes, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, [][]job.JobScope, int, int) []job.Job); ok {
+		r0 = rf(ctx, scopes, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, [][]job.JobScope, int, int) int); ok {
+		r1 = rf(ctx, scopes, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, [][]job.JobScope, int, int) error); ok {
+		r2 = rf(ctx, scopes, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_FindJobsInScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobsInScopes'
+type ORM_FindJobsInScopes_Call struct {
+	*mock.Call
+}
+
+// FindJobsInScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scopes [][]job.JobScope
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) FindJobsInScopes(ctx interface{}, scopes interface{}, offset interface{}, limit interface{}) *ORM_FindJobsInScopes_Call {
+	return &ORM_FindJobsInScopes_Call{Call: _e.mock.On("FindJobsInScopes", ctx, scopes, offset, limit)}
+}
+
+func (_c *ORM_FindJobsInScopes_Call) Run(run func(ctx context.Context, scopes [][]job.JobScope, offset int, limit int)) *ORM_FindJobsInScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([][]job.JobScope), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobsInScopes_Call) Return(_a0 []job.Job, _a1 int, _a2 error) *ORM_FindJobsInScopes_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_FindJobsInScopes_Call) RunAndReturn(run func(context.Context, [][]job.JobScope, int, int) ([]job.Job, int, error)) *ORM_FindJobsInScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindOCR2JobIDByAddress provides a mock function with given fields: ctx, contractID, feedID
 func (_m *ORM) FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error) {
 	ret := _m.Called(ctx, contractID, feedID)
@@ -1249,6 +1317,65 @@ func (_c *ORM_FindPipelineRunsByIDs_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindRecentErroredPipelineRuns provides a mock function with given fields: ctx, limit
+func (_m *ORM) FindRecentErroredPipelineRuns(ctx context.Context, limit int) ([]pipeline.Run, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecentErroredPipelineRuns")
+	}
+
+	var r0 []pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]pipeline.Run, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []pipeline.Run); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindRecentErroredPipelineRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecentErroredPipelineRuns'
+type ORM_FindRecentErroredPipelineRuns_Call struct {
+	*mock.Call
+}
+
+// FindRecentErroredPipelineRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *ORM_Expecter) FindRecentErroredPipelineRuns(ctx interface{}, limit interface{}) *ORM_FindRecentErroredPipelineRuns_Call {
+	return &ORM_FindRecentErroredPipelineRuns_Call{Call: _e.mock.On("FindRecentErroredPipelineRuns", ctx, limit)}
+}
+
+func (_c *ORM_FindRecentErroredPipelineRuns_Call) Run(run func(ctx context.Context, limit int)) *ORM_FindRecentErroredPipelineRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_FindRecentErroredPipelineRuns_Call) Return(runs []pipeline.Run, err error) *ORM_FindRecentErroredPipelineRuns_Call {
+	_c.Call.Return(runs, err)
+	return _c
+}
+
+func (_c *ORM_FindRecentErroredPipelineRuns_Call) RunAndReturn(run func(context.Context, int) ([]pipeline.Run, error)) *ORM_FindRecentErroredPipelineRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindSpecError provides a mock function with given fields: ctx, id
 func (_m *ORM) FindSpecError(ctx context.Context, id int64) (job.SpecError, error) {
 	ret := _m.Called(ctx, id)
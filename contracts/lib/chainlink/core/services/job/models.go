@@ -42,6 +42,7 @@ const (
 	Cron                    Type = (Type)(pipeline.CronJobType)
 	CCIP                    Type = (Type)(pipeline.CCIPJobType)
 	DirectRequest           Type = (Type)(pipeline.DirectRequestJobType)
+	EVMLog                  Type = (Type)(pipeline.EVMLogJobType)
 	FluxMonitor             Type = (Type)(pipeline.FluxMonitorJobType)
 	Gateway                 Type = (Type)(pipeline.GatewayJobType)
 	Keeper                  Type = (Type)(pipeline.KeeperJobType)
@@ -83,6 +84,7 @@ var (
 		Cron:                    true,
 		CCIP:                    false,
 		DirectRequest:           true,
+		EVMLog:                  true,
 		FluxMonitor:             true,
 		Gateway:                 false,
 		Keeper:                  false, // observationSource is injected in the upkeep executor
@@ -103,6 +105,7 @@ var (
 		Cron:                    true,
 		CCIP:                    false,
 		DirectRequest:           true,
+		EVMLog:                  true,
 		FluxMonitor:             false,
 		Gateway:                 false,
 		Keeper:                  true,
@@ -123,6 +126,7 @@ var (
 		Cron:                    1,
 		CCIP:                    1,
 		DirectRequest:           1,
+		EVMLog:                  1,
 		FluxMonitor:             1,
 		Gateway:                 1,
 		Keeper:                  1,
@@ -158,6 +162,8 @@ type Job struct {
 	VRFSpec                       *VRFSpec
 	WebhookSpecID                 *int32
 	WebhookSpec                   *WebhookSpec
+	EVMLogSpecID                  *int32
+	EVMLogSpec                    *EVMLogSpec
 	BlockhashStoreSpecID          *int32
 	BlockhashStoreSpec            *BlockhashStoreSpec
 	BlockHeaderFeederSpecID       *int32
@@ -185,16 +191,25 @@ type Job struct {
 	CCIPSpec                      *CCIPSpec
 	CCIPBootstrapSpecID           *int32
 	JobSpecErrors                 []SpecError
-	Type                          Type          `toml:"type"`
-	SchemaVersion                 uint32        `toml:"schemaVersion"`
-	GasLimit                      clnull.Uint32 `toml:"gasLimit"`
-	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
-	Name                          null.String   `toml:"name"`
+	Type                          Type           `toml:"type"`
+	SchemaVersion                 uint32         `toml:"schemaVersion"`
+	GasLimit                      clnull.Uint32  `toml:"gasLimit"`
+	ForwardingAllowed             bool           `toml:"forwardingAllowed"`
+	Name                          null.String    `toml:"name"`
+	Tags                          pq.StringArray `toml:"tags"`
 	MaxTaskDuration               models.Interval
+	ExpectedRunInterval           models.Interval   `toml:"expectedRunInterval"`
+	MaxTimeBetweenSuccessfulRuns  models.Interval   `toml:"maxTimeBetweenSuccessfulRuns"`
+	MaxConsecutiveErrors          uint32            `toml:"maxConsecutiveErrors"`
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
 }
 
+// HasLivenessChecks returns true if the job declares liveness expectations, which are checked by the health checker.
+func (j Job) HasLivenessChecks() bool {
+	return !j.MaxTimeBetweenSuccessfulRuns.IsZero() || j.MaxConsecutiveErrors > 0
+}
+
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
 	return common.BytesToHash([]byte(strings.Replace(id.String(), "-", "", 4)))
 }
@@ -519,6 +534,69 @@ func (s *CronSpec) SetID(value string) error {
 	return nil
 }
 
+// EVMLogSpec defines the job spec for the evmlog job, which triggers a pipeline run for every
+// log of an arbitrary contract event.
+type EVMLogSpec struct {
+	ID int32 `toml:"-"`
+
+	// ContractAddress is the address of the contract emitting the event.
+	ContractAddress evmtypes.EIP55Address `toml:"contractAddress"`
+
+	// EventABI is the event signature, in the same format as the ethabidecodelog task abi, e.g.
+	// "MarketCreated(uint256 indexed marketId, address indexed creator, string question)".
+	EventABI string `toml:"eventABI"`
+
+	// TopicFilters restricts the logs triggering runs to those whose indexed arguments match one
+	// of the given values, keyed by argument name. If empty, every log of the event triggers a run.
+	TopicFilters EVMLogTopicFilters `toml:"topicFilters"`
+
+	// MinConfirmations is the number of confirmations after which a log triggers a run, at least 1:
+	// a log with 1 confirmation has one block on top of it. If not set, only finalized logs
+	// trigger runs.
+	MinConfirmations clnull.Uint32 `toml:"minConfirmations"`
+
+	// LookbackBlocks defines how many blocks before the latest one are scanned when the job first
+	// starts. Afterwards, it resumes after the last processed log.
+	LookbackBlocks int32 `toml:"lookbackBlocks"`
+
+	// PollPeriod defines how often new logs are fetched from the log poller.
+	PollPeriod time.Duration `toml:"pollPeriod"`
+
+	// EVMChainID defines the chain the contract is deployed on.
+	EVMChainID *big.Big `toml:"evmChainID"`
+
+	// CreatedAt is the time this job was created.
+	CreatedAt time.Time `toml:"-"`
+
+	// UpdatedAt is the time this job was last updated.
+	UpdatedAt time.Time `toml:"-"`
+}
+
+// EVMLogTopicFilters maps indexed event argument names to the values they may take, encoded as
+// JSON in the database.
+type EVMLogTopicFilters map[string][]string
+
+// Value returns this instance serialized for database storage.
+func (f EVMLogTopicFilters) Value() (driver.Value, error) {
+	if len(f) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(f)
+}
+
+// Scan reads the database value and returns an instance.
+func (f *EVMLogTopicFilters) Scan(value interface{}) error {
+	if value == nil {
+		return nil // field is nullable
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, f)
+}
+
 type FluxMonitorSpec struct {
 	ID              int32                 `toml:"-"`
 	ContractAddress evmtypes.EIP55Address `toml:"contractAddress"`
@@ -645,7 +723,7 @@ type BlockhashStoreSpec struct {
 	// PollPeriod defines how often recent blocks should be scanned for blockhash storage.
 	PollPeriod time.Duration `toml:"pollPeriod"`
 
-	// RunTimeout defines the timeout for a single run of the blockhash store feeder.
+	// RunTimeout defines the timeout for a single run of the blockhash store feeder, shared by its stores.
 	RunTimeout time.Duration `toml:"runTimeout"`
 
 	// EVMChainID defines the chain ID for monitoring and storing of blockhashes.
@@ -654,6 +732,10 @@ type BlockhashStoreSpec struct {
 	// FromAddress is the sender address that should be used to store blockhashes.
 	FromAddresses []evmtypes.EIP55Address `toml:"fromAddresses"`
 
+	// AdditionalStores are further BlockhashStore contracts maintained by this job, each fed by
+	// its own coordinators. They share the job's lookback window and sending keys.
+	AdditionalStores BlockhashStoreTargets `toml:"additionalStores"`
+
 	// CreatedAt is the time this job was created.
 	CreatedAt time.Time `toml:"-"`
 
@@ -694,7 +776,7 @@ type BlockHeaderFeederSpec struct {
 	// PollPeriod defines how often recent blocks should be scanned for blockhash storage.
 	PollPeriod time.Duration `toml:"pollPeriod"`
 
-	// RunTimeout defines the timeout for a single run of the blockhash store feeder.
+	// RunTimeout defines the timeout for a single run of the blockhash store feeder, shared by its stores.
 	RunTimeout time.Duration `toml:"runTimeout"`
 
 	// EVMChainID defines the chain ID for monitoring and storing of blockhashes.
@@ -709,6 +791,11 @@ type BlockHeaderFeederSpec struct {
 	// StoreBlockhashesBatchSize is the RPC call batch size for storing blockhashes
 	StoreBlockhashesBatchSize uint16 `toml:"storeBlockhashesBatchSize"`
 
+	// AdditionalStores are further BlockhashStore contracts maintained by this job, each fed by
+	// its own coordinators and with its own BatchBlockhashStore. They share the job's lookback
+	// window and sending keys.
+	AdditionalStores BlockhashStoreTargets `toml:"additionalStores"`
+
 	// CreatedAt is the time this job was created.
 	CreatedAt time.Time `toml:"-"`
 
@@ -716,6 +803,81 @@ type BlockHeaderFeederSpec struct {
 	UpdatedAt time.Time `toml:"-"`
 }
 
+// Stores returns the main BlockhashStore of the spec followed by its additional stores.
+func (s *BlockhashStoreSpec) Stores() BlockhashStoreTargets {
+	return append(BlockhashStoreTargets{{
+		CoordinatorV1Address:         s.CoordinatorV1Address,
+		CoordinatorV2Address:         s.CoordinatorV2Address,
+		CoordinatorV2PlusAddress:     s.CoordinatorV2PlusAddress,
+		BlockhashStoreAddress:        s.BlockhashStoreAddress,
+		TrustedBlockhashStoreAddress: s.TrustedBlockhashStoreAddress,
+	}}, s.AdditionalStores...)
+}
+
+// Stores returns the main BlockhashStore of the spec followed by its additional stores.
+func (s *BlockHeaderFeederSpec) Stores() BlockhashStoreTargets {
+	batchBlockhashStoreAddress := s.BatchBlockhashStoreAddress
+	return append(BlockhashStoreTargets{{
+		CoordinatorV1Address:       s.CoordinatorV1Address,
+		CoordinatorV2Address:       s.CoordinatorV2Address,
+		CoordinatorV2PlusAddress:   s.CoordinatorV2PlusAddress,
+		BlockhashStoreAddress:      s.BlockhashStoreAddress,
+		BatchBlockhashStoreAddress: &batchBlockhashStoreAddress,
+	}}, s.AdditionalStores...)
+}
+
+// BlockhashStoreTarget is a BlockhashStore contract maintained by a blockhash store or block
+// header feeder job, along with the coordinators whose requests it covers.
+type BlockhashStoreTarget struct {
+	// CoordinatorV1Address is the VRF V1 coordinator to watch for unfulfilled requests. If empty,
+	// no V1 coordinator will be watched.
+	CoordinatorV1Address *evmtypes.EIP55Address `toml:"coordinatorV1Address" json:"coordinatorV1Address,omitempty"`
+
+	// CoordinatorV2Address is the VRF V2 coordinator to watch for unfulfilled requests. If empty,
+	// no V2 coordinator will be watched.
+	CoordinatorV2Address *evmtypes.EIP55Address `toml:"coordinatorV2Address" json:"coordinatorV2Address,omitempty"`
+
+	// CoordinatorV2PlusAddress is the VRF V2Plus coordinator to watch for unfulfilled requests. If empty,
+	// no V2Plus coordinator will be watched.
+	CoordinatorV2PlusAddress *evmtypes.EIP55Address `toml:"coordinatorV2PlusAddress" json:"coordinatorV2PlusAddress,omitempty"`
+
+	// BlockhashStoreAddress is the address of the BlockhashStore contract to store blockhashes
+	// into.
+	BlockhashStoreAddress evmtypes.EIP55Address `toml:"blockhashStoreAddress" json:"blockhashStoreAddress"`
+
+	// TrustedBlockhashStoreAddress is the address of the trusted BlockhashStore contract to store
+	// blockhashes into. Only used by blockhash store jobs.
+	TrustedBlockhashStoreAddress *evmtypes.EIP55Address `toml:"trustedBlockhashStoreAddress" json:"trustedBlockhashStoreAddress,omitempty"`
+
+	// BatchBlockhashStoreAddress is the address of the BatchBlockhashStore contract to store block
+	// headers into. Only used by block header feeder jobs.
+	BatchBlockhashStoreAddress *evmtypes.EIP55Address `toml:"batchBlockhashStoreAddress" json:"batchBlockhashStoreAddress,omitempty"`
+}
+
+// BlockhashStoreTargets is a list of BlockhashStoreTarget, encoded as JSON in the database.
+type BlockhashStoreTargets []BlockhashStoreTarget
+
+// Value returns this instance serialized for database storage.
+func (t BlockhashStoreTargets) Value() (driver.Value, error) {
+	if len(t) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(t)
+}
+
+// Scan reads the database value and returns an instance.
+func (t *BlockhashStoreTargets) Scan(value interface{}) error {
+	if value == nil {
+		return nil // field is nullable
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, t)
+}
+
 // LegacyGasStationServerSpec defines the job spec for the legacy gas station server.
 type LegacyGasStationServerSpec struct {
 	ID int32
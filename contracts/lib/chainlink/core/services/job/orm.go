@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -46,6 +47,7 @@ type ORM interface {
 	InsertJob(ctx context.Context, job *Job) error
 	CreateJob(ctx context.Context, jb *Job) error
 	FindJobs(ctx context.Context, offset, limit int) ([]Job, int, error)
+	FindJobsInScopes(ctx context.Context, scopes [][]JobScope, offset, limit int) ([]Job, int, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobByExternalJobID(ctx context.Context, uuid uuid.UUID) (Job, error)
 	FindJobIDByAddress(ctx context.Context, address evmtypes.EIP55Address, evmChainID *big.Big) (int32, error)
@@ -62,6 +64,7 @@ type ORM interface {
 
 	FindPipelineRunIDsByJobID(ctx context.Context, jobID int32, offset, limit int) (ids []int64, err error)
 	FindPipelineRunsByIDs(ctx context.Context, ids []int64) (runs []pipeline.Run, err error)
+	FindRecentErroredPipelineRuns(ctx context.Context, limit int) (runs []pipeline.Run, err error)
 	CountPipelineRunsByJobID(ctx context.Context, jobID int32) (count int32, err error)
 
 	FindJobsByPipelineSpecIDs(ctx context.Context, ids []int32) ([]Job, error)
@@ -378,6 +381,15 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 				return fmt.Errorf("failed to create CronSpec for jobSpec: %w", err)
 			}
 			jb.CronSpecID = &specID
+		case EVMLog:
+			if jb.EVMLogSpec.EVMChainID == nil {
+				return errors.New("evm chain id must be defined")
+			}
+			specID, err := tx.insertEVMLogSpec(ctx, jb.EVMLogSpec)
+			if err != nil {
+				return fmt.Errorf("failed to create EVMLogSpec for jobSpec: %w", err)
+			}
+			jb.EVMLogSpecID = &specID
 		case VRF:
 			if jb.VRFSpec.EVMChainID == nil {
 				return errors.New("evm chain id must be defined")
@@ -586,6 +598,12 @@ func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32,
 			RETURNING id;`, spec)
 }
 
+func (o *orm) insertEVMLogSpec(ctx context.Context, spec *EVMLogSpec) (specID int32, err error) {
+	return o.prepareQuerySpecID(ctx, `INSERT INTO evm_log_specs (contract_address, event_abi, topic_filters, min_confirmations, lookback_blocks, poll_period, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :event_abi, :topic_filters, :min_confirmations, :lookback_blocks, :poll_period, :evm_chain_id, NOW(), NOW())
+			RETURNING id;`, spec)
+}
+
 func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, err error) {
 	return o.prepareQuerySpecID(ctx, `INSERT INTO vrf_specs (
 				coordinator_address, public_key, min_incoming_confirmations,
@@ -605,14 +623,14 @@ func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, e
 }
 
 func (o *orm) insertBlockhashStoreSpec(ctx context.Context, spec *BlockhashStoreSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO blockhash_store_specs (coordinator_v1_address, coordinator_v2_address, coordinator_v2_plus_address, trusted_blockhash_store_address, trusted_blockhash_store_batch_size, wait_blocks, lookback_blocks, heartbeat_period, blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_addresses, created_at, updated_at)
-			VALUES (:coordinator_v1_address, :coordinator_v2_address, :coordinator_v2_plus_address, :trusted_blockhash_store_address, :trusted_blockhash_store_batch_size, :wait_blocks, :lookback_blocks, :heartbeat_period, :blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_addresses, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO blockhash_store_specs (coordinator_v1_address, coordinator_v2_address, coordinator_v2_plus_address, trusted_blockhash_store_address, trusted_blockhash_store_batch_size, wait_blocks, lookback_blocks, heartbeat_period, blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_addresses, additional_stores, created_at, updated_at)
+			VALUES (:coordinator_v1_address, :coordinator_v2_address, :coordinator_v2_plus_address, :trusted_blockhash_store_address, :trusted_blockhash_store_batch_size, :wait_blocks, :lookback_blocks, :heartbeat_period, :blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_addresses, :additional_stores, NOW(), NOW())
 			RETURNING id;`, toBlockhashStoreSpecRow(spec))
 }
 
 func (o *orm) insertBlockHeaderFeederSpec(ctx context.Context, spec *BlockHeaderFeederSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO block_header_feeder_specs (coordinator_v1_address, coordinator_v2_address, coordinator_v2_plus_address, wait_blocks, lookback_blocks, blockhash_store_address, batch_blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_addresses, get_blockhashes_batch_size, store_blockhashes_batch_size, created_at, updated_at)
-			VALUES (:coordinator_v1_address, :coordinator_v2_address, :coordinator_v2_plus_address, :wait_blocks, :lookback_blocks, :blockhash_store_address, :batch_blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_addresses,  :get_blockhashes_batch_size, :store_blockhashes_batch_size, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO block_header_feeder_specs (coordinator_v1_address, coordinator_v2_address, coordinator_v2_plus_address, wait_blocks, lookback_blocks, blockhash_store_address, batch_blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_addresses, get_blockhashes_batch_size, store_blockhashes_batch_size, additional_stores, created_at, updated_at)
+			VALUES (:coordinator_v1_address, :coordinator_v2_address, :coordinator_v2_plus_address, :wait_blocks, :lookback_blocks, :blockhash_store_address, :batch_blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_addresses,  :get_blockhashes_batch_size, :store_blockhashes_batch_size, :additional_stores, NOW(), NOW())
 			RETURNING id;`, toBlockHeaderFeederSpecRow(spec))
 }
 
@@ -735,18 +753,18 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, evm_log_spec_id, tags, external_job_id, gas_limit, forwarding_allowed, expected_run_interval, max_time_between_successful_runs, max_consecutive_errors, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :evm_log_spec_id, :tags, :external_job_id, :gas_limit, :forwarding_allowed, :expected_run_interval, :max_time_between_successful_runs, :max_consecutive_errors, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, evm_log_spec_id, tags, external_job_id, gas_limit, forwarding_allowed, expected_run_interval, max_time_between_successful_runs, max_consecutive_errors, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :evm_log_spec_id, :tags, :external_job_id, :gas_limit, :forwarding_allowed, :expected_run_interval, :max_time_between_successful_runs, :max_consecutive_errors, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -775,6 +793,7 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 		OffchainReporting2:   `DELETE FROM ocr2_oracle_specs WHERE id IN (SELECT ocr2_oracle_spec_id FROM deleted_jobs)`,
 		Keeper:               `DELETE FROM keeper_specs WHERE id IN (SELECT keeper_spec_id FROM deleted_jobs)`,
 		Cron:                 `DELETE FROM cron_specs WHERE id IN (SELECT cron_spec_id FROM deleted_jobs)`,
+		EVMLog:               `DELETE FROM evm_log_specs WHERE id IN (SELECT evm_log_spec_id FROM deleted_jobs)`,
 		VRF:                  `DELETE FROM vrf_specs WHERE id IN (SELECT vrf_spec_id FROM deleted_jobs)`,
 		Webhook:              `DELETE FROM webhook_specs WHERE id IN (SELECT webhook_spec_id FROM deleted_jobs)`,
 		BlockhashStore:       `DELETE FROM blockhash_store_specs WHERE id IN (SELECT blockhash_store_spec_id FROM deleted_jobs)`,
@@ -814,6 +833,7 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 				workflow_spec_id,
 				standard_capabilities_spec_id,
 				ccip_spec_id,
+				evm_log_spec_id,
 				stream_id
 		),`
 	if len(q) > 0 {
@@ -914,6 +934,77 @@ func (o *orm) FindJobs(ctx context.Context, offset, limit int) (jobs []Job, coun
 	return jobs, count, err
 }
 
+// JobScope matches the jobs with all of its restrictions which are set, like a job scoped permission grant.
+type JobScope struct {
+	IDs   []int32
+	Types []string
+	// Tags matches the jobs with at least one of these tags.
+	Tags []string
+}
+
+// FindJobsInScopes returns the page of the jobs matching at least one scope of each of the sets of scopes, and
+// their count. An empty set matches no job.
+func (o *orm) FindJobsInScopes(ctx context.Context, scopes [][]JobScope, offset, limit int) (jobs []Job, count int, err error) {
+	where, args := jobScopesCondition(scopes)
+	err = o.transact(ctx, false, func(tx *orm) error {
+		sql := `SELECT count(*) FROM jobs WHERE ` + where + `;`
+		err = tx.ds.QueryRowxContext(ctx, sql, args...).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("failed to query jobs count: %w", err)
+		}
+
+		sql = fmt.Sprintf(`SELECT jobs.*, job_pipeline_specs.pipeline_spec_id as pipeline_spec_id
+			FROM jobs
+			    JOIN job_pipeline_specs ON (jobs.id = job_pipeline_specs.job_id)
+			WHERE %s
+			ORDER BY jobs.created_at DESC, jobs.id DESC OFFSET $%d LIMIT $%d;`, where, len(args)+1, len(args)+2)
+		err = tx.ds.SelectContext(ctx, &jobs, sql, append(args, offset, limit)...)
+		if err != nil {
+			return fmt.Errorf("failed to select jobs: %w", err)
+		}
+
+		err = tx.loadAllJobsTypes(ctx, jobs)
+		if err != nil {
+			return fmt.Errorf("failed to load job types: %w", err)
+		}
+
+		return nil
+	})
+	return jobs, count, err
+}
+
+// jobScopesCondition returns the SQL condition on the jobs table matching scopes, and its arguments.
+func jobScopesCondition(scopes [][]JobScope) (string, []any) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	conds := []string{"TRUE"}
+	for _, set := range scopes {
+		alts := []string{"FALSE"}
+		for _, s := range set {
+			cond := []string{"TRUE"}
+			if len(s.IDs) > 0 {
+				ids := make(pq.Int64Array, len(s.IDs))
+				for i, id := range s.IDs {
+					ids[i] = int64(id)
+				}
+				cond = append(cond, "jobs.id = ANY("+arg(ids)+")")
+			}
+			if len(s.Types) > 0 {
+				cond = append(cond, "jobs.type = ANY("+arg(pq.StringArray(s.Types))+")")
+			}
+			if len(s.Tags) > 0 {
+				cond = append(cond, "jobs.tags && "+arg(pq.StringArray(s.Tags)))
+			}
+			alts = append(alts, "("+strings.Join(cond, " AND ")+")")
+		}
+		conds = append(conds, "("+strings.Join(alts, " OR ")+")")
+	}
+	return strings.Join(conds, " AND "), args
+}
+
 func LoadDefaultVRFPollPeriod(vrfs VRFSpec) *VRFSpec {
 	if vrfs.PollPeriod == 0 {
 		vrfs.PollPeriod = 5 * time.Second
@@ -1348,6 +1439,27 @@ func (o *orm) FindPipelineRunsByIDs(ctx context.Context, ids []int64) (runs []pi
 	return runs, errors.Wrap(err, "FindPipelineRunsByIDs failed")
 }
 
+// erroredRunsSearchWindow is how many of the most recent pipeline runs are searched for errored runs, so that large
+// tables are not scanned when there are few errored runs.
+const erroredRunsSearchWindow = 10_000
+
+// FindRecentErroredPipelineRuns returns up to limit of the most recent errored pipeline runs, most recent first.
+func (o *orm) FindRecentErroredPipelineRuns(ctx context.Context, limit int) (runs []pipeline.Run, err error) {
+	err = o.transact(ctx, false, func(tx *orm) error {
+		var ids []int64
+		stmt := `SELECT id FROM pipeline_runs
+			WHERE state = $1 AND id > (SELECT COALESCE(MAX(id), 0) FROM pipeline_runs) - $2
+			ORDER BY id DESC LIMIT $3`
+		if err = tx.ds.SelectContext(ctx, &ids, stmt, pipeline.RunStatusErrored, erroredRunsSearchWindow, limit); err != nil {
+			return errors.Wrap(err, "error loading errored run ids")
+		}
+		runs, err = tx.loadPipelineRunsByID(ctx, ids)
+		return err
+	})
+
+	return runs, errors.Wrap(err, "FindRecentErroredPipelineRuns failed")
+}
+
 // FindPipelineRunByID returns pipeline run with the id.
 func (o *orm) FindPipelineRunByID(ctx context.Context, id int64) (pipeline.Run, error) {
 	var run pipeline.Run
@@ -1506,6 +1618,7 @@ func (o *orm) loadAllJobTypes(ctx context.Context, job *Job) error {
 		o.loadJobType(ctx, job, "OCR2OracleSpec", "ocr2_oracle_specs", job.OCR2OracleSpecID),
 		o.loadJobType(ctx, job, "KeeperSpec", "keeper_specs", job.KeeperSpecID),
 		o.loadJobType(ctx, job, "CronSpec", "cron_specs", job.CronSpecID),
+		o.loadJobType(ctx, job, "EVMLogSpec", "evm_log_specs", job.EVMLogSpecID),
 		o.loadJobType(ctx, job, "WebhookSpec", "webhook_specs", job.WebhookSpecID),
 		o.loadVRFJob(ctx, job, job.VRFSpecID),
 		o.loadBlockhashStoreJob(ctx, job, job.BlockhashStoreSpecID),
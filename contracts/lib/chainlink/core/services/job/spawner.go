@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 type (
@@ -197,6 +198,7 @@ func (js *spawner) stopService(jobID int32) {
 	}
 
 	delete(js.activeJobs, jobID)
+	pipeline.ForgetJobMetrics(jobID)
 }
 
 func (js *spawner) StartService(ctx context.Context, jb Job) error {
@@ -217,6 +219,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
 	jb.PipelineSpec.JobID = jb.ID
 	jb.PipelineSpec.JobType = string(jb.Type)
+	jb.PipelineSpec.ExternalJobID = jb.ExternalJobID
 	jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed
 	if jb.GasLimit.Valid {
 		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
@@ -230,6 +233,9 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		js.activeJobs[jb.ID] = aj
 		return fmt.Errorf("failed to create services for job: %d: %w", jb.ID, err)
 	}
+	if jb.HasLivenessChecks() {
+		srvs = append(srvs, newLivenessCheck(jb, js.lggr))
+	}
 
 	var ms services.MultiStart
 	for _, srv := range srvs {
@@ -247,6 +253,9 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		aj.services = append(aj.services, srv)
 	}
 	js.activeJobs[jb.ID] = aj
+	if !jb.ExpectedRunInterval.IsZero() || jb.HasLivenessChecks() {
+		pipeline.ObserveJobStarted(*jb.PipelineSpec)
+	}
 	return nil
 }
 
@@ -343,6 +352,9 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 		// Stop the service and remove the job from memory, which will always happen even if closing the services fail.
 		js.stopService(jobID)
 	}
+	if err == nil {
+		pipeline.DeleteJobMetrics(jobID)
+	}
 	lggr.Infow("Stopped and deleted job")
 
 	return err
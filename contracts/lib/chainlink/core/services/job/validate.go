@@ -17,6 +17,7 @@ var (
 		Bootstrap:               {},
 		Cron:                    {},
 		DirectRequest:           {},
+		EVMLog:                  {},
 		FluxMonitor:             {},
 		Gateway:                 {},
 		Keeper:                  {},
@@ -33,6 +34,12 @@ var (
 	}
 )
 
+// IsValid returns true if t is a known job type.
+func (t Type) IsValid() bool {
+	_, ok := jobTypes[t]
+	return ok
+}
+
 // ValidateSpec is the common spec validation
 func ValidateSpec(ts string) (Type, error) {
 	var jb Job
@@ -67,6 +74,12 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
+	if jb.ExpectedRunInterval < 0 {
+		return "", errors.New("expectedRunInterval must not be negative")
+	}
+	if jb.MaxTimeBetweenSuccessfulRuns < 0 {
+		return "", errors.New("maxTimeBetweenSuccessfulRuns must not be negative")
+	}
 	// spec.CustomRevertsPipelineEnabled == false, default is custom reverted txns pipeline disabled
 
 	if strings.Contains(ts, "<{}>") {
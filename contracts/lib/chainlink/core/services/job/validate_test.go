@@ -90,11 +90,42 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "negative expected run interval",
+			spec: `
+type="vrf"
+schemaVersion=1
+expectedRunInterval="-1m"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "expectedRunInterval must not be negative")
+			},
+		},
+		{
+			name: "negative max time between successful runs",
+			spec: `
+type="vrf"
+schemaVersion=1
+maxTimeBetweenSuccessfulRuns="-1m"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "maxTimeBetweenSuccessfulRuns must not be negative")
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
 type="vrf"
 schemaVersion=1
+expectedRunInterval="1m"
+maxTimeBetweenSuccessfulRuns="5m"
+maxConsecutiveErrors=3
 observationSource="""
 ds [type=http]
 """
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/keeper_registry_wrapper1_1"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/keeper_registry_wrapper1_2"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/keeper_registry_wrapper1_3"
@@ -11,6 +13,20 @@ var Registry1_1ABI = types.MustGetABI(keeper_registry_wrapper1_1.KeeperRegistryA
 var Registry1_2ABI = types.MustGetABI(keeper_registry_wrapper1_2.KeeperRegistryABI)
 var Registry1_3ABI = types.MustGetABI(keeper_registry_wrapper1_3.KeeperRegistryABI)
 
+// registryABI returns the ABI of the given version of the keeper registry
+func registryABI(version RegistryVersion) (abi.ABI, error) {
+	switch version {
+	case RegistryVersion_1_0, RegistryVersion_1_1:
+		return Registry1_1ABI, nil
+	case RegistryVersion_1_2:
+		return Registry1_2ABI, nil
+	case RegistryVersion_1_3:
+		return Registry1_3ABI, nil
+	default:
+		return abi.ABI{}, newUnsupportedVersionError("registryABI", version)
+	}
+}
+
 type RegistryGasChecker interface {
 	CheckGasOverhead() uint32
 	PerformGasOverhead() uint32
@@ -5,6 +5,7 @@ import (
 	stderrors "errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -95,17 +96,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		minIncomingConfirmations = *spec.KeeperSpec.MinIncomingConfirmations
 	}
 
-	// effectiveKeeperAddress is the keeper address registered on the registry. This is by default the EOA account on the node.
-	// In the case of forwarding, the keeper address is the forwarder contract deployed onchain between EOA and Registry.
-	effectiveKeeperAddress := spec.KeeperSpec.FromAddress.Address()
-	if spec.ForwardingAllowed {
-		fwdrAddress, fwderr := chain.TxManager().GetForwarderForEOA(ctx, spec.KeeperSpec.FromAddress.Address())
-		if fwderr == nil {
-			effectiveKeeperAddress = fwdrAddress
-		} else {
-			svcLogger.Warnw("Skipping forwarding for job, will fallback to default behavior", "job", spec.Name, "err", fwderr)
-		}
-	}
+	effectiveKeeperAddress := EffectiveKeeperAddress(ctx, chain, spec, svcLogger)
 
 	keeper := d.cfg.Keeper()
 	registry := keeper.Registry()
@@ -139,3 +130,18 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		upkeepExecuter,
 	}, nil
 }
+
+// EffectiveKeeperAddress returns the keeper address registered on the registry. This is by default the EOA account on the node.
+// In the case of forwarding, the keeper address is the forwarder contract deployed onchain between EOA and Registry.
+func EffectiveKeeperAddress(ctx context.Context, chain legacyevm.Chain, spec job.Job, lggr logger.Logger) common.Address {
+	effectiveKeeperAddress := spec.KeeperSpec.FromAddress.Address()
+	if spec.ForwardingAllowed {
+		fwdrAddress, fwderr := chain.TxManager().GetForwarderForEOA(ctx, spec.KeeperSpec.FromAddress.Address())
+		if fwderr == nil {
+			effectiveKeeperAddress = fwdrAddress
+		} else {
+			lggr.Warnw("Skipping forwarding for job, will fallback to default behavior", "job", spec.Name, "err", fwderr)
+		}
+	}
+	return effectiveKeeperAddress
+}
@@ -240,3 +240,83 @@ func (o *ORM) SetLastRunInfoForUpkeepOnJob(ctx context.Context, jobID int32, upk
 	}
 	return rowsAffected, nil
 }
+
+// UpkeepsForJob returns all upkeeps tracked by the registry of the job with the given ID
+func (o *ORM) UpkeepsForJob(ctx context.Context, jobID int32) (upkeeps []UpkeepRegistration, err error) {
+	err = o.ds.SelectContext(ctx, &upkeeps, `
+SELECT upkeep_registrations.*
+FROM upkeep_registrations
+  INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
+WHERE keeper_registries.job_id = $1
+ORDER BY upkeep_registrations.upkeep_id ASC
+`, jobID)
+	if err != nil {
+		return upkeeps, errors.Wrap(err, "UpkeepsForJob failed to get upkeep_registrations")
+	}
+	if err = o.loadUpkeepsRegistry(ctx, upkeeps); err != nil {
+		return upkeeps, errors.Wrap(err, "UpkeepsForJob failed to load Registry on upkeeps")
+	}
+	return upkeeps, nil
+}
+
+// UpkeepForJob returns a single upkeep tracked by the registry of the job with the given ID
+func (o *ORM) UpkeepForJob(ctx context.Context, jobID int32, upkeepID *big.Big) (UpkeepRegistration, error) {
+	var upkeep UpkeepRegistration
+	err := o.ds.GetContext(ctx, &upkeep, `
+SELECT upkeep_registrations.*
+FROM upkeep_registrations
+  INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
+WHERE keeper_registries.job_id = $1 AND upkeep_registrations.upkeep_id = $2
+`, jobID, upkeepID)
+	if err != nil {
+		return upkeep, errors.Wrapf(err, "failed to get upkeep %s for job_id %d", upkeepID, jobID)
+	}
+	upkeeps := []UpkeepRegistration{upkeep}
+	if err = o.loadUpkeepsRegistry(ctx, upkeeps); err != nil {
+		return upkeep, errors.Wrap(err, "UpkeepForJob failed to load Registry on upkeep")
+	}
+	return upkeeps[0], nil
+}
+
+// UpsertUpkeepChecks records the latest check results of the given upkeeps in a single statement, replacing any
+// previous ones
+func (o *ORM) UpsertUpkeepChecks(ctx context.Context, checks []UpkeepCheck) error {
+	if len(checks) == 0 {
+		return nil
+	}
+	stmt := `
+INSERT INTO keeper_upkeep_checks (upkeep_registration_id, block_number, performable, perform_data, gas_limit, error, checked_at) VALUES (
+:upkeep_registration_id, :block_number, :performable, :perform_data, :gas_limit, :error, :checked_at
+) ON CONFLICT (upkeep_registration_id) DO UPDATE SET
+	block_number = EXCLUDED.block_number,
+	performable = EXCLUDED.performable,
+	perform_data = EXCLUDED.perform_data,
+	gas_limit = EXCLUDED.gas_limit,
+	error = EXCLUDED.error,
+	checked_at = EXCLUDED.checked_at
+`
+	_, err := o.ds.NamedExecContext(ctx, stmt, checks)
+	return errors.Wrap(err, "failed to upsert upkeep checks")
+}
+
+// LastUpkeepChecksForJob returns the latest recorded check of every upkeep of the job with the given ID,
+// keyed by upkeep registration ID
+func (o *ORM) LastUpkeepChecksForJob(ctx context.Context, jobID int32) (map[int32]UpkeepCheck, error) {
+	var checks []UpkeepCheck
+	err := o.ds.SelectContext(ctx, &checks, `
+SELECT keeper_upkeep_checks.upkeep_registration_id, keeper_upkeep_checks.block_number, keeper_upkeep_checks.performable,
+	keeper_upkeep_checks.perform_data, keeper_upkeep_checks.gas_limit, keeper_upkeep_checks.error, keeper_upkeep_checks.checked_at
+FROM keeper_upkeep_checks
+  INNER JOIN upkeep_registrations ON upkeep_registrations.id = keeper_upkeep_checks.upkeep_registration_id
+  INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
+WHERE keeper_registries.job_id = $1
+`, jobID)
+	if err != nil {
+		return nil, errors.Wrap(err, "LastUpkeepChecksForJob failed")
+	}
+	checksByID := make(map[int32]UpkeepCheck, len(checks))
+	for _, check := range checks {
+		checksByID[check.UpkeepRegistrationID] = check
+	}
+	return checksByID, nil
+}
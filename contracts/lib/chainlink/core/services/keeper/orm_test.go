@@ -11,6 +11,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 	evmutils "github.com/smartcontractkit/chainlink-evm/pkg/utils"
@@ -440,6 +441,39 @@ func TestKeeperDB_NewSetLastRunInfoForUpkeepOnJob(t *testing.T) {
 	assertLastRunHeight(t, db, upkeep, 101, 0)
 }
 
+func TestKeeperDB_UpsertUpkeepChecks(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db, _, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	upkeep1 := cltest.MustInsertUpkeepForRegistry(t, db, registry)
+	upkeep2 := cltest.MustInsertUpkeepForRegistry(t, db, registry)
+
+	require.NoError(t, orm.UpsertUpkeepChecks(ctx, nil))
+	require.NoError(t, orm.UpsertUpkeepChecks(ctx, []keeper.UpkeepCheck{
+		{UpkeepRegistrationID: upkeep1.ID, BlockNumber: 10, Performable: true, PerformData: checkData, GasLimit: null.IntFrom(1000), CheckedAt: time.Now()},
+		{UpkeepRegistrationID: upkeep2.ID, BlockNumber: 10, Error: null.StringFrom("boom"), CheckedAt: time.Now()},
+	}))
+	cltest.AssertCount(t, db, "keeper_upkeep_checks", 2)
+
+	// replaces the previous check of the upkeep
+	require.NoError(t, orm.UpsertUpkeepChecks(ctx, []keeper.UpkeepCheck{
+		{UpkeepRegistrationID: upkeep2.ID, BlockNumber: 11, Performable: true, CheckedAt: time.Now()},
+	}))
+	cltest.AssertCount(t, db, "keeper_upkeep_checks", 2)
+
+	checks, err := orm.LastUpkeepChecksForJob(ctx, j.ID)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, int64(10), checks[upkeep1.ID].BlockNumber)
+	assert.Equal(t, null.IntFrom(1000), checks[upkeep1.ID].GasLimit)
+	assert.Equal(t, int64(11), checks[upkeep2.ID].BlockNumber)
+	assert.True(t, checks[upkeep2.ID].Performable)
+	assert.False(t, checks[upkeep2.ID].Error.Valid)
+}
+
 func TestKeeperDB_LeastSignificant(t *testing.T) {
 	t.Parallel()
 	db, _, _ := setupKeeperDB(t)
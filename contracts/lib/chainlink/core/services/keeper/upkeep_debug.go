@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// UpkeepCheck is the outcome of a single checkUpkeep evaluation of an upkeep.
+// The UpkeepExecuter keeps the latest one of every upkeep in memory and writes them
+// to the database periodically in a batch, and UpkeepDebugger
+// produces them on demand when simulating a check.
+type UpkeepCheck struct {
+	UpkeepRegistrationID int32
+	BlockNumber          int64
+	Performable          bool
+	PerformData          []byte
+	GasLimit             null.Int
+	Error                null.String
+	CheckedAt            time.Time
+
+	// The fields below are only populated for simulated checks
+	GasEstimate         null.Int `db:"-"`
+	TurnBlockHashBinary string   `db:"-"`
+	Eligible            bool     `db:"-"`
+	EligibilityReason   string   `db:"-"`
+}
+
+// UpkeepStatus combines an upkeep registration with its last recorded check
+// and its turn taking eligibility at the given block.
+type UpkeepStatus struct {
+	Upkeep            UpkeepRegistration
+	LastCheck         *UpkeepCheck
+	BlockNumber       int64
+	Eligible          bool
+	EligibilityReason string
+}
+
+// newUpkeepCheckFromRun extracts the check result from a completed keeper pipeline run
+func newUpkeepCheckFromRun(upkeep UpkeepRegistration, blockNumber int64, run *pipeline.Run) UpkeepCheck {
+	check := UpkeepCheck{
+		UpkeepRegistrationID: upkeep.ID,
+		BlockNumber:          blockNumber,
+		CheckedAt:            time.Now(),
+	}
+	if tr := run.ByDotID("decode_check_upkeep_tx"); tr != nil && tr.Output.Valid {
+		if out, ok := tr.Output.Val.(map[string]interface{}); ok {
+			if performData, ok := out["performData"].([]byte); ok {
+				check.PerformData = performData
+			}
+			if gasLimit, ok := out["gasLimit"].(*big.Int); ok && gasLimit.IsInt64() {
+				check.GasLimit = null.IntFrom(gasLimit.Int64())
+			}
+		}
+	}
+	if tr := run.ByDotID("check_success"); tr != nil && !tr.Error.Valid {
+		check.Performable = true
+	}
+	for _, err := range run.AllErrors {
+		if err.Valid && err.String != "" {
+			check.Error = err
+			break
+		}
+	}
+	return check
+}
+
+// upkeepTurnEligibility mirrors the turn taking conditions of ORM.EligibleUpkeepsForRegistry for a single upkeep
+func upkeepTurnEligibility(upkeep UpkeepRegistration, blockNumber int64, gracePeriod int64, turnHash common.Hash) (bool, string) {
+	registry := upkeep.Registry
+	if registry.NumKeepers <= 0 {
+		return false, "registry has no keepers"
+	}
+	turn := int64(LeastSignificant32(upkeep.UpkeepID.ToInt()) ^ LeastSignificant32(turnHash.Big()))
+	turnIndex := int32(turn % int64(registry.NumKeepers))
+	lastIsMe := upkeep.LastKeeperIndex.Valid && upkeep.LastKeeperIndex.Int64 == int64(registry.KeeperIndex)
+
+	if registry.KeeperIndex == turnIndex {
+		if !lastIsMe {
+			return true, "my turn"
+		}
+		if upkeep.LastRunBlockHeight+gracePeriod < blockNumber {
+			return true, "my turn, last performed by me but grace period has passed"
+		}
+		return false, "my turn, but last performed by me within the grace period"
+	}
+
+	buddyIndex := (registry.KeeperIndex + 1) % registry.NumKeepers
+	if registry.NumKeepers > 1 && buddyIndex == turnIndex {
+		if upkeep.LastKeeperIndex.Valid && upkeep.LastKeeperIndex.Int64 == int64(buddyIndex) {
+			return true, "buddy's turn and buddy performed last"
+		}
+		return false, "buddy's turn"
+	}
+	return false, fmt.Sprintf("keeper %d's turn", turnIndex)
+}
+
+// UpkeepDebugger gives operators visibility into the upkeeps of a single keeper job
+// and runs one-off simulated checks without broadcasting any transaction.
+type UpkeepDebugger struct {
+	job                    job.Job
+	orm                    *ORM
+	ethClient              evmclient.Client
+	config                 UpkeepExecuterConfig
+	effectiveKeeperAddress common.Address
+}
+
+// NewUpkeepDebugger is the constructor of UpkeepDebugger
+func NewUpkeepDebugger(
+	jb job.Job,
+	orm *ORM,
+	ethClient evmclient.Client,
+	config UpkeepExecuterConfig,
+	effectiveKeeperAddress common.Address,
+) (*UpkeepDebugger, error) {
+	if jb.KeeperSpec == nil {
+		return nil, errors.Errorf("job %d is not a keeper job", jb.ID)
+	}
+	return &UpkeepDebugger{
+		job:                    jb,
+		orm:                    orm,
+		ethClient:              ethClient,
+		config:                 config,
+		effectiveKeeperAddress: effectiveKeeperAddress,
+	}, nil
+}
+
+// Upkeeps returns every upkeep of the job along with its last recorded check
+// and its eligibility at the latest head.
+func (d *UpkeepDebugger) Upkeeps(ctx context.Context) ([]UpkeepStatus, error) {
+	upkeeps, err := d.orm.UpkeepsForJob(ctx, d.job.ID)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := d.orm.LastUpkeepChecksForJob(ctx, d.job.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(upkeeps) == 0 {
+		return nil, nil
+	}
+
+	blockNumber, err := d.latestBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	turnHash, err := turnBlockHash(ctx, d.ethClient, upkeeps[0].Registry, blockNumber, d.config.TurnLookBack())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get turn block number hash")
+	}
+
+	statuses := make([]UpkeepStatus, len(upkeeps))
+	for i, upkeep := range upkeeps {
+		statuses[i] = UpkeepStatus{
+			Upkeep:      upkeep,
+			BlockNumber: blockNumber,
+		}
+		if check, ok := checks[upkeep.ID]; ok {
+			statuses[i].LastCheck = &check
+		}
+		statuses[i].Eligible, statuses[i].EligibilityReason = upkeepTurnEligibility(upkeep, blockNumber, d.config.MaxGracePeriod(), turnHash)
+	}
+	return statuses, nil
+}
+
+// SimulateCheck runs checkUpkeep for the given upkeep at the given block, or at the latest
+// head if blockNumber is not positive. The perform gas estimate is always taken against the
+// latest state. Nothing is persisted and no transaction is sent.
+func (d *UpkeepDebugger) SimulateCheck(ctx context.Context, upkeepID *ubig.Big, blockNumber int64) (UpkeepCheck, error) {
+	upkeep, err := d.orm.UpkeepForJob(ctx, d.job.ID, upkeepID)
+	if err != nil {
+		return UpkeepCheck{}, err
+	}
+	if blockNumber <= 0 {
+		blockNumber, err = d.latestBlockNumber(ctx)
+		if err != nil {
+			return UpkeepCheck{}, err
+		}
+	}
+
+	check := UpkeepCheck{
+		UpkeepRegistrationID: upkeep.ID,
+		BlockNumber:          blockNumber,
+		CheckedAt:            time.Now(),
+	}
+
+	turnHash, err := turnBlockHash(ctx, d.ethClient, upkeep.Registry, blockNumber, d.config.TurnLookBack())
+	if err != nil {
+		return check, errors.Wrap(err, "unable to get turn block number hash")
+	}
+	check.TurnBlockHashBinary = fmt.Sprintf("%b", turnHash.Big())
+	check.Eligible, check.EligibilityReason = upkeepTurnEligibility(upkeep, blockNumber, d.config.MaxGracePeriod(), turnHash)
+
+	registry, err := NewRegistryWrapper(upkeep.Registry.ContractAddress, d.ethClient)
+	if err != nil {
+		return check, errors.Wrap(err, "unable to create keeper registry wrapper")
+	}
+	registryABI, err := registryABI(registry.Version)
+	if err != nil {
+		return check, err
+	}
+
+	contractAddress := upkeep.Registry.ContractAddress.Address()
+	checkData, err := registryABI.Pack("checkUpkeep", upkeepID.ToInt(), d.effectiveKeeperAddress)
+	if err != nil {
+		return check, errors.Wrap(err, "unable to encode checkUpkeep call")
+	}
+	out, err := d.ethClient.CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: checkData}, big.NewInt(blockNumber))
+	if err != nil {
+		check.Error = null.StringFrom(err.Error())
+		return check, nil
+	}
+	results, err := registryABI.Unpack("checkUpkeep", out)
+	if err != nil || len(results) < 3 {
+		check.Error = null.StringFrom(fmt.Sprintf("unable to decode checkUpkeep result: %v", err))
+		return check, nil
+	}
+	performData, ok := results[0].([]byte)
+	if !ok {
+		check.Error = null.StringFrom(fmt.Sprintf("unexpected performData type %T", results[0]))
+		return check, nil
+	}
+	check.PerformData = performData
+	if gasLimit, ok := results[2].(*big.Int); ok && gasLimit.IsInt64() {
+		check.GasLimit = null.IntFrom(gasLimit.Int64())
+	}
+	if uint32(len(performData)) >= d.config.Registry().MaxPerformDataSize() {
+		check.Error = null.StringFrom(fmt.Sprintf("perform data size %d exceeds limit %d", len(performData), d.config.Registry().MaxPerformDataSize()))
+		return check, nil
+	}
+
+	performCallData, err := registryABI.Pack("performUpkeep", upkeepID.ToInt(), performData)
+	if err != nil {
+		return check, errors.Wrap(err, "unable to encode performUpkeep call")
+	}
+	gasEstimate, err := d.ethClient.EstimateGas(ctx, ethereum.CallMsg{From: d.effectiveKeeperAddress, To: &contractAddress, Data: performCallData})
+	if err != nil {
+		check.Error = null.StringFrom(errors.Wrap(err, "performUpkeep simulation failed").Error())
+		return check, nil
+	}
+	check.GasEstimate = null.IntFrom(int64(gasEstimate))
+	check.Performable = true
+	return check, nil
+}
+
+func (d *UpkeepDebugger) latestBlockNumber(ctx context.Context) (int64, error) {
+	head, err := d.ethClient.HeadByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get latest head")
+	}
+	if head == nil {
+		return 0, errors.New("no latest head available")
+	}
+	return head.Number, nil
+}
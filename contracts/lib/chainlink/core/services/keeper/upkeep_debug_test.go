@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gonull "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/null"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestUpkeepTurnEligibility(t *testing.T) {
+	// upkeep ID 1 XOR hash 0 => turn 1
+	turnHash := common.Hash{}
+	newUpkeep := func(keeperIndex, numKeepers int32, lastKeeperIndex null.Int64, lastRun int64) UpkeepRegistration {
+		return UpkeepRegistration{
+			UpkeepID:           big.NewI(1),
+			LastKeeperIndex:    lastKeeperIndex,
+			LastRunBlockHeight: lastRun,
+			Registry: Registry{
+				KeeperIndex: keeperIndex,
+				NumKeepers:  numKeepers,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		upkeep   UpkeepRegistration
+		eligible bool
+	}{
+		{"no keepers", newUpkeep(0, 0, null.Int64{}, 0), false},
+		{"my turn, never performed", newUpkeep(1, 3, null.Int64{}, 0), true},
+		{"my turn, last performed by other", newUpkeep(1, 3, null.Int64From(2), 0), true},
+		{"my turn, last performed by me within grace period", newUpkeep(1, 3, null.Int64From(1), 95), false},
+		{"my turn, last performed by me past grace period", newUpkeep(1, 3, null.Int64From(1), 50), true},
+		{"buddy's turn, buddy performed last", newUpkeep(0, 3, null.Int64From(1), 0), true},
+		{"buddy's turn, someone else performed last", newUpkeep(0, 3, null.Int64From(2), 0), false},
+		{"someone else's turn", newUpkeep(2, 3, null.Int64{}, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eligible, reason := upkeepTurnEligibility(tt.upkeep, 100, 10, turnHash)
+			assert.Equal(t, tt.eligible, eligible)
+			assert.NotEmpty(t, reason)
+		})
+	}
+}
+
+func TestNewUpkeepCheckFromRun(t *testing.T) {
+	upkeep := UpkeepRegistration{ID: 7, UpkeepID: big.NewI(1)}
+
+	t.Run("performable", func(t *testing.T) {
+		run := &pipeline.Run{
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{
+					DotID: "decode_check_upkeep_tx",
+					Output: jsonserializable.JSONSerializable{Valid: true, Val: map[string]interface{}{
+						"performData": []byte{0x1, 0x2},
+						"gasLimit":    big.NewI(21000).ToInt(),
+					}},
+				},
+				{DotID: "check_success"},
+			},
+		}
+		check := newUpkeepCheckFromRun(upkeep, 42, run)
+		require.Equal(t, int32(7), check.UpkeepRegistrationID)
+		require.Equal(t, int64(42), check.BlockNumber)
+		require.True(t, check.Performable)
+		require.Equal(t, []byte{0x1, 0x2}, check.PerformData)
+		require.Equal(t, gonull.IntFrom(21000), check.GasLimit)
+		require.False(t, check.Error.Valid)
+	})
+
+	t.Run("check reverted", func(t *testing.T) {
+		run := &pipeline.Run{
+			AllErrors: pipeline.RunErrors{gonull.String{}, gonull.StringFrom("execution reverted")},
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{DotID: "check_upkeep_tx", Error: gonull.StringFrom("execution reverted")},
+			},
+		}
+		check := newUpkeepCheckFromRun(upkeep, 42, run)
+		require.False(t, check.Performable)
+		require.Nil(t, check.PerformData)
+		require.Equal(t, "execution reverted", check.Error.String)
+	})
+}
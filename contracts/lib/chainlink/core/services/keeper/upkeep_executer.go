@@ -30,6 +30,9 @@ import (
 const (
 	executionQueueSize  = 10
 	maxUpkeepPerformGas = 5_000_000 // Max perform gas for upkeep is 5M on all chains for v1.x
+	// upkeepChecksFlushInterval is how often the latest upkeep checks kept in memory are written to the database
+	upkeepChecksFlushInterval = 30 * time.Second
+	upkeepChecksFlushTimeout  = 10 * time.Second
 )
 
 // UpkeepExecuter fulfills Service and HeadTrackable interfaces
@@ -69,6 +72,11 @@ type UpkeepExecuter struct {
 	logger                 logger.Logger
 	wgDone                 sync.WaitGroup
 	effectiveKeeperAddress common.Address
+
+	// checks holds the latest check of every upkeep since the last flush, to avoid a write per upkeep per head
+	checksMu        sync.Mutex
+	checks          map[int32]UpkeepCheck
+	checksFlushedAt time.Time
 }
 
 // NewUpkeepExecuter is the constructor of UpkeepExecuter
@@ -96,6 +104,8 @@ func NewUpkeepExecuter(
 		pr:                     pr,
 		effectiveKeeperAddress: effectiveKeeperAddress,
 		logger:                 logger.Named("UpkeepExecuter"),
+		checks:                 make(map[int32]UpkeepCheck),
+		checksFlushedAt:        time.Now(),
 	}
 }
 
@@ -122,6 +132,10 @@ func (ex *UpkeepExecuter) Close() error {
 	return ex.StopOnce("UpkeepExecuter", func() error {
 		close(ex.chStop)
 		ex.wgDone.Wait()
+
+		ctx, cancel := context.WithTimeout(context.Background(), upkeepChecksFlushTimeout)
+		defer cancel()
+		ex.flushUpkeepChecks(ctx)
 		return nil
 	})
 }
@@ -201,6 +215,34 @@ func (ex *UpkeepExecuter) processActiveUpkeeps(ctx context.Context) {
 
 	wg.Wait()
 	ex.logger.Debugw("Finished checking upkeeps", "blockNum", head.Number)
+
+	if time.Since(ex.checksFlushedAt) >= upkeepChecksFlushInterval {
+		ex.flushUpkeepChecks(ctx)
+	}
+}
+
+// recordUpkeepCheck keeps the check in memory until the next flush, replacing any previous check of the same upkeep
+func (ex *UpkeepExecuter) recordUpkeepCheck(check UpkeepCheck) {
+	ex.checksMu.Lock()
+	defer ex.checksMu.Unlock()
+	ex.checks[check.UpkeepRegistrationID] = check
+}
+
+// flushUpkeepChecks writes the checks recorded since the last flush to the database in a single batch. The checks
+// are only kept for debugging, so a failed batch is dropped rather than retried.
+func (ex *UpkeepExecuter) flushUpkeepChecks(ctx context.Context) {
+	ex.checksMu.Lock()
+	checks := make([]UpkeepCheck, 0, len(ex.checks))
+	for _, check := range ex.checks {
+		checks = append(checks, check)
+	}
+	ex.checks = make(map[int32]UpkeepCheck)
+	ex.checksFlushedAt = time.Now()
+	ex.checksMu.Unlock()
+
+	if err := ex.orm.UpsertUpkeepChecks(ctx, checks); err != nil {
+		ex.logger.Errorw("Failed to record upkeep checks", "err", err, "count", len(checks))
+	}
 }
 
 // execute triggers the pipeline run
@@ -233,6 +275,8 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 		return
 	}
 
+	ex.recordUpkeepCheck(newUpkeepCheckFromRun(upkeep, head.Number, run))
+
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
 		rowsAffected, err := ex.orm.SetLastRunInfoForUpkeepOnJob(ctxService, ex.job.ID, upkeep.UpkeepID, head.Number, upkeep.Registry.FromAddress)
@@ -249,16 +293,24 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 }
 
 func (ex *UpkeepExecuter) turnBlockHashBinary(ctx context.Context, registry Registry, head *evmtypes.Head, lookback int64) (string, error) {
-	turnBlock := head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
-	block, err := ex.ethClient.HeadByNumber(ctx, big.NewInt(turnBlock))
+	hashAtHeight, err := turnBlockHash(ctx, ex.ethClient, registry, head.Number, lookback)
 	if err != nil {
 		return "", err
 	}
-	hashAtHeight := block.Hash
 	binaryString := fmt.Sprintf("%b", hashAtHeight.Big())
 	return binaryString, nil
 }
 
+// turnBlockHash returns the hash of the block that seeds the turn taking algorithm at the given height
+func turnBlockHash(ctx context.Context, ethClient evmclient.Client, registry Registry, blockNumber int64, lookback int64) (common.Hash, error) {
+	turnBlock := blockNumber - (blockNumber % int64(registry.BlockCountPerTurn)) - lookback
+	block, err := ethClient.HeadByNumber(ctx, big.NewInt(turnBlock))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.Hash, nil
+}
+
 func buildJobSpec(
 	jb job.Job,
 	effectiveKeeperAddress common.Address,
@@ -31,6 +31,9 @@ var _ core.Keystore = &CSASigner{}
 type CSASigner struct {
 	CSA
 	core.UnimplementedKeystore
+	// Subsystem is the caller to which signatures are attributed, unless set on the context with
+	// WithKeyUsageCaller.
+	Subsystem string
 }
 
 func (c CSASigner) Accounts(ctx context.Context) (accounts []string, err error) {
@@ -53,7 +56,14 @@ func (c CSASigner) Sign(ctx context.Context, account string, data []byte) (signe
 	if data == nil {
 		return nil, nil
 	}
-	return k.Sign(rand.Reader, data, crypto.Hash(0))
+	signed, err = k.Sign(rand.Reader, data, crypto.Hash(0))
+	if err != nil {
+		return nil, err
+	}
+	if ks, ok := c.CSA.(*csa); ok {
+		ks.recordUsage(ctx, KeyUsage{KeyType: "CSA", KeyID: account, Subsystem: c.Subsystem}, data)
+	}
+	return signed, nil
 }
 
 type csa struct {
@@ -33,6 +33,8 @@ type Eth interface {
 	Add(ctx context.Context, address common.Address, chainID *big.Int) error
 
 	EnsureKeys(ctx context.Context, chainIDs ...*big.Int) error
+	EnsureRemoteKeys(ctx context.Context, chainIDs ...*big.Int) error
+	SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error)
 
 	EnabledKeysForChain(ctx context.Context, chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -48,18 +50,36 @@ type Eth interface {
 	XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2)
 }
 
+// RemoteSigner holds EVM keys outside of the keystore, and signs on its behalf with them.
+type RemoteSigner interface {
+	// Addresses returns the addresses of all the keys held by the signer.
+	Addresses(ctx context.Context) ([]common.Address, error)
+	// SignHash signs the 32 bytes hash with the key for address, in the [R || S || V] format
+	// with V 0 or 1.
+	SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error)
+}
+
 var _ loop.Keystore = &EthSigner{}
 
 type EthSigner struct {
 	Eth
 	core.UnimplementedKeystore
 	chainID *big.Int
+	caller  *keyUsageCaller
 }
 
 func NewEthSigner(eth Eth, chainID *big.Int) *EthSigner {
 	return &EthSigner{Eth: eth, chainID: chainID}
 }
 
+// WithCaller returns a copy of e attributing its signatures to subsystem and, if not nil, to the
+// job jobID, unless another caller is set on the context with WithKeyUsageCaller.
+func (e *EthSigner) WithCaller(subsystem string, jobID *int32) *EthSigner {
+	c := *e
+	c.caller = &keyUsageCaller{subsystem: subsystem, jobID: jobID}
+	return &c
+}
+
 func (e *EthSigner) Accounts(ctx context.Context) (accounts []string, err error) {
 	as, err := e.EnabledAddressesForChain(ctx, e.chainID)
 	if err != nil {
@@ -72,15 +92,14 @@ func (e *EthSigner) Accounts(ctx context.Context) (accounts []string, err error)
 }
 
 func (e *EthSigner) Sign(ctx context.Context, account string, data []byte) (signed []byte, err error) {
-	k, err := e.Get(ctx, account)
-	if err != nil {
-		return nil, err
+	if !common.IsHexAddress(account) {
+		return nil, ErrKeyNotFound
 	}
-	// loopp spec requires passing nil hash to check existence of id
-	if data == nil {
-		return nil, nil
+	if _, ok := ctx.Value(keyUsageCallerKey{}).(keyUsageCaller); !ok && e.caller != nil {
+		ctx = context.WithValue(ctx, keyUsageCallerKey{}, *e.caller)
 	}
-	return k.Sign(data)
+	// loopp spec requires passing nil hash to check existence of id, which SignHash supports
+	return e.SignHash(ctx, common.HexToAddress(account), data)
 }
 
 type eth struct {
@@ -88,6 +107,10 @@ type eth struct {
 	keystateORM
 	ds            sqlutil.DataSource
 	resourceMutex map[common.Address]*evmkeystore.Mutex // ResourceMutex is an internal field and ought not be persisted to the database. Its main usage is to verify that the same key is not used for both TXMv1 and TXMv2 (usage in both TXMs will cause nonce drift and will lead to missing transactions). This functionality should be removed after we completely switch to TXMv2
+
+	// remote holds the keys which have a state but are not in the key ring. Their states are
+	// persisted by EnsureRemoteKeys, so that they remain usable across restarts.
+	remote RemoteSigner
 }
 
 // GetResourceMutex gets the resource mutex associates with the address if no resource mutex is found a new one is created
@@ -191,6 +214,74 @@ func (ks *eth) EnsureKeys(ctx context.Context, chainIDs ...*big.Int) (err error)
 	return nil
 }
 
+// EnsureRemoteKeys ensures that each key held by the remote signer has a state linked to each
+// chain, so that it can be used to sign transactions and is picked as a sending key. As in
+// EnsureKeys, disabled keys are not enabled again. It may be called again to register the keys
+// added to the remote signer since.
+func (ks *eth) EnsureRemoteKeys(ctx context.Context, chainIDs ...*big.Int) error {
+	if ks.remote == nil {
+		return errors.New("no remote signer configured")
+	}
+	addresses, err := ks.remote.Addresses(ctx)
+	if err != nil {
+		return err
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+
+	for _, address := range addresses {
+		if _, found := ks.keyRing.Eth[address.Hex()]; found {
+			return errors.Errorf("key %s is held by both the keystore and the remote signer", address)
+		}
+		for _, chainID := range chainIDs {
+			if _, exists := ks.keyStates.KeyIDChainID[address.String()][chainID.String()]; exists {
+				continue
+			}
+			if err = ks.addKey(ctx, nil, address, chainID); err != nil {
+				return fmt.Errorf("failed to add remote key %s for chain %s: %w", address, chainID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// SignHash signs hash with the key for address, which is either in the key ring or held by the
+// remote signer. A nil hash only checks that the key exists.
+func (ks *eth) SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error) {
+	ks.lock.RLock()
+	if ks.isLocked() {
+		ks.lock.RUnlock()
+		return nil, ErrLocked
+	}
+	key, found := ks.keyRing.Eth[address.Hex()]
+	remote := ks.isRemote(address.Hex())
+	ks.lock.RUnlock()
+
+	if !found && !remote {
+		return nil, ErrKeyNotFound
+	}
+	if hash == nil {
+		return nil, nil
+	}
+	var sig []byte
+	var err error
+	if found {
+		sig, err = key.Sign(hash)
+	} else {
+		// the remote signer is called without holding the lock
+		sig, err = ks.remote.SignHash(ctx, address, hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ks.recordUsage(ctx, KeyUsage{KeyType: "EVM", KeyID: address.Hex(), Subsystem: "EVM"}, hash)
+	return sig, nil
+}
+
 func (ks *eth) Import(ctx context.Context, keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -257,8 +348,7 @@ func (ks *eth) addKey(ctx context.Context, ds sqlutil.DataSource, address common
 func (ks *eth) Enable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address) {
 		return ErrKeyNotFound
 	}
 	return ks.enable(ctx, address, chainID)
@@ -285,8 +375,7 @@ func (ks *eth) enable(ctx context.Context, address common.Address, chainID *big.
 func (ks *eth) Disable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address) {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.disable(ctx, address, chainID)
@@ -401,14 +490,7 @@ func (ks *eth) CheckEnabled(ctx context.Context, address common.Address, chainID
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	var found bool
-	for _, k := range ks.keyRing.Eth {
-		if k.Address == address {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if !ks.hasKey(address) {
 		return errors.Errorf("no eth key exists with address %s", address.String())
 	}
 	states := ks.keyStates.KeyIDChainID[address.String()]
@@ -527,6 +609,29 @@ func (ks *eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 	}
 }
 
+// caller must hold lock!
+func (ks *eth) hasKey(address common.Address) bool {
+	if _, found := ks.keyRing.Eth[address.Hex()]; found {
+		return true
+	}
+	return ks.isRemote(address.Hex())
+}
+
+// isRemote reports whether the key with the given ID is held by the remote signer: it has a state
+// but is not in the key ring.
+//
+// caller must hold lock!
+func (ks *eth) isRemote(keyID string) bool {
+	if ks.remote == nil {
+		return false
+	}
+	if _, found := ks.keyRing.Eth[keyID]; found {
+		return false
+	}
+	_, found := ks.keyStates.KeyIDChainID[keyID]
+	return found
+}
+
 // caller must hold lock!
 func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 	key, found := ks.keyRing.Eth[id]
@@ -549,8 +654,11 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	}
 	for keyID, state := range states {
 		if includeDisabled || !state.Disabled {
-			k := ks.keyRing.Eth[keyID]
-			keys = append(keys, k)
+			if k, found := ks.keyRing.Eth[keyID]; found {
+				keys = append(keys, k)
+			} else if ks.isRemote(keyID) {
+				keys = append(keys, ethkey.FromAddress(common.HexToAddress(keyID)))
+			}
 		}
 	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+	evmkeys "github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/txmgrtest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
@@ -21,6 +26,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/remotesignertest"
+	coreutils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func Test_EthKeyStore(t *testing.T) {
@@ -752,3 +760,121 @@ func Test_EthKeyStore_Disable(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func Test_EthKeyStore_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	signer := remotesignertest.NewServer(t)
+	remoteAddress := signer.NewKey(t)
+	keyStore := keystore.NewWithRemoteSigner(db, coreutils.FastScryptParams, t.Logf, remotesigner.NewClient(signer.BaseURL(t), time.Second))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ks := keyStore.Eth()
+	localKey, err := ks.Create(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	chainSigner := keystore.NewEthSigner(ks, testutils.FixtureChainID)
+
+	_, err = chainSigner.Sign(ctx, remoteAddress.String(), nil)
+	require.ErrorIs(t, err, keystore.ErrKeyNotFound, "remote keys can only be used once ensured")
+
+	require.NoError(t, ks.EnsureRemoteKeys(ctx, testutils.FixtureChainID, testutils.SimulatedChainID))
+	testutils.AssertCount(t, db, "evm.key_states", 3)
+	require.NoError(t, ks.EnsureRemoteKeys(ctx, testutils.FixtureChainID, testutils.SimulatedChainID))
+	testutils.AssertCount(t, db, "evm.key_states", 3)
+
+	t.Run("remote keys are enabled but never stored in the key ring", func(t *testing.T) {
+		accounts, err := chainSigner.Accounts(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{localKey.Address.String(), remoteAddress.String()}, accounts)
+
+		keys, err := ks.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, localKey.Address, keys[0].Address)
+		require.NoError(t, ks.CheckEnabled(ctx, remoteAddress, testutils.FixtureChainID))
+	})
+
+	t.Run("remote keys are sending keys", func(t *testing.T) {
+		keys, err := ks.EnabledKeysForChain(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		var addresses []common.Address
+		for _, k := range keys {
+			addresses = append(addresses, k.Address)
+		}
+		assert.ElementsMatch(t, []common.Address{localKey.Address, remoteAddress}, addresses)
+
+		_, err = keys[slices.IndexFunc(keys, func(k ethkey.KeyV2) bool { return k.Address == remoteAddress })].Sign(make([]byte, common.HashLength))
+		require.ErrorIs(t, err, ethkey.ErrRemoteKey)
+
+		first, err := ks.GetRoundRobinAddress(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		second, err := ks.GetRoundRobinAddress(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []common.Address{localKey.Address, remoteAddress}, []common.Address{first, second})
+
+		address, err := ks.GetRoundRobinAddress(ctx, testutils.FixtureChainID, remoteAddress)
+		require.NoError(t, err)
+		assert.Equal(t, remoteAddress, address)
+	})
+
+	t.Run("remote keys remain usable after a restart", func(t *testing.T) {
+		restarted := keystore.NewWithRemoteSigner(db, coreutils.FastScryptParams, t.Logf, remotesigner.NewClient(signer.BaseURL(t), time.Second))
+		require.NoError(t, restarted.Unlock(ctx, cltest.Password))
+
+		_, err := keystore.NewEthSigner(restarted.Eth(), testutils.FixtureChainID).Sign(ctx, remoteAddress.String(), make([]byte, common.HashLength))
+		require.NoError(t, err)
+		address, err := restarted.Eth().GetRoundRobinAddress(ctx, testutils.FixtureChainID, remoteAddress)
+		require.NoError(t, err)
+		assert.Equal(t, remoteAddress, address)
+	})
+
+	t.Run("signs transactions with the remote signer", func(t *testing.T) {
+		store := evmkeys.NewChainStore(chainSigner, testutils.FixtureChainID)
+		to := testutils.NewAddress()
+		tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 1, To: &to, Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1)})
+
+		signed, err := store.SignTx(ctx, remoteAddress, tx)
+		require.NoError(t, err)
+		sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(testutils.FixtureChainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, remoteAddress, sender)
+		assert.Equal(t, 1, signer.Signatures(remoteAddress))
+
+		signed, err = store.SignTx(ctx, localKey.Address, tx)
+		require.NoError(t, err)
+		sender, err = gethtypes.Sender(gethtypes.LatestSignerForChainID(testutils.FixtureChainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, localKey.Address, sender)
+		assert.Equal(t, 1, signer.Signatures(remoteAddress))
+	})
+
+	t.Run("fails when the remote signer is unavailable", func(t *testing.T) {
+		signer.SetFail(true)
+		defer signer.SetFail(false)
+
+		_, err := chainSigner.Sign(ctx, remoteAddress.String(), make([]byte, common.HashLength))
+		require.ErrorContains(t, err, "unexpected status 500")
+	})
+
+	t.Run("disabled remote keys are not enabled again", func(t *testing.T) {
+		require.NoError(t, ks.Disable(ctx, remoteAddress, testutils.FixtureChainID))
+		require.NoError(t, ks.EnsureRemoteKeys(ctx, testutils.FixtureChainID))
+
+		accounts, err := chainSigner.Accounts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{localKey.Address.String()}, accounts)
+	})
+
+	t.Run("rejects keys held by both the keystore and the remote signer", func(t *testing.T) {
+		k, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		signer.AddKey(k)
+		keyJSON, err := ethkey.FromPrivateKey(k).ToEncryptedJSON(cltest.Password, coreutils.FastScryptParams)
+		require.NoError(t, err)
+		_, err = ks.Import(ctx, keyJSON, cltest.Password, testutils.FixtureChainID)
+		require.NoError(t, err)
+
+		require.ErrorContains(t, ks.EnsureRemoteKeys(ctx, testutils.FixtureChainID), "held by both the keystore and the remote signer")
+	})
+}
@@ -0,0 +1,138 @@
+package keystore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+// KeyUsage describes a single signature produced with a key of the keystore.
+type KeyUsage struct {
+	// KeyType is the kind of key used, e.g. EVM, CSA or OCR2.
+	KeyType string
+	KeyID   string
+	// Subsystem is the part of the node which requested the signature.
+	Subsystem string
+	JobID     *int32
+	// PayloadHash is the hex encoded sha256 hash of the signed payload.
+	PayloadHash string
+}
+
+// KeyUsageRecorder is notified of every signature produced through the keystore facades.
+// It is called synchronously, so it must not block.
+type KeyUsageRecorder func(ctx context.Context, usage KeyUsage)
+
+type keyUsageCallerKey struct{}
+
+type keyUsageCaller struct {
+	subsystem string
+	jobID     *int32
+}
+
+// WithKeyUsageCaller returns a context attributing the signatures made with it to subsystem and,
+// if not nil, to the job jobID.
+func WithKeyUsageCaller(ctx context.Context, subsystem string, jobID *int32) context.Context {
+	return context.WithValue(ctx, keyUsageCallerKey{}, keyUsageCaller{subsystem: subsystem, jobID: jobID})
+}
+
+// SetKeyUsageRecorder sets the recorder notified of every signature. A nil recorder disables it.
+func (km *keyManager) SetKeyUsageRecorder(r KeyUsageRecorder) {
+	if r == nil {
+		km.usageRecorder.Store(nil)
+		return
+	}
+	km.usageRecorder.Store(&r)
+}
+
+func (km *keyManager) keyUsageRecorder() KeyUsageRecorder {
+	if r := km.usageRecorder.Load(); r != nil {
+		return *r
+	}
+	return nil
+}
+
+// recordUsage notifies the recorder, if any, of the signature of payload. The caller set on ctx
+// with WithKeyUsageCaller takes precedence over the one in usage.
+func (km *keyManager) recordUsage(ctx context.Context, usage KeyUsage, payload []byte) {
+	record := km.keyUsageRecorder()
+	if record == nil {
+		return
+	}
+	if caller, ok := ctx.Value(keyUsageCallerKey{}).(keyUsageCaller); ok {
+		usage.Subsystem = caller.subsystem
+		if caller.jobID != nil {
+			usage.JobID = caller.jobID
+		}
+	}
+	if usage.Subsystem == "" {
+		usage.Subsystem = "unknown"
+	}
+	sum := sha256.Sum256(payload)
+	usage.PayloadHash = hex.EncodeToString(sum[:])
+	record(ctx, usage)
+}
+
+// AttributeKeyBundle returns a bundle recording the signatures made with kb as requested by
+// subsystem for the job jobID. kb is returned as is if it was not obtained from the keystore
+// or no recorder is set.
+func AttributeKeyBundle(kb ocr2key.KeyBundle, subsystem string, jobID int32) ocr2key.KeyBundle {
+	b, ok := kb.(*keyUsageBundle)
+	if !ok {
+		return kb
+	}
+	return &keyUsageBundle{KeyBundle: b.KeyBundle, km: b.km, subsystem: subsystem, jobID: &jobID}
+}
+
+// keyUsageBundle records the signatures made with an OCR2 key bundle, which are requested by
+// libocr without a context.
+type keyUsageBundle struct {
+	ocr2key.KeyBundle
+	km        *keyManager
+	subsystem string
+	jobID     *int32
+}
+
+func (b *keyUsageBundle) record(payload []byte) {
+	b.km.recordUsage(context.Background(), KeyUsage{
+		KeyType:   "OCR2",
+		KeyID:     b.ID(),
+		Subsystem: b.subsystem,
+		JobID:     b.jobID,
+	}, payload)
+}
+
+func (b *keyUsageBundle) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	sig, err := b.KeyBundle.Sign(reportCtx, report)
+	if err == nil {
+		b.record(report)
+	}
+	return sig, err
+}
+
+func (b *keyUsageBundle) Sign3(digest ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report) ([]byte, error) {
+	sig, err := b.KeyBundle.Sign3(digest, seqNr, r)
+	if err == nil {
+		b.record(r)
+	}
+	return sig, err
+}
+
+func (b *keyUsageBundle) SignBlob(blob []byte) ([]byte, error) {
+	sig, err := b.KeyBundle.SignBlob(blob)
+	if err == nil {
+		b.record(blob)
+	}
+	return sig, err
+}
+
+func (b *keyUsageBundle) OffchainSign(msg []byte) ([]byte, error) {
+	sig, err := b.KeyBundle.OffchainSign(msg)
+	if err == nil {
+		b.record(msg)
+	}
+	return sig, err
+}
@@ -0,0 +1,125 @@
+package keystore_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+)
+
+func Test_KeyUsageRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	keyStore := keystore.ExposedNewMaster(t, pgtest.NewSqlxDB(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+	var mu sync.Mutex
+	var usages []keystore.KeyUsage
+	keyStore.SetKeyUsageRecorder(func(_ context.Context, usage keystore.KeyUsage) {
+		mu.Lock()
+		defer mu.Unlock()
+		usages = append(usages, usage)
+	})
+	lastUsage := func(t *testing.T, count int) keystore.KeyUsage {
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, usages, count)
+		return usages[count-1]
+	}
+	hash := func(payload []byte) string {
+		sum := sha256.Sum256(payload)
+		return hex.EncodeToString(sum[:])
+	}
+
+	t.Run("EVM", func(t *testing.T) {
+		key, err := keyStore.Eth().Create(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		signer := keystore.NewEthSigner(keyStore.Eth(), testutils.FixtureChainID)
+
+		_, err = signer.Sign(ctx, key.Address.String(), nil)
+		require.NoError(t, err)
+		mu.Lock()
+		assert.Empty(t, usages, "existence checks are not recorded")
+		mu.Unlock()
+
+		digest := crypto.Keccak256([]byte("payload"))
+		jobID := int32(42)
+		_, err = signer.Sign(keystore.WithKeyUsageCaller(ctx, "VRF", &jobID), key.Address.String(), digest)
+		require.NoError(t, err)
+		assert.Equal(t, keystore.KeyUsage{
+			KeyType:     "EVM",
+			KeyID:       key.Address.Hex(),
+			Subsystem:   "VRF",
+			JobID:       &jobID,
+			PayloadHash: hash(digest),
+		}, lastUsage(t, 1))
+
+		txmSigner := signer.WithCaller("TXM", nil)
+		_, err = txmSigner.Sign(ctx, key.Address.String(), digest)
+		require.NoError(t, err)
+		usage := lastUsage(t, 2)
+		assert.Equal(t, "TXM", usage.Subsystem)
+		assert.Nil(t, usage.JobID)
+
+		_, err = txmSigner.Sign(keystore.WithKeyUsageCaller(ctx, "VRF", &jobID), key.Address.String(), digest)
+		require.NoError(t, err)
+		assert.Equal(t, "VRF", lastUsage(t, 3).Subsystem, "the caller set on the context takes precedence")
+	})
+
+	t.Run("CSA", func(t *testing.T) {
+		key, err := keyStore.CSA().Create(ctx)
+		require.NoError(t, err)
+		signer := keystore.CSASigner{CSA: keyStore.CSA(), Subsystem: "JobDistributor"}
+
+		_, err = signer.Sign(ctx, key.ID(), []byte("message"))
+		require.NoError(t, err)
+		usage := lastUsage(t, 4)
+		assert.Equal(t, "CSA", usage.KeyType)
+		assert.Equal(t, key.ID(), usage.KeyID)
+		assert.Equal(t, "JobDistributor", usage.Subsystem)
+		assert.Nil(t, usage.JobID)
+		assert.Equal(t, hash([]byte("message")), usage.PayloadHash)
+	})
+
+	t.Run("OCR2", func(t *testing.T) {
+		created, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+		require.NoError(t, err)
+		kb, err := keyStore.OCR2().Get(created.ID())
+		require.NoError(t, err)
+		kb = keystore.AttributeKeyBundle(kb, "OCR2/median", 7)
+
+		_, err = kb.SignBlob([]byte("blob"))
+		require.NoError(t, err)
+		usage := lastUsage(t, 5)
+		assert.Equal(t, "OCR2", usage.KeyType)
+		assert.Equal(t, created.ID(), usage.KeyID)
+		assert.Equal(t, "OCR2/median", usage.Subsystem)
+		require.NotNil(t, usage.JobID)
+		assert.Equal(t, int32(7), *usage.JobID)
+
+		_, err = kb.OffchainSign([]byte("observation"))
+		require.NoError(t, err)
+		assert.Equal(t, hash([]byte("observation")), lastUsage(t, 6).PayloadHash)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		keyStore.SetKeyUsageRecorder(nil)
+		key, err := keyStore.CSA().GetAll()
+		require.NoError(t, err)
+		_, err = keystore.CSASigner{CSA: keyStore.CSA()}.Sign(ctx, key[0].ID(), []byte("message"))
+		require.NoError(t, err)
+		lastUsage(t, 6)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -19,6 +20,9 @@ import (
 
 var curve = crypto.S256()
 
+// ErrRemoteKey is returned when using the private key of a key held by a remote signer.
+var ErrRemoteKey = errors.New("key is held by a remote signer")
+
 func KeyFor(raw internal.Raw) KeyV2 {
 	var privateKey ecdsa.PrivateKey
 	d := big.NewInt(0).SetBytes(internal.Bytes(raw))
@@ -47,6 +51,15 @@ func newKeyV2(privKey *ecdsa.PrivateKey) KeyV2 {
 	}
 }
 
+// FromAddress returns a key of which only the address is known, such as a key held by a remote
+// signer. It can neither sign nor be exported.
+func FromAddress(address common.Address) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+	}
+}
+
 func NewV2() (KeyV2, error) {
 	privateKeyECDSA, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
 	if err != nil {
@@ -67,7 +80,12 @@ func (key KeyV2) ID() string {
 
 func (key KeyV2) Raw() internal.Raw { return key.raw }
 
-func (key KeyV2) Sign(data []byte) ([]byte, error) { return crypto.Sign(data, key.getPK()) }
+func (key KeyV2) Sign(data []byte) ([]byte, error) {
+	if key.getPK == nil {
+		return nil, ErrRemoteKey
+	}
+	return crypto.Sign(data, key.getPK())
+}
 
 // Cmp uses byte-order address comparison to give a stable comparison between two keys
 func (key KeyV2) Cmp(key2 KeyV2) int {
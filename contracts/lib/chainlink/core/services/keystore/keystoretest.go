@@ -44,6 +44,10 @@ func (o *memoryORM) getEncryptedKeyRing(ctx context.Context) (encryptedKeyRing,
 	return *o.keyRing, nil
 }
 
+func (o *memoryORM) purgeExpiredKeyRingBackups(ctx context.Context) error {
+	return nil
+}
+
 func newInMemoryORM(ds sqlutil.DataSource) *memoryORM {
 	return &memoryORM{ds: ds}
 }
@@ -60,6 +64,7 @@ func NewInMemory(ds sqlutil.DataSource, scryptParams utils.ScryptParams, logf Lo
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		announce:     announcer(logf),
+		logf:         logf,
 	}
 
 	return &master{
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 
@@ -53,6 +54,7 @@ type Master interface {
 	Workflow() Workflow
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
+	SetKeyUsageRecorder(r KeyUsageRecorder)
 }
 type master struct {
 	*keyManager
@@ -77,6 +79,14 @@ func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, announce Logf)
 	return newMaster(ds, scryptParams, announce)
 }
 
+// NewWithRemoteSigner is like New, but the EVM keys held by remote can also be used to sign,
+// once registered with Eth().EnsureRemoteKeys.
+func NewWithRemoteSigner(ds sqlutil.DataSource, scryptParams utils.ScryptParams, announce Logf, remote RemoteSigner) Master {
+	ks := newMaster(ds, scryptParams, announce)
+	ks.eth.remote = remote
+	return ks
+}
+
 func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, announce Logf) *master {
 	orm := NewORM(ds)
 	km := &keyManager{
@@ -85,6 +95,7 @@ func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, announce
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		announce:     announcer(announce),
+		logf:         announce,
 	}
 
 	return &master{
@@ -161,6 +172,7 @@ type ORM interface {
 	isEmpty(context.Context) (bool, error)
 	saveEncryptedKeyRing(context.Context, *encryptedKeyRing, ...func(sqlutil.DataSource) error) error
 	getEncryptedKeyRing(context.Context) (encryptedKeyRing, error)
+	purgeExpiredKeyRingBackups(context.Context) error
 }
 
 type keystateORM interface {
@@ -176,6 +188,9 @@ type keyManager struct {
 	lock         *sync.RWMutex
 	password     string
 	announce     func(Key)
+	logf         Logf
+
+	usageRecorder atomic.Pointer[KeyUsageRecorder]
 }
 
 func (km *keyManager) IsEmpty(ctx context.Context) (bool, error) {
@@ -208,6 +223,12 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	}
 	km.keyStates = ks
 
+	// Failing to purge must not keep the node from starting; the backups are purged again on the
+	// next unlock or password rotation.
+	if err = km.orm.purgeExpiredKeyRingBackups(ctx); err != nil {
+		km.logf("WARN: unable to purge expired key ring backups, unlocking the keystore anyway: %v", err)
+	}
+
 	km.password = password
 	return nil
 }
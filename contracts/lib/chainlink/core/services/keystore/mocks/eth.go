@@ -527,6 +527,67 @@ func (_c *Eth_EnsureKeys_Call) RunAndReturn(run func(context.Context, ...*big.In
 	return _c
 }
 
+// EnsureRemoteKeys provides a mock function with given fields: ctx, chainIDs
+func (_m *Eth) EnsureRemoteKeys(ctx context.Context, chainIDs ...*big.Int) error {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureRemoteKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...*big.Int) error); ok {
+		r0 = rf(ctx, chainIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Eth_EnsureRemoteKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureRemoteKeys'
+type Eth_EnsureRemoteKeys_Call struct {
+	*mock.Call
+}
+
+// EnsureRemoteKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainIDs ...*big.Int
+func (_e *Eth_Expecter) EnsureRemoteKeys(ctx interface{}, chainIDs ...interface{}) *Eth_EnsureRemoteKeys_Call {
+	return &Eth_EnsureRemoteKeys_Call{Call: _e.mock.On("EnsureRemoteKeys",
+		append([]interface{}{ctx}, chainIDs...)...)}
+}
+
+func (_c *Eth_EnsureRemoteKeys_Call) Run(run func(ctx context.Context, chainIDs ...*big.Int)) *Eth_EnsureRemoteKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*big.Int, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(*big.Int)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Eth_EnsureRemoteKeys_Call) Return(_a0 error) *Eth_EnsureRemoteKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Eth_EnsureRemoteKeys_Call) RunAndReturn(run func(context.Context, ...*big.Int) error) *Eth_EnsureRemoteKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Export provides a mock function with given fields: ctx, id, password
 func (_m *Eth) Export(ctx context.Context, id string, password string) ([]byte, error) {
 	ret := _m.Called(ctx, id, password)
@@ -1072,6 +1133,66 @@ func (_c *Eth_Import_Call) RunAndReturn(run func(context.Context, []byte, string
 	return _c
 }
 
+// SignHash provides a mock function with given fields: ctx, address, hash
+func (_m *Eth) SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error) {
+	ret := _m.Called(ctx, address, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignHash")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) ([]byte, error)); ok {
+		return rf(ctx, address, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) []byte); ok {
+		r0 = rf(ctx, address, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []byte) error); ok {
+		r1 = rf(ctx, address, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_SignHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignHash'
+type Eth_SignHash_Call struct {
+	*mock.Call
+}
+
+// SignHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - hash []byte
+func (_e *Eth_Expecter) SignHash(ctx interface{}, address interface{}, hash interface{}) *Eth_SignHash_Call {
+	return &Eth_SignHash_Call{Call: _e.mock.On("SignHash", ctx, address, hash)}
+}
+
+func (_c *Eth_SignHash_Call) Run(run func(ctx context.Context, address common.Address, hash []byte)) *Eth_SignHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *Eth_SignHash_Call) Return(_a0 []byte, _a1 error) *Eth_SignHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_SignHash_Call) RunAndReturn(run func(context.Context, common.Address, []byte) ([]byte, error)) *Eth_SignHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // XXXTestingOnlyAdd provides a mock function with given fields: ctx, key
 func (_m *Eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 	_m.Called(ctx, key)
@@ -407,6 +407,39 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// SetKeyUsageRecorder provides a mock function with given fields: r
+func (_m *Master) SetKeyUsageRecorder(r keystore.KeyUsageRecorder) {
+	_m.Called(r)
+}
+
+// Master_SetKeyUsageRecorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetKeyUsageRecorder'
+type Master_SetKeyUsageRecorder_Call struct {
+	*mock.Call
+}
+
+// SetKeyUsageRecorder is a helper method to define mock.On call
+//   - r keystore.KeyUsageRecorder
+func (_e *Master_Expecter) SetKeyUsageRecorder(r interface{}) *Master_SetKeyUsageRecorder_Call {
+	return &Master_SetKeyUsageRecorder_Call{Call: _e.mock.On("SetKeyUsageRecorder", r)}
+}
+
+func (_c *Master_SetKeyUsageRecorder_Call) Run(run func(r keystore.KeyUsageRecorder)) *Master_SetKeyUsageRecorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(keystore.KeyUsageRecorder))
+	})
+	return _c
+}
+
+func (_c *Master_SetKeyUsageRecorder_Call) Return() *Master_SetKeyUsageRecorder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Master_SetKeyUsageRecorder_Call) RunAndReturn(run func(keystore.KeyUsageRecorder)) *Master_SetKeyUsageRecorder_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Solana provides a mock function with no fields
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
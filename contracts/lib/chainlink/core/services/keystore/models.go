@@ -38,12 +38,7 @@ func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	if len(ekr.EncryptedKeys) == 0 {
 		return newKeyRing(), nil
 	}
-	var cryptoJSON gethkeystore.CryptoJSON
-	err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON)
-	if err != nil {
-		return nil, err
-	}
-	marshalledRawKeyRingJson, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+	marshalledRawKeyRingJson, err := ekr.decryptData(password)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +61,36 @@ func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	return ring, nil
 }
 
+// decryptData returns the marshalled raw key ring.
+func (ekr encryptedKeyRing) decryptData(password string) ([]byte, error) {
+	var cryptoJSON gethkeystore.CryptoJSON
+	err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON)
+	if err != nil {
+		return nil, err
+	}
+	return gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+}
+
+// encryptKeyRingData encrypts a marshalled raw key ring.
+func encryptKeyRingData(marshalledRawKeyRingJson []byte, password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
+	cryptoJSON, err := gethkeystore.EncryptDataV3(
+		marshalledRawKeyRingJson,
+		[]byte(adulteratedPassword(password)),
+		scryptParams.N,
+		scryptParams.P,
+	)
+	if err != nil {
+		return ekr, errors.Wrapf(err, "could not encrypt key ring")
+	}
+	encryptedKeys, err := json.Marshal(&cryptoJSON)
+	if err != nil {
+		return ekr, errors.Wrapf(err, "could not encode cryptoJSON")
+	}
+	return encryptedKeyRing{
+		EncryptedKeys: encryptedKeys,
+	}, nil
+}
+
 type keyStates struct {
 	// Key ID => chain ID => state
 	KeyIDChainID map[string]map[string]*ethkey.State
@@ -198,22 +223,7 @@ func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ek
 		return encryptedKeyRing{}, err
 	}
 
-	cryptoJSON, err := gethkeystore.EncryptDataV3(
-		marshalledRawKeyRingJson,
-		[]byte(adulteratedPassword(password)),
-		scryptParams.N,
-		scryptParams.P,
-	)
-	if err != nil {
-		return ekr, errors.Wrapf(err, "could not encrypt key ring")
-	}
-	encryptedKeys, err := json.Marshal(&cryptoJSON)
-	if err != nil {
-		return ekr, errors.Wrapf(err, "could not encode cryptoJSON")
-	}
-	return encryptedKeyRing{
-		EncryptedKeys: encryptedKeys,
-	}, nil
+	return encryptKeyRingData(marshalledRawKeyRingJson, password, scryptParams)
 }
 
 func (kr *keyRing) raw() (rawKeys rawKeyRing) {
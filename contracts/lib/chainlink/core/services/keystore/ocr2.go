@@ -40,7 +40,11 @@ func (ks ocr2) Get(id string) (ocr2key.KeyBundle, error) {
 	if ks.isLocked() {
 		return nil, ErrLocked
 	}
-	return ks.getByID(id)
+	kb, err := ks.getByID(id)
+	if err != nil || ks.keyUsageRecorder() == nil {
+		return kb, err
+	}
+	return &keyUsageBundle{KeyBundle: kb, km: ks.keyManager, subsystem: "OCR2"}, nil
 }
 
 func (ks ocr2) GetAll() ([]ocr2key.KeyBundle, error) {
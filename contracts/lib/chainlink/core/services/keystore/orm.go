@@ -63,6 +63,10 @@ func (orm ksORM) getEncryptedKeyRing(ctx context.Context) (kr encryptedKeyRing,
 	return kr, nil
 }
 
+func (orm ksORM) purgeExpiredKeyRingBackups(ctx context.Context) error {
+	return purgeExpiredKeyRingBackups(ctx, orm.ds)
+}
+
 func (orm ksORM) loadKeyStates(ctx context.Context) (*keyStates, error) {
 	ks := newKeyStates()
 	var ethkeystates []*ethkey.State
@@ -0,0 +1,218 @@
+// Package remotesigner implements a client for external signers holding EVM keys, so that they can
+// be used by the node without being stored in its keystore.
+//
+// The client relies on two endpoints:
+//   - GET /api/v1/eth1/publicKeys lists the public keys held by the signer, as web3signer does
+//   - POST /api/v1/eth1/sign-digest/{publicKey} signs the hex encoded 32 bytes digest in the
+//     "data" field as is, without hashing or prefixing it
+//
+// The web3signer POST /api/v1/eth1/sign/{publicKey} endpoint cannot be used instead: it signs the
+// keccak256 hash of its data, while the node only has the digest of the transactions it signs.
+// Every signature is recovered and checked against the expected address before it is returned.
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth1/publicKeys"
+	signDigestPath = "/api/v1/eth1/sign-digest/"
+
+	// maxResponseSize caps the size of the responses read from the signer.
+	maxResponseSize = 1 << 20
+)
+
+// ErrUnknownAddress is returned when signing with an address which the signer does not hold.
+var ErrUnknownAddress = errors.New("address is not held by the remote signer")
+
+// SignRequest is the body of a signing request, with the hex encoded data to sign.
+type SignRequest struct {
+	Data string `json:"data"`
+}
+
+// Client signs digests with the keys held by a remote signer.
+type Client struct {
+	url    *url.URL
+	client *http.Client
+
+	mu          sync.RWMutex
+	identifiers map[common.Address]string
+}
+
+// NewClient returns a Client for the signer at baseURL.
+func NewClient(baseURL *url.URL, timeout time.Duration) *Client {
+	return &Client{
+		url:         baseURL,
+		client:      &http.Client{Timeout: timeout},
+		identifiers: map[common.Address]string{},
+	}
+}
+
+// Addresses returns the addresses of all the keys held by the signer.
+func (c *Client) Addresses(ctx context.Context) ([]common.Address, error) {
+	var publicKeys []string
+	if err := c.do(ctx, http.MethodGet, publicKeysPath, nil, &publicKeys); err != nil {
+		return nil, errors.Wrap(err, "failed to list remote signer public keys")
+	}
+
+	identifiers := make(map[common.Address]string, len(publicKeys))
+	addresses := make([]common.Address, 0, len(publicKeys))
+	for _, pk := range publicKeys {
+		address, err := PublicKeyToAddress(pk)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := identifiers[address]; ok {
+			continue
+		}
+		identifiers[address] = pk
+		addresses = append(addresses, address)
+	}
+
+	c.mu.Lock()
+	c.identifiers = identifiers
+	c.mu.Unlock()
+	return addresses, nil
+}
+
+// SignHash signs the 32 bytes hash with the key for address. The signature is in the
+// [R || S || V] format, with V 0 or 1, as returned by crypto.Sign.
+func (c *Client) SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, errors.Errorf("hash must be %d bytes long, got %d", common.HashLength, len(hash))
+	}
+	identifier, err := c.identifier(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(SignRequest{Data: hexutil.Encode(hash)})
+	if err != nil {
+		return nil, err
+	}
+	var encoded string
+	if err = c.do(ctx, http.MethodPost, signDigestPath+identifier, body, &encoded); err != nil {
+		return nil, errors.Wrapf(err, "failed to sign with remote key %s", address)
+	}
+
+	sig, err := hexutil.Decode(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote signer")
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, errors.Errorf("invalid signature from remote signer: expected %d bytes, got %d", crypto.SignatureLength, len(sig))
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote signer")
+	}
+	if signer := crypto.PubkeyToAddress(*pub); signer != address {
+		return nil, errors.Errorf("remote signer signed with %s instead of %s", signer, address)
+	}
+	return sig, nil
+}
+
+// identifier returns the public key identifying the key for address, listing the keys of the
+// signer again if it is unknown.
+func (c *Client) identifier(ctx context.Context, address common.Address) (string, error) {
+	c.mu.RLock()
+	identifier, ok := c.identifiers[address]
+	c.mu.RUnlock()
+	if ok {
+		return identifier, nil
+	}
+
+	if _, err := c.Addresses(ctx); err != nil {
+		return "", err
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if identifier, ok = c.identifiers[address]; !ok {
+		return "", errors.Wrap(ErrUnknownAddress, address.String())
+	}
+	return identifier, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body []byte, result any) error {
+	u := c.url.JoinPath(path)
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(b))
+	}
+
+	// web3signer returns the signature as plain text, and the public keys as JSON.
+	if s, ok := result.(*string); ok && !json.Valid(b) {
+		*s = string(bytes.TrimSpace(b))
+		return nil
+	}
+	if err = json.Unmarshal(b, result); err != nil {
+		return errors.Wrap(err, "invalid response")
+	}
+	return nil
+}
+
+// PublicKeyToAddress returns the address for a hex encoded secp256k1 public key, either
+// compressed, uncompressed, or uncompressed without its 0x04 prefix.
+func PublicKeyToAddress(publicKey string) (common.Address, error) {
+	b, err := hexutil.Decode(publicKey)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "invalid public key %q", publicKey)
+	}
+	switch len(b) {
+	case 33:
+		pub, err := crypto.DecompressPubkey(b)
+		if err != nil {
+			return common.Address{}, errors.Wrapf(err, "invalid public key %q", publicKey)
+		}
+		return crypto.PubkeyToAddress(*pub), nil
+	case 64:
+		b = append([]byte{4}, b...)
+	case 65:
+	default:
+		return common.Address{}, errors.Errorf("invalid public key %q: unexpected length %d", publicKey, len(b))
+	}
+	pub, err := crypto.UnmarshalPubkey(b)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "invalid public key %q", publicKey)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
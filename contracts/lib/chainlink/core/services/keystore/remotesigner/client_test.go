@@ -0,0 +1,138 @@
+package remotesigner_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/remotesignertest"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	signer := remotesignertest.NewServer(t)
+	address := signer.NewKey(t)
+	client := remotesigner.NewClient(signer.BaseURL(t), time.Second)
+	hash := crypto.Keccak256([]byte("settlement"))
+
+	t.Run("lists addresses", func(t *testing.T) {
+		addresses, err := client.Addresses(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Address{address}, addresses)
+	})
+
+	t.Run("signs hashes", func(t *testing.T) {
+		sig, err := client.SignHash(ctx, address, hash)
+		require.NoError(t, err)
+		require.Len(t, sig, crypto.SignatureLength)
+		assert.LessOrEqual(t, sig[crypto.RecoveryIDOffset], byte(1))
+		pub, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("signs the hash itself rather than its hash", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			digest := testutils.Random32Byte()
+			sig, err := client.SignHash(ctx, address, digest[:])
+			require.NoError(t, err)
+			pub, err := crypto.SigToPub(digest[:], sig)
+			require.NoError(t, err)
+			assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+		}
+	})
+
+	t.Run("finds keys added to the signer", func(t *testing.T) {
+		added := signer.NewKey(t)
+		_, err := client.SignHash(ctx, added, hash)
+		require.NoError(t, err)
+		assert.Equal(t, 1, signer.Signatures(added))
+	})
+
+	t.Run("unknown address", func(t *testing.T) {
+		_, err := client.SignHash(ctx, testutils.NewAddress(), hash)
+		require.ErrorIs(t, err, remotesigner.ErrUnknownAddress)
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		_, err := client.SignHash(ctx, address, []byte("not a hash"))
+		require.ErrorContains(t, err, "hash must be 32 bytes long")
+	})
+
+	t.Run("signer error", func(t *testing.T) {
+		signer.SetFail(true)
+		defer signer.SetFail(false)
+
+		_, err := client.SignHash(ctx, address, hash)
+		require.ErrorContains(t, err, "unexpected status 500: signer unavailable")
+	})
+}
+
+func TestClient_WrongSigner(t *testing.T) {
+	t.Parallel()
+
+	expected, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(expected.PublicKey)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`["` + hexutil.Encode(crypto.FromECDSAPub(&expected.PublicKey)) + `"]`))
+			return
+		}
+		sig, err := crypto.Sign(crypto.Keccak256([]byte("settlement")), other)
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, _ = w.Write([]byte(hexutil.Encode(sig)))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	client := remotesigner.NewClient(u, time.Second)
+	_, err = client.SignHash(testutils.Context(t), address, crypto.Keccak256([]byte("settlement")))
+	require.ErrorContains(t, err, "remote signer signed with "+crypto.PubkeyToAddress(other.PublicKey).String())
+}
+
+func TestPublicKeyToAddress(t *testing.T) {
+	t.Parallel()
+
+	k, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(k.PublicKey)
+	uncompressed := crypto.FromECDSAPub(&k.PublicKey)
+
+	for _, tc := range []struct {
+		name      string
+		publicKey string
+	}{
+		{"uncompressed", hexutil.Encode(uncompressed)},
+		{"uncompressed without prefix", hexutil.Encode(uncompressed[1:])},
+		{"compressed", hexutil.Encode(crypto.CompressPubkey(&k.PublicKey))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := remotesigner.PublicKeyToAddress(tc.publicKey)
+			require.NoError(t, err)
+			assert.Equal(t, address, got)
+		})
+	}
+
+	_, err = remotesigner.PublicKeyToAddress("0x1234")
+	require.ErrorContains(t, err, "unexpected length 2")
+	_, err = remotesigner.PublicKeyToAddress("not hex")
+	require.Error(t, err)
+}
@@ -0,0 +1,140 @@
+// Package remotesignertest provides a local web3signer compatible signer for tests.
+package remotesignertest
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+// Server is an in-memory signer serving the web3signer eth1 endpoints, as well as the digest signing
+// endpoint used by remotesigner.Client.
+type Server struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	keys  map[string]*ecdsa.PrivateKey
+	signs map[common.Address]int
+	fail  bool
+}
+
+// NewServer starts a Server holding keys, which is closed at the end of the test.
+func NewServer(t testing.TB, keys ...*ecdsa.PrivateKey) *Server {
+	s := &Server{
+		keys:  map[string]*ecdsa.PrivateKey{},
+		signs: map[common.Address]int{},
+	}
+	for _, k := range keys {
+		s.AddKey(k)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// NewKey generates a key and adds it to the server.
+func (s *Server) NewKey(t testing.TB) common.Address {
+	k, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	s.AddKey(k)
+	return crypto.PubkeyToAddress(k.PublicKey)
+}
+
+// AddKey adds a key to the server.
+func (s *Server) AddKey(k *ecdsa.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[hexutil.Encode(crypto.FromECDSAPub(&k.PublicKey))] = k
+}
+
+// BaseURL returns the parsed URL of the server.
+func (s *Server) BaseURL(t testing.TB) *url.URL {
+	u, err := url.Parse(s.Server.URL)
+	require.NoError(t, err)
+	return u
+}
+
+// SetFail makes every subsequent request fail with an internal server error, or succeed again.
+func (s *Server) SetFail(fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail = fail
+}
+
+// Signatures returns the number of signatures made with the key for address.
+func (s *Server) Signatures(address common.Address) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.signs[address]
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		http.Error(w, "signer unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/eth1/publicKeys":
+		publicKeys := make([]string, 0, len(s.keys))
+		for pk := range s.keys {
+			publicKeys = append(publicKeys, pk)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(publicKeys)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/v1/eth1/sign/"):
+		// like web3signer, the data is hashed before it is signed
+		s.sign(w, r, strings.TrimPrefix(r.URL.Path, "/api/v1/eth1/sign/"), true)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/v1/eth1/sign-digest/"):
+		s.sign(w, r, strings.TrimPrefix(r.URL.Path, "/api/v1/eth1/sign-digest/"), false)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// sign writes the signature of the request data, or of its keccak256 hash if hash is set, with the
+// key for publicKey.
+func (s *Server) sign(w http.ResponseWriter, r *http.Request, publicKey string, hash bool) {
+	k, ok := s.keys[publicKey]
+	if !ok {
+		http.Error(w, "public key not found", http.StatusNotFound)
+		return
+	}
+	var req remotesigner.SignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, err := hexutil.Decode(req.Data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if hash {
+		data = crypto.Keccak256(data)
+	}
+	sig, err := crypto.Sign(data, k)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// web3signer returns the recovery ID as 27 or 28
+	sig[crypto.RecoveryIDOffset] += 27
+	s.signs[crypto.PubkeyToAddress(k.PublicKey)]++
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(hexutil.Encode(sig)))
+}
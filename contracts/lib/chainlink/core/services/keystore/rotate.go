@@ -0,0 +1,93 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ErrEmptyKeyRing is returned when rotating the password of a key ring without any key, which any
+// password unlocks.
+var ErrEmptyKeyRing = errors.New("key ring has no keys")
+
+// RotatePassword re-encrypts all the keys with newPassword and scryptParams, in a single
+// transaction which also backs up the key ring encrypted with oldPassword to
+// encrypted_key_ring_backups for backupRetention, and purges the expired backups. The rotation is
+// only committed once the stored key ring is verified to decrypt with newPassword to the same
+// keys. It returns the ID of the backup.
+//
+// The node must not be running, or it would keep encrypting with oldPassword.
+func RotatePassword(ctx context.Context, ds sqlutil.DataSource, oldPassword, newPassword string, scryptParams utils.ScryptParams, backupRetention time.Duration) (backupID int64, err error) {
+	if newPassword == oldPassword {
+		return 0, errors.New("new password must differ from the current one")
+	}
+	if backupRetention <= 0 {
+		return 0, errors.New("backup retention must be positive")
+	}
+	err = sqlutil.TransactDataSource(ctx, ds, nil, func(tx sqlutil.DataSource) error {
+		var ekr encryptedKeyRing
+		err2 := tx.GetContext(ctx, &ekr, `SELECT * FROM encrypted_key_rings LIMIT 1 FOR UPDATE`)
+		if errors.Is(err2, sql.ErrNoRows) || (err2 == nil && len(ekr.EncryptedKeys) == 0) {
+			return ErrEmptyKeyRing
+		} else if err2 != nil {
+			return errors.Wrap(err2, "unable to get encrypted key ring")
+		}
+
+		data, err2 := ekr.decryptData(oldPassword)
+		if err2 != nil {
+			return errors.Wrap(err2, "unable to decrypt encrypted key ring with the current password")
+		}
+		rotated, err2 := encryptKeyRingData(data, newPassword, scryptParams)
+		if err2 != nil {
+			return err2
+		}
+
+		if err2 = purgeExpiredKeyRingBackups(ctx, tx); err2 != nil {
+			return err2
+		}
+		err2 = tx.GetContext(ctx, &backupID, `INSERT INTO encrypted_key_ring_backups (encrypted_keys, created_at, expires_at) VALUES ($1, NOW(), $2) RETURNING id`,
+			ekr.EncryptedKeys, time.Now().Add(backupRetention))
+		if err2 != nil {
+			return errors.Wrap(err2, "while backing up keyring")
+		}
+		_, err2 = tx.ExecContext(ctx, `UPDATE encrypted_key_rings SET encrypted_keys = $1, updated_at = NOW()`, rotated.EncryptedKeys)
+		if err2 != nil {
+			return errors.Wrap(err2, "while saving keyring")
+		}
+
+		return errors.Wrap(verifyRotatedKeyRing(ctx, tx, data, newPassword), "verifying re-encrypted key ring")
+	})
+	return
+}
+
+// verifyRotatedKeyRing checks the stored key ring decrypts with password to the expected data,
+// and that its keys can be loaded.
+func verifyRotatedKeyRing(ctx context.Context, ds sqlutil.DataSource, expected []byte, password string) error {
+	var stored encryptedKeyRing
+	if err := ds.GetContext(ctx, &stored, `SELECT * FROM encrypted_key_rings LIMIT 1`); err != nil {
+		return err
+	}
+	data, err := stored.decryptData(password)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(data, expected) {
+		return errors.New("decrypted key ring differs from the original")
+	}
+	_, err = stored.Decrypt(password)
+	return err
+}
+
+// purgeExpiredKeyRingBackups deletes the backups saved by RotatePassword once they expire. Since
+// the password of an expired backup may be compromised, it is also called when unlocking the
+// keystore.
+func purgeExpiredKeyRingBackups(ctx context.Context, ds sqlutil.DataSource) error {
+	_, err := ds.ExecContext(ctx, `DELETE FROM encrypted_key_ring_backups WHERE expires_at <= NOW()`)
+	return errors.Wrap(err, "while purging expired key ring backups")
+}
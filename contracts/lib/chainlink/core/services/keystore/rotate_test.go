@@ -0,0 +1,65 @@
+package keystore_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestRotatePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	const newPassword = "p4SsW0rD1!@#_new"
+
+	_, err := keystore.RotatePassword(ctx, db, cltest.Password, newPassword, utils.FastScryptParams, time.Hour)
+	require.ErrorIs(t, err, keystore.ErrEmptyKeyRing)
+
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	_, err = keystore.RotatePassword(ctx, db, "wrong password", newPassword, utils.FastScryptParams, time.Hour)
+	require.ErrorContains(t, err, "current password")
+	cltest.AssertCount(t, db, "encrypted_key_ring_backups", 0)
+
+	_, err = keystore.RotatePassword(ctx, db, cltest.Password, cltest.Password, utils.FastScryptParams, time.Hour)
+	require.ErrorContains(t, err, "must differ")
+
+	_, err = keystore.RotatePassword(ctx, db, cltest.Password, newPassword, utils.FastScryptParams, 0)
+	require.ErrorContains(t, err, "retention must be positive")
+
+	backupID, err := keystore.RotatePassword(ctx, db, cltest.Password, newPassword, utils.FastScryptParams, time.Hour)
+	require.NoError(t, err)
+	require.NotZero(t, backupID)
+	cltest.AssertCount(t, db, "encrypted_key_ring_backups", 1)
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+	found, err := keyStore.Eth().Get(ctx, key.ID())
+	require.NoError(t, err)
+	requireEqualKeys(t, key, found)
+	cltest.AssertCount(t, db, "encrypted_key_ring_backups", 1)
+
+	// Expired backups are purged when the keystore is unlocked
+	_, err = db.ExecContext(ctx, `UPDATE encrypted_key_ring_backups SET expires_at = NOW() - interval '1 second'`)
+	require.NoError(t, err)
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+	cltest.AssertCount(t, db, "encrypted_key_ring_backups", 0)
+
+	// Failing to purge them does not keep the keystore locked
+	_, err = db.ExecContext(ctx, `DROP TABLE encrypted_key_ring_backups`)
+	require.NoError(t, err)
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+}
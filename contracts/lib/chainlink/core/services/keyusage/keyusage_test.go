@@ -0,0 +1,142 @@
+package keyusage_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyusage"
+)
+
+type auditEvent struct {
+	id   audit.EventID
+	data audit.Data
+}
+
+type testAuditLogger struct {
+	audit.AuditLogger
+
+	mu     sync.Mutex
+	events []auditEvent
+}
+
+func (l *testAuditLogger) Audit(eventID audit.EventID, data audit.Data) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, auditEvent{eventID, data})
+}
+
+func TestORM_Entries(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := keyusage.NewORM(db)
+
+	jobID := int32(7)
+	now := time.Now()
+	require.NoError(t, orm.InsertEntries(ctx, []keyusage.Entry{
+		{KeyType: "EVM", KeyID: "0xabc", Subsystem: "EVM", PayloadHash: "01", CreatedAt: now.Add(-time.Hour)},
+		{KeyType: "OCR2", KeyID: "bundle", Subsystem: "OCR2/median", JobID: &jobID, PayloadHash: "02", CreatedAt: now},
+		{KeyType: "EVM", KeyID: "0xabc", Subsystem: "OCR2/median", JobID: &jobID, PayloadHash: "03", CreatedAt: now},
+	}))
+
+	entries, count, err := orm.FindEntries(ctx, keyusage.Filter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "03", entries[0].PayloadHash)
+
+	entries, count, err = orm.FindEntries(ctx, keyusage.Filter{KeyType: "EVM", KeyID: "0xabc"}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, entries, 2)
+
+	entries, count, err = orm.FindEntries(ctx, keyusage.Filter{JobID: &jobID, Subsystem: "OCR2/median"}, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "02", entries[0].PayloadHash)
+
+	_, count, err = orm.FindEntries(ctx, keyusage.Filter{Since: now.Add(-2 * time.Hour), Until: now.Add(-time.Minute)}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = db.ExecContext(ctx, "DELETE FROM key_usage_log")
+	require.ErrorContains(t, err, "append-only")
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := keyusage.NewORM(pgtest.NewSqlxDB(t))
+	auditLogger := &testAuditLogger{}
+	recorder := keyusage.NewRecorder(auditLogger, orm, logger.TestLogger(t))
+	servicetest.Run(t, recorder)
+
+	jobID := int32(3)
+	recorder.Record(ctx, keystore.KeyUsage{KeyType: "CSA", KeyID: "csa", Subsystem: "JobDistributor", PayloadHash: "aa"})
+	recorder.Record(ctx, keystore.KeyUsage{KeyType: "EVM", KeyID: "0xabc", Subsystem: "OCR2/median", JobID: &jobID, PayloadHash: "bb"})
+
+	auditLogger.mu.Lock()
+	require.Len(t, auditLogger.events, 2)
+	assert.Equal(t, audit.KeyUsed, auditLogger.events[1].id)
+	assert.Equal(t, audit.Data{
+		"keyType":     "EVM",
+		"keyID":       "0xabc",
+		"subsystem":   "OCR2/median",
+		"jobID":       jobID,
+		"payloadHash": "bb",
+	}, auditLogger.events[1].data)
+	auditLogger.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		_, count, err := orm.FindEntries(ctx, keyusage.Filter{}, 0, 10)
+		require.NoError(t, err)
+		return count == 2
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+}
+
+func TestRecorder_WithoutStore(t *testing.T) {
+	t.Parallel()
+
+	auditLogger := &testAuditLogger{}
+	recorder := keyusage.NewRecorder(auditLogger, nil, logger.TestLogger(t))
+	servicetest.Run(t, recorder)
+
+	recorder.Record(context.Background(), keystore.KeyUsage{KeyType: "CSA", KeyID: "csa", Subsystem: "JobDistributor", PayloadHash: "aa"})
+	assert.Len(t, auditLogger.events, 1)
+}
+
+func TestRecorder_AggregatesOCR2(t *testing.T) {
+	t.Parallel()
+
+	auditLogger := &testAuditLogger{}
+	recorder := keyusage.NewRecorder(auditLogger, nil, logger.TestLogger(t))
+	servicetest.Run(t, recorder)
+
+	job1, job2 := int32(1), int32(2)
+	for range 3 {
+		recorder.Record(context.Background(), keystore.KeyUsage{KeyType: "OCR2", KeyID: "bundle", Subsystem: "OCR2/median", JobID: &job1, PayloadHash: "aa"})
+	}
+	recorder.Record(context.Background(), keystore.KeyUsage{KeyType: "OCR2", KeyID: "bundle", Subsystem: "OCR2/median", JobID: &job2, PayloadHash: "bb"})
+	recorder.Record(context.Background(), keystore.KeyUsage{KeyType: "CSA", KeyID: "csa", Subsystem: "JobDistributor", PayloadHash: "cc"})
+
+	require.Len(t, auditLogger.events, 3)
+	assert.Equal(t, job1, auditLogger.events[0].data["jobID"])
+	assert.Equal(t, 1, auditLogger.events[0].data["signatures"])
+	assert.Equal(t, job2, auditLogger.events[1].data["jobID"])
+	assert.NotContains(t, auditLogger.events[2].data, "signatures")
+}
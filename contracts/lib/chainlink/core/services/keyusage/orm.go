@@ -0,0 +1,97 @@
+package keyusage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// Entry is a signature recorded in the key usage log.
+type Entry struct {
+	ID          int64     `db:"id"`
+	KeyType     string    `db:"key_type"`
+	KeyID       string    `db:"key_id"`
+	Subsystem   string    `db:"subsystem"`
+	JobID       *int32    `db:"job_id"`
+	PayloadHash string    `db:"payload_hash"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// Filter restricts the entries returned by FindEntries. Zero fields match all the entries.
+type Filter struct {
+	KeyType   string
+	KeyID     string
+	Subsystem string
+	JobID     *int32
+	Since     time.Time
+	Until     time.Time
+}
+
+// ORM stores the key usage log, which is append-only.
+type ORM interface {
+	InsertEntries(ctx context.Context, entries []Entry) error
+	// FindEntries returns the entries matching filter, most recent first, and their total count.
+	FindEntries(ctx context.Context, filter Filter, offset, limit int) ([]Entry, int, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) InsertEntries(ctx context.Context, entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	_, err := o.ds.NamedExecContext(ctx, `INSERT INTO key_usage_log (key_type, key_id, subsystem, job_id, payload_hash, created_at)
+		VALUES (:key_type, :key_id, :subsystem, :job_id, :payload_hash, :created_at)`, entries)
+	return err
+}
+
+func (o *orm) FindEntries(ctx context.Context, filter Filter, offset, limit int) (entries []Entry, count int, err error) {
+	var conds []string
+	var args []any
+	where := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.KeyType != "" {
+		where("key_type = $%d", filter.KeyType)
+	}
+	if filter.KeyID != "" {
+		where("key_id = $%d", filter.KeyID)
+	}
+	if filter.Subsystem != "" {
+		where("subsystem = $%d", filter.Subsystem)
+	}
+	if filter.JobID != nil {
+		where("job_id = $%d", *filter.JobID)
+	}
+	if !filter.Since.IsZero() {
+		where("created_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		where("created_at < $%d", filter.Until)
+	}
+	clause := ""
+	if len(conds) > 0 {
+		clause = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	if err = o.ds.GetContext(ctx, &count, "SELECT count(*) FROM key_usage_log"+clause, args...); err != nil {
+		return nil, 0, err
+	}
+	query := fmt.Sprintf("SELECT * FROM key_usage_log%s ORDER BY id DESC LIMIT $%d OFFSET $%d", clause, len(args)+1, len(args)+2)
+	if err = o.ds.SelectContext(ctx, &entries, query, append(args, limit, offset)...); err != nil {
+		return nil, 0, err
+	}
+	return entries, count, nil
+}
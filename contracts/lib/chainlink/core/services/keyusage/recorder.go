@@ -0,0 +1,189 @@
+// Package keyusage keeps the audit trail of the signatures produced with the keys of the keystore.
+package keyusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+const (
+	ServiceName = "KeyUsageRecorder"
+
+	bufferSize    = 4096
+	batchSize     = 500
+	flushInterval = time.Second
+	flushTimeout  = 10 * time.Second
+
+	// ocr2AuditInterval is the minimum interval between the audit events of the signatures made
+	// with an OCR2 key for a given job, which sign every round.
+	ocr2AuditInterval = time.Minute
+)
+
+// aggregateKey identifies the signatures whose audit events are aggregated together.
+type aggregateKey struct {
+	keyID     string
+	subsystem string
+	jobID     int32
+}
+
+// aggregate counts the signatures made since the last audit event of an aggregateKey.
+type aggregate struct {
+	auditedAt time.Time
+	count     int
+}
+
+// Recorder emits an audit event for every signature reported by the keystore and, if it has an
+// ORM, appends it to the key usage log.
+type Recorder struct {
+	services.Service
+	eng *services.Engine
+
+	auditLogger audit.AuditLogger
+	orm         ORM
+	entries     chan Entry
+
+	mu         sync.Mutex
+	aggregates map[aggregateKey]*aggregate
+}
+
+// NewRecorder returns a Recorder emitting audit events to auditLogger. The signatures are only
+// stored if orm is not nil.
+func NewRecorder(auditLogger audit.AuditLogger, orm ORM, lggr logger.Logger) *Recorder {
+	r := &Recorder{
+		auditLogger: auditLogger,
+		orm:         orm,
+		entries:     make(chan Entry, bufferSize),
+		aggregates:  make(map[aggregateKey]*aggregate),
+	}
+	r.Service, r.eng = services.Config{
+		Name:  ServiceName,
+		Start: r.start,
+	}.NewServiceEngine(lggr)
+	return r
+}
+
+func (r *Recorder) start(context.Context) error {
+	if r.orm != nil {
+		r.eng.Go(r.run)
+	}
+	return nil
+}
+
+// Record implements keystore.KeyUsageRecorder. It never blocks: entries are dropped if the
+// store falls behind. The signatures made with OCR2 keys are all stored, but audited at most once
+// every ocr2AuditInterval per key and job, with the number of signatures they stand for.
+func (r *Recorder) Record(_ context.Context, usage keystore.KeyUsage) {
+	r.audit(usage)
+
+	if r.orm == nil {
+		return
+	}
+	entry := Entry{
+		KeyType:     usage.KeyType,
+		KeyID:       usage.KeyID,
+		Subsystem:   usage.Subsystem,
+		JobID:       usage.JobID,
+		PayloadHash: usage.PayloadHash,
+		CreatedAt:   time.Now(),
+	}
+	select {
+	case r.entries <- entry:
+	default:
+		r.eng.Errorw("Key usage log buffer is full, dropping entry", "keyType", usage.KeyType, "keyID", usage.KeyID, "subsystem", usage.Subsystem)
+	}
+}
+
+func (r *Recorder) audit(usage keystore.KeyUsage) {
+	data := audit.Data{
+		"keyType":     usage.KeyType,
+		"keyID":       usage.KeyID,
+		"subsystem":   usage.Subsystem,
+		"payloadHash": usage.PayloadHash,
+	}
+	if usage.JobID != nil {
+		data["jobID"] = *usage.JobID
+	}
+	if usage.KeyType == "OCR2" {
+		count, ok := r.aggregateOCR2(usage, time.Now())
+		if !ok {
+			return
+		}
+		data["signatures"] = count
+	}
+	r.auditLogger.Audit(audit.KeyUsed, data)
+}
+
+// aggregateOCR2 counts the signature, and reports whether it is time to audit the signatures
+// counted since the last audit event of its key and job, and how many there are.
+func (r *Recorder) aggregateOCR2(usage keystore.KeyUsage, now time.Time) (int, bool) {
+	key := aggregateKey{keyID: usage.KeyID, subsystem: usage.Subsystem}
+	if usage.JobID != nil {
+		key.jobID = *usage.JobID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	agg, ok := r.aggregates[key]
+	if !ok {
+		agg = &aggregate{}
+		r.aggregates[key] = agg
+	}
+	agg.count++
+	if !agg.auditedAt.IsZero() && now.Sub(agg.auditedAt) < ocr2AuditInterval {
+		return 0, false
+	}
+	count := agg.count
+	agg.auditedAt, agg.count = now, 0
+	return count, true
+}
+
+func (r *Recorder) run(ctx context.Context) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Entry, 0, batchSize)
+	flush := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+		if err := r.orm.InsertEntries(ctx, batch); err != nil {
+			r.eng.Errorw("Failed to store key usage entries", "count", len(batch), "err", err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case entry := <-r.entries:
+			batch = append(batch, entry)
+			if len(batch) >= batchSize {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		case <-ctx.Done():
+			// store what was recorded before closing
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), flushTimeout)
+			defer cancel()
+			for {
+				select {
+				case entry := <-r.entries:
+					batch = append(batch, entry)
+					if len(batch) >= batchSize {
+						flush(ctx)
+					}
+				default:
+					flush(ctx)
+					return
+				}
+			}
+		}
+	}
+}
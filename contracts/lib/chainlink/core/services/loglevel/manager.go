@@ -0,0 +1,94 @@
+package loglevel
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	ServiceName = "LogLevelOverrides"
+
+	// refreshInterval is how often expired overrides are deleted, and the overrides of job types are applied to the
+	// jobs created since.
+	refreshInterval = time.Minute
+)
+
+// Manager stores the log level overrides, and applies them to the loggers of the jobs.
+type Manager struct {
+	services.Service
+	eng *services.Engine
+
+	orm ORM
+}
+
+func NewManager(orm ORM, lggr logger.Logger) *Manager {
+	m := &Manager{orm: orm}
+	m.Service, m.eng = services.Config{
+		Name:  ServiceName,
+		Start: m.start,
+		Close: m.close,
+	}.NewServiceEngine(lggr)
+	return m
+}
+
+func (m *Manager) start(ctx context.Context) error {
+	if err := m.apply(ctx); err != nil {
+		return err
+	}
+	m.eng.GoTick(services.NewTicker(refreshInterval), m.refresh)
+	return nil
+}
+
+func (m *Manager) close() error {
+	logger.SetJobLevelOverrides(nil)
+	return nil
+}
+
+// Overrides returns the overrides which have not expired.
+func (m *Manager) Overrides(ctx context.Context) ([]Override, error) {
+	return m.orm.ListOverrides(ctx)
+}
+
+// SetOverride validates and stores o, replacing any override of the same job or job type, and applies it at once.
+func (m *Manager) SetOverride(ctx context.Context, o *Override) error {
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = time.Now()
+	}
+	if err := o.Validate(); err != nil {
+		return err
+	}
+	if err := m.orm.UpsertOverride(ctx, o); err != nil {
+		return err
+	}
+	return m.apply(ctx)
+}
+
+// DeleteOverride deletes the override with the given ID, or returns sql.ErrNoRows, and applies the remaining ones.
+func (m *Manager) DeleteOverride(ctx context.Context, id int64) error {
+	if err := m.orm.DeleteOverride(ctx, id); err != nil {
+		return err
+	}
+	return m.apply(ctx)
+}
+
+func (m *Manager) apply(ctx context.Context) error {
+	levels, err := m.orm.JobLevels(ctx)
+	if err != nil {
+		return err
+	}
+	logger.SetJobLevelOverrides(levels)
+	return nil
+}
+
+func (m *Manager) refresh(ctx context.Context) {
+	if err := m.orm.DeleteExpiredOverrides(ctx); err != nil {
+		m.eng.Errorw("Failed to delete expired log level overrides", "err", err)
+	}
+	if err := m.apply(ctx); err != nil {
+		m.eng.Errorw("Failed to apply log level overrides", "err", err)
+	}
+}
@@ -0,0 +1,128 @@
+// Package loglevel keeps the log level overrides of jobs, which raise or lower the log level of the loggers of a single
+// job, or of all the jobs of a type, independently of the global log level.
+package loglevel
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// Override is the log level of the loggers of a job, or of all the jobs of a type. The override of a job takes
+// precedence over the one of its type.
+type Override struct {
+	ID        int64     `db:"id"`
+	JobID     *int32    `db:"job_id"`
+	JobType   *string   `db:"job_type"`
+	Level     string    `db:"level"`
+	ExpiresAt null.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Validate returns an error if o does not target exactly one job or job type, or if its level is invalid.
+func (o Override) Validate() error {
+	if (o.JobID == nil) == (o.JobType == nil) {
+		return errors.New("exactly one of job ID or job type must be set")
+	}
+	if o.JobType != nil && !job.Type(*o.JobType).IsValid() {
+		return fmt.Errorf("invalid job type: %s", *o.JobType)
+	}
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(o.Level)); err != nil {
+		return err
+	}
+	if o.ExpiresAt.Valid && !o.ExpiresAt.Time.After(o.CreatedAt) {
+		return errors.New("expiry must be in the future")
+	}
+	return nil
+}
+
+// ORM stores the log level overrides.
+type ORM interface {
+	// UpsertOverride stores o, replacing any override of the same job or job type.
+	UpsertOverride(ctx context.Context, o *Override) error
+	// ListOverrides returns the overrides which have not expired.
+	ListOverrides(ctx context.Context) ([]Override, error)
+	// DeleteOverride deletes the override with the given ID, or returns sql.ErrNoRows.
+	DeleteOverride(ctx context.Context, id int64) error
+	DeleteExpiredOverrides(ctx context.Context) error
+	// JobLevels returns the effective overrides of the jobs, keyed by job ID.
+	JobLevels(ctx context.Context) (map[int32]logger.LevelOverride, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) UpsertOverride(ctx context.Context, override *Override) error {
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM log_level_overrides WHERE job_id = $1 OR job_type = $2`, override.JobID, override.JobType); err != nil {
+			return err
+		}
+		return tx.GetContext(ctx, &override.ID, `INSERT INTO log_level_overrides (job_id, job_type, level, expires_at, created_at)
+			VALUES ($1, $2, $3, $4, $5) RETURNING id`, override.JobID, override.JobType, override.Level, override.ExpiresAt, override.CreatedAt)
+	})
+}
+
+func (o *orm) ListOverrides(ctx context.Context) (overrides []Override, err error) {
+	err = o.ds.SelectContext(ctx, &overrides, `SELECT * FROM log_level_overrides WHERE expires_at IS NULL OR expires_at > now() ORDER BY id`)
+	return
+}
+
+func (o *orm) DeleteOverride(ctx context.Context, id int64) error {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM log_level_overrides WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) DeleteExpiredOverrides(ctx context.Context) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM log_level_overrides WHERE expires_at <= now()`)
+	return err
+}
+
+func (o *orm) JobLevels(ctx context.Context) (map[int32]logger.LevelOverride, error) {
+	var rows []struct {
+		JobID     int32     `db:"job_id"`
+		Level     string    `db:"level"`
+		ExpiresAt null.Time `db:"expires_at"`
+	}
+	err := o.ds.SelectContext(ctx, &rows, `SELECT DISTINCT ON (jobs.id) jobs.id AS job_id, o.level, o.expires_at
+		FROM log_level_overrides o JOIN jobs ON jobs.id = o.job_id OR jobs.type = o.job_type
+		WHERE o.expires_at IS NULL OR o.expires_at > now()
+		ORDER BY jobs.id, o.job_id IS NULL`)
+	if err != nil {
+		return nil, err
+	}
+	levels := make(map[int32]logger.LevelOverride, len(rows))
+	for _, r := range rows {
+		var lvl zapcore.Level
+		if err = lvl.UnmarshalText([]byte(r.Level)); err != nil {
+			return nil, err
+		}
+		levels[r.JobID] = logger.LevelOverride{Level: lvl, Expires: r.ExpiresAt.Time}
+	}
+	return levels, nil
+}
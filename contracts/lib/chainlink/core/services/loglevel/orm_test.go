@@ -0,0 +1,100 @@
+package loglevel_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/loglevel"
+)
+
+func TestORM_Overrides(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := loglevel.NewORM(db)
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	other, _ := cltest.MustInsertWebhookSpec(t, db)
+	now := time.Now()
+	jobType := string(job.Webhook)
+
+	byType := loglevel.Override{JobType: &jobType, Level: "warn", CreatedAt: now}
+	require.NoError(t, orm.UpsertOverride(ctx, &byType))
+	byJob := loglevel.Override{JobID: &jb.ID, Level: "info", CreatedAt: now}
+	require.NoError(t, orm.UpsertOverride(ctx, &byJob))
+
+	levels, err := orm.JobLevels(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, zapcore.InfoLevel, levels[jb.ID].Level, "the override of a job takes precedence over its type")
+	assert.Equal(t, zapcore.WarnLevel, levels[other.ID].Level)
+
+	// Replaces the override of the job.
+	byJob = loglevel.Override{JobID: &jb.ID, Level: "debug", ExpiresAt: null.TimeFrom(now.Add(time.Hour)), CreatedAt: now}
+	require.NoError(t, orm.UpsertOverride(ctx, &byJob))
+	overrides, err := orm.ListOverrides(ctx)
+	require.NoError(t, err)
+	require.Len(t, overrides, 2)
+	assert.Equal(t, "debug", overrides[1].Level)
+
+	levels, err = orm.JobLevels(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, zapcore.DebugLevel, levels[jb.ID].Level)
+	assert.WithinDuration(t, now.Add(time.Hour), levels[jb.ID].Expires, time.Second)
+
+	require.NoError(t, orm.DeleteOverride(ctx, byType.ID))
+	require.ErrorIs(t, orm.DeleteOverride(ctx, byType.ID), sql.ErrNoRows)
+	levels, err = orm.JobLevels(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, levels, other.ID)
+
+	_, err = db.ExecContext(ctx, `UPDATE log_level_overrides SET expires_at = now() - interval '1 second'`)
+	require.NoError(t, err)
+	overrides, err = orm.ListOverrides(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
+	levels, err = orm.JobLevels(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, levels)
+
+	require.NoError(t, orm.DeleteExpiredOverrides(ctx))
+	require.ErrorIs(t, orm.DeleteOverride(ctx, byJob.ID), sql.ErrNoRows)
+}
+
+func TestOverride_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	jobID, jobType, badType := int32(1), "cron", "unknown"
+	for _, tt := range []struct {
+		name     string
+		override loglevel.Override
+		err      string
+	}{
+		{"job", loglevel.Override{JobID: &jobID, Level: "debug", CreatedAt: now}, ""},
+		{"job type", loglevel.Override{JobType: &jobType, Level: "error", ExpiresAt: null.TimeFrom(now.Add(time.Minute)), CreatedAt: now}, ""},
+		{"no target", loglevel.Override{Level: "debug", CreatedAt: now}, "exactly one of job ID or job type must be set"},
+		{"both targets", loglevel.Override{JobID: &jobID, JobType: &jobType, Level: "debug", CreatedAt: now}, "exactly one of job ID or job type must be set"},
+		{"invalid job type", loglevel.Override{JobType: &badType, Level: "debug", CreatedAt: now}, "invalid job type: unknown"},
+		{"invalid level", loglevel.Override{JobID: &jobID, Level: "loud", CreatedAt: now}, "unrecognized level"},
+		{"expired", loglevel.Override{JobID: &jobID, Level: "debug", ExpiresAt: null.TimeFrom(now), CreatedAt: now}, "expiry must be in the future"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.override.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
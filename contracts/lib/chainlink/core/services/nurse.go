@@ -1,19 +1,26 @@
 package services
 
 import (
+	"archive/zip"
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/pprof/profile"
@@ -21,8 +28,10 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/s3"
 )
 
 type Nurse struct {
@@ -35,6 +44,11 @@ type Nurse struct {
 	checksMu sync.RWMutex
 
 	chGather chan gatherRequest
+
+	// gathering is the ID of the capture in progress, or 0
+	gathering atomic.Int64
+
+	upload *profileUpload
 }
 
 type Config interface {
@@ -49,6 +63,7 @@ type Config interface {
 	MutexProfileFraction() int
 	PollInterval() commonconfig.Duration
 	ProfileRoot() string
+	Upload() config.AutoPprofUpload
 }
 
 type CheckFunc func() (unwell bool, meta Meta)
@@ -56,13 +71,48 @@ type CheckFunc func() (unwell bool, meta Meta)
 type gatherRequest struct {
 	reason string
 	meta   Meta
+	at     time.Time
 }
 
 type Meta map[string]interface{}
 
+// ProfileCapture is a set of profiles gathered at the same time, after a check tripped or on demand. Its ID is the
+// time at which it started, in microseconds since the epoch, which prefixes the names of its files.
+type ProfileCapture struct {
+	ID         string
+	Time       time.Time
+	Reason     string
+	Meta       Meta
+	Files      []string
+	Size       int64
+	InProgress bool
+}
+
+// captureMeta is the content of the meta file of a capture.
+type captureMeta struct {
+	Time   time.Time `json:"time"`
+	Reason string    `json:"reason"`
+	Meta   Meta      `json:"meta,omitempty"`
+}
+
+type profileUpload struct {
+	client  *s3.Client
+	prefix  string
+	timeout time.Duration
+}
+
+var (
+	ErrCaptureNotFound     = errors.New("profile capture not found")
+	ErrCaptureInProgress   = errors.New("profile capture is in progress")
+	ErrCapturePending      = errors.New("another profile capture is already pending")
+	ErrProfileSizeExceeded = errors.New("total profile size exceeds AutoPprof.MaxProfileSize")
+)
+
 const (
 	cpuProfName   = "cpu"
 	traceProfName = "trace"
+
+	metaFileSuffix = ".meta.json"
 )
 
 func NewNurse(cfg Config, log logger.Logger) *Nurse {
@@ -76,6 +126,18 @@ func NewNurse(cfg Config, log logger.Logger) *Nurse {
 		Start: n.start,
 	}.NewServiceEngine(log)
 
+	if upload := cfg.Upload(); upload.Enabled() {
+		if endpoint := upload.Endpoint(); endpoint != nil {
+			n.upload = &profileUpload{
+				client:  s3.NewClient(*endpoint, upload.Bucket(), upload.Region(), upload.AccessKeyID(), upload.SecretAccessKey(), upload.Timeout()),
+				prefix:  upload.Prefix(),
+				timeout: upload.Timeout(),
+			}
+		} else {
+			n.eng.Error("AutoPprof.Upload.Endpoint is required when uploads are enabled, profiles will not be uploaded")
+		}
+	}
+
 	return n
 }
 
@@ -117,7 +179,7 @@ func (n *Nurse) start(_ context.Context) error {
 			case <-ctx.Done():
 				return
 			case req := <-n.chGather:
-				n.gatherVitals(req.reason, req.meta)
+				n.gatherVitals(ctx, req)
 			}
 		}
 	})
@@ -134,11 +196,147 @@ func (n *Nurse) AddCheck(reason string, checkFunc CheckFunc) {
 func (n *Nurse) GatherVitals(ctx context.Context, reason string, meta Meta) {
 	select {
 	case <-ctx.Done():
-	case n.chGather <- gatherRequest{reason, meta}:
+	case n.chGather <- gatherRequest{reason: reason, meta: meta, at: time.Now()}:
+	default:
+	}
+}
+
+// RequestCapture requests a capture of the profiles for the given reason, and returns its ID. Unlike GatherVitals, it
+// returns an error if the capture would not be gathered, because another one is already pending or because the
+// profiles already exceed MaxProfileSize.
+func (n *Nurse) RequestCapture(ctx context.Context, reason string, meta Meta) (string, error) {
+	if err := n.Ready(); err != nil {
+		return "", err
+	}
+	size, err := n.totalProfileBytes()
+	if err != nil {
+		return "", err
+	} else if size >= uint64(n.cfg.MaxProfileSize()) {
+		return "", ErrProfileSizeExceeded
+	}
+
+	req := gatherRequest{reason: reason, meta: meta, at: time.Now()}
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case n.chGather <- req:
+		return strconv.FormatInt(req.at.UnixMicro(), 10), nil
 	default:
+		return "", ErrCapturePending
 	}
 }
 
+// Captures returns the profile captures stored in ProfileRoot, most recent first.
+func (n *Nurse) Captures() ([]ProfileCapture, error) {
+	profiles, err := n.listProfiles()
+	if err != nil {
+		return nil, err
+	}
+	gathering := n.gathering.Load()
+	byID := make(map[string]*ProfileCapture)
+	for _, p := range profiles {
+		id, micros, ok := parseCaptureID(p.Name())
+		if !ok {
+			continue
+		}
+		c, ok := byID[id]
+		if !ok {
+			c = &ProfileCapture{ID: id, Time: time.UnixMicro(micros), InProgress: micros == gathering}
+			byID[id] = c
+		}
+		c.Files = append(c.Files, p.Name())
+		c.Size += p.Size()
+		if strings.HasSuffix(p.Name(), metaFileSuffix) {
+			meta, err := n.readMeta(p.Name())
+			if err != nil {
+				n.eng.Warnw("could not read profile capture meta", "file", p.Name(), "err", err)
+				continue
+			}
+			c.Reason, c.Meta = meta.Reason, meta.Meta
+		}
+	}
+
+	captures := make([]ProfileCapture, 0, len(byID))
+	for _, c := range byID {
+		sort.Strings(c.Files)
+		captures = append(captures, *c)
+	}
+	sort.Slice(captures, func(i, j int) bool { return captures[i].Time.After(captures[j].Time) })
+	return captures, nil
+}
+
+// WriteCapturesZip writes the files of the captures with the given IDs to w as a zip archive, or of all the completed
+// captures if no ID is given. Unknown and in progress captures are reported before anything is written.
+func (n *Nurse) WriteCapturesZip(w io.Writer, ids ...string) error {
+	captures, err := n.Captures()
+	if err != nil {
+		return err
+	}
+	var selected []ProfileCapture
+	if len(ids) == 0 {
+		for _, c := range captures {
+			if !c.InProgress {
+				selected = append(selected, c)
+			}
+		}
+	}
+	for _, id := range ids {
+		i := slices.IndexFunc(captures, func(c ProfileCapture) bool { return c.ID == id })
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrCaptureNotFound, id)
+		} else if captures[i].InProgress {
+			return fmt.Errorf("%w: %s", ErrCaptureInProgress, id)
+		}
+		selected = append(selected, captures[i])
+	}
+
+	zw := zip.NewWriter(w)
+	for _, c := range selected {
+		for _, name := range c.Files {
+			if err := n.addToZip(zw, name); err != nil {
+				return err
+			}
+		}
+	}
+	return zw.Close()
+}
+
+func (n *Nurse) addToZip(zw *zip.Writer, name string) error {
+	f, err := os.Open(filepath.Join(n.cfg.ProfileRoot(), name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Method = zip.Deflate
+	fw, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, f)
+	return err
+}
+
+// parseCaptureID returns the ID of the capture of a profile file, and the time it started at in microseconds.
+func parseCaptureID(name string) (string, int64, bool) {
+	id, _, ok := strings.Cut(name, ".")
+	if !ok {
+		return "", 0, false
+	}
+	micros, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return id, micros, true
+}
+
 func (n *Nurse) checkMem() (bool, Meta) {
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
@@ -164,7 +362,8 @@ func (n *Nurse) checkGoroutines() (bool, Meta) {
 	}
 }
 
-func (n *Nurse) gatherVitals(reason string, meta Meta) {
+func (n *Nurse) gatherVitals(ctx context.Context, req gatherRequest) {
+	reason, meta := req.reason, req.meta
 	loggerFields := (logger.Fields{"reason": reason}).Merge(logger.Fields(meta))
 
 	n.eng.Debugw("Nurse is gathering vitals", loggerFields.Slice()...)
@@ -180,13 +379,20 @@ func (n *Nurse) gatherVitals(reason string, meta Meta) {
 		return
 	}
 
-	now := time.Now()
+	now := req.at
+	n.gathering.Store(now.UnixMicro())
+	defer n.gathering.Store(0)
 
 	err = n.appendLog(now, reason, meta)
 	if err != nil {
 		n.eng.Warnw("cannot write pprof profile", loggerFields.With("err", err).Slice()...)
 		return
 	}
+	err = n.writeMeta(now, reason, meta)
+	if err != nil {
+		n.eng.Warnw("cannot write pprof profile", loggerFields.With("err", err).Slice()...)
+		return
+	}
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go n.gatherCPU(now, &wg)
@@ -221,8 +427,55 @@ func (n *Nurse) gatherVitals(reason string, meta Meta) {
 
 	select {
 	case <-n.eng.StopChan:
+		return
 	case <-ch:
 	}
+
+	if n.upload != nil {
+		ctx, cancel := context.WithTimeout(ctx, n.upload.timeout)
+		defer cancel()
+		if err := n.uploadCapture(ctx, now); err != nil {
+			n.eng.Errorw("could not upload pprof profiles", loggerFields.With("err", err).Slice()...)
+		}
+	}
+}
+
+// uploadCapture uploads the files of the capture started at now, as <Prefix><ID>/<file>.
+func (n *Nurse) uploadCapture(ctx context.Context, now time.Time) error {
+	id := strconv.FormatInt(now.UnixMicro(), 10)
+	profiles, err := n.listProfiles()
+	if err != nil {
+		return err
+	}
+	for _, p := range profiles {
+		if !strings.HasPrefix(p.Name(), id+".") {
+			continue
+		}
+		key := n.upload.prefix + id + "/" + p.Name()
+		if err := n.upload.client.PutFile(ctx, key, filepath.Join(n.cfg.ProfileRoot(), p.Name())); err != nil {
+			return err
+		}
+	}
+	n.eng.Infow("Uploaded pprof profiles", "id", id, "prefix", n.upload.prefix)
+	return nil
+}
+
+func (n *Nurse) writeMeta(now time.Time, reason string, meta Meta) error {
+	b, err := json.Marshal(captureMeta{Time: now, Reason: reason, Meta: meta})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(n.cfg.ProfileRoot(), fmt.Sprintf("%v%s", now.UnixMicro(), metaFileSuffix)), b, 0600)
+}
+
+func (n *Nurse) readMeta(name string) (captureMeta, error) {
+	var meta captureMeta
+	b, err := os.ReadFile(filepath.Join(n.cfg.ProfileRoot(), name))
+	if err != nil {
+		return meta, err
+	}
+	err = json.Unmarshal(b, &meta)
+	return meta, err
 }
 
 func (n *Nurse) appendLog(now time.Time, reason string, meta Meta) error {
@@ -450,7 +703,8 @@ func (n *Nurse) listProfiles() ([]fs.FileInfo, error) {
 		if entry.IsDir() ||
 			(filepath.Ext(entry.Name()) != ".pprof" &&
 				entry.Name() != "nurse.log" &&
-				!strings.HasSuffix(entry.Name(), ".pprof.gz")) {
+				!strings.HasSuffix(entry.Name(), ".pprof.gz") &&
+				!strings.HasSuffix(entry.Name(), metaFileSuffix)) {
 			continue
 		}
 		info, err := entry.Info()
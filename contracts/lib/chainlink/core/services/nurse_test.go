@@ -1,6 +1,9 @@
 package services
 
 import (
+	"archive/zip"
+	"bytes"
+	"net/url"
 	"runtime"
 	"strings"
 	"testing"
@@ -10,7 +13,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/s3test"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -28,6 +33,7 @@ type mockConfig struct {
 	mutexProfileFraction int
 	memThreshold         utils.FileSize
 	goroutineThreshold   int
+	upload               mockUploadConfig
 }
 
 var (
@@ -97,6 +103,23 @@ func (c mockConfig) GoroutineThreshold() int {
 	return c.goroutineThreshold
 }
 
+func (c mockConfig) Upload() config.AutoPprofUpload {
+	return c.upload
+}
+
+type mockUploadConfig struct {
+	endpoint *url.URL
+}
+
+func (u mockUploadConfig) Enabled() bool           { return u.endpoint != nil }
+func (u mockUploadConfig) Endpoint() *url.URL      { return u.endpoint }
+func (u mockUploadConfig) Bucket() string          { return "profiles" }
+func (u mockUploadConfig) Region() string          { return "us-east-1" }
+func (u mockUploadConfig) Prefix() string          { return "node-1/" }
+func (u mockUploadConfig) Timeout() time.Duration  { return time.Minute }
+func (u mockUploadConfig) AccessKeyID() string     { return s3test.AccessKeyID }
+func (u mockUploadConfig) SecretAccessKey() string { return s3test.SecretAccessKey }
+
 func TestNurse(t *testing.T) {
 	l := logger.TestLogger(t)
 	nrse := NewNurse(newMockConfig(t), l)
@@ -139,3 +162,59 @@ func profileExists(t *testing.T, nrse *Nurse, typ string) bool {
 	}
 	return false
 }
+
+func TestNurse_RequestCapture(t *testing.T) {
+	server := s3test.NewServer(t, "profiles")
+	endpoint := server.Endpoint(t)
+	cfg := newMockConfig(t)
+	// only on demand captures
+	cfg.memThreshold = utils.FileSize(1 << 62)
+	cfg.goroutineThreshold = 1 << 30
+	cfg.upload = mockUploadConfig{endpoint: &endpoint}
+	nrse := NewNurse(cfg, logger.TestLogger(t))
+
+	_, err := nrse.RequestCapture(t.Context(), "manual", nil)
+	require.Error(t, err, "the nurse is not started")
+
+	require.NoError(t, nrse.Start(t.Context()))
+	defer func() { require.NoError(t, nrse.Close()) }()
+
+	id, err := nrse.RequestCapture(t.Context(), "manual", Meta{"requested_by": "admin@example.com"})
+	require.NoError(t, err)
+
+	var capture ProfileCapture
+	testutils.AssertEventually(t, func() bool {
+		captures, err := nrse.Captures()
+		require.NoError(t, err)
+		if len(captures) != 1 || captures[0].InProgress || len(server.Keys()) == 0 {
+			return false
+		}
+		capture = captures[0]
+		return true
+	})
+	assert.Equal(t, id, capture.ID)
+	assert.Equal(t, "manual", capture.Reason)
+	assert.Equal(t, Meta{"requested_by": "admin@example.com"}, capture.Meta)
+	assert.Contains(t, capture.Files, id+".cpu.pprof")
+	assert.Contains(t, capture.Files, id+".trace.pprof.gz")
+	assert.Contains(t, capture.Files, id+metaFileSuffix)
+	assert.Positive(t, capture.Size)
+
+	var keys []string
+	for _, name := range capture.Files {
+		keys = append(keys, "node-1/"+id+"/"+name)
+	}
+	assert.Equal(t, keys, server.Keys())
+
+	var buf bytes.Buffer
+	require.NoError(t, nrse.WriteCapturesZip(&buf, id))
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, capture.Files, names)
+
+	require.ErrorIs(t, nrse.WriteCapturesZip(&buf, "42"), ErrCaptureNotFound)
+}
@@ -233,7 +233,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 		}
 
 		cid := chain.ID()
-		ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKeyStore, cid), cid)
+		ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKeyStore, cid).WithCaller("OCR", &jb.ID), cid)
 
 		transmitter, err := ocrcommon.NewTransmitter(
 			chain.TxManager(),
@@ -535,6 +535,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	if err != nil {
 		return nil, err
 	}
+	kb = keystore.AttributeKeyBundle(kb, "OCR2/"+string(spec.PluginType), jb.ID)
 
 	spec.CaptureEATelemetry = d.cfg.OCR2().CaptureEATelemetry()
 
@@ -1697,7 +1698,7 @@ func (d *Delegate) newServicesOCR2Keepers20(
 	}
 
 	cid := chain.ID()
-	ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKs, cid), cid)
+	ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKs, cid).WithCaller("OCR2/"+string(types.OCR2Keeper), &jb.ID), cid)
 	keeperProvider, rgstry, encoder, logProvider, err2 := ocr2keeper.EVMDependencies20(ctx, jb, d.ds, lggr, chain, ks)
 	if err2 != nil {
 		return nil, errors.Wrap(err2, "could not build dependencies for ocr2 keepers")
@@ -1830,7 +1831,7 @@ func (d *Delegate) newServicesOCR2Functions(
 		return nil, fmt.Errorf("functions is not available in LOOP Plugin mode: %w", stderrors.ErrUnsupported)
 	}
 	cid := chain.ID()
-	ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKs, cid), cid)
+	ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKs, cid).WithCaller("OCR2/"+string(types.Functions), &jb.ID), cid)
 	createPluginProvider := func(pluginType functionsRelay.FunctionsPluginType, relayerName string) (evmrelaytypes.FunctionsProvider, error) {
 		return evmrelay.NewFunctionsProvider(
 			ctx,
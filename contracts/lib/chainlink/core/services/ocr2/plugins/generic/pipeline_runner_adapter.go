@@ -32,6 +32,7 @@ func (p *PipelineRunnerAdapter) ExecuteRun(ctx context.Context, spec string, var
 		JobID:           p.job.ID,
 		JobName:         p.job.Name.ValueOrZero(),
 		JobType:         string(p.job.Type),
+		ExternalJobID:   p.job.ExternalJobID,
 	}
 
 	defaultVars := map[string]interface{}{
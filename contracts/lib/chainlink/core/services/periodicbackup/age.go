@@ -0,0 +1,20 @@
+package periodicbackup
+
+import (
+	"io"
+
+	"filippo.io/age"
+)
+
+// encryptAge encrypts src to recipient, writing the age file (https://age-encryption.org/v1) to
+// dst, so that backups can be decrypted with any age implementation.
+func encryptAge(dst io.Writer, src io.Reader, recipient age.Recipient) error {
+	w, err := age.Encrypt(dst, recipient)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(w, src); err != nil {
+		return err
+	}
+	return w.Close()
+}
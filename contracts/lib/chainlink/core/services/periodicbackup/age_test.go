@@ -0,0 +1,50 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAgeIdentity returns a new age X25519 identity and its encoded public key.
+func newAgeIdentity(t *testing.T) (*age.X25519Identity, string) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	return identity, identity.Recipient().String()
+}
+
+func decryptAge(t *testing.T, identity age.Identity, encrypted []byte) []byte {
+	r, err := age.Decrypt(bytes.NewReader(encrypted), identity)
+	require.NoError(t, err)
+	plaintext, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return plaintext
+}
+
+func TestAge_EncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	identity, publicKey := newAgeIdentity(t)
+	recipient, err := age.ParseX25519Recipient(publicKey)
+	require.NoError(t, err)
+
+	for name, size := range map[string]int{
+		"empty":       0,
+		"small":       42,
+		"many chunks": 3*64*1024 + 42,
+	} {
+		t.Run(name, func(t *testing.T) {
+			plaintext := make([]byte, size)
+			_, err := rand.Read(plaintext)
+			require.NoError(t, err)
+			var encrypted bytes.Buffer
+			require.NoError(t, encryptAge(&encrypted, bytes.NewReader(plaintext), recipient))
+			assert.Equal(t, plaintext, decryptAge(t, identity, encrypted.Bytes()))
+		})
+	}
+}
@@ -2,13 +2,17 @@ package periodicbackup
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
+	"filippo.io/age"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -16,10 +20,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/s3"
 )
 
 var (
 	filePattern        = "cl_backup_%s.dump"
+	timestampedPattern = "cl_backup_%s_%s.dump"
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -28,6 +34,11 @@ var (
 	}
 )
 
+const (
+	encryptedFileSuffix = ".age"
+	timestampFormat     = "20060102T150405Z"
+)
+
 type backupResult struct {
 	size            int64
 	path            string
@@ -48,7 +59,19 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		encryptTo       *age.X25519Recipient
+		retention       retentionPolicy
+		upload          *backupUpload
 		done            chan bool
+
+		lastBackupMu  sync.RWMutex
+		lastBackupErr error
+	}
+
+	backupUpload struct {
+		client  *s3.Client
+		prefix  string
+		timeout time.Duration
 	}
 
 	BackupConfig interface {
@@ -56,6 +79,10 @@ type (
 		Dir() string
 		Mode() config.DatabaseBackupMode
 		Frequency() time.Duration
+		EncryptionPublicKey() string
+		MaxBackups() uint32
+		MaxAge() time.Duration
+		Upload() config.BackupUpload
 	}
 )
 
@@ -76,14 +103,38 @@ func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig,
 		outputParentDir = dir
 	}
 
+	var encryptTo *age.X25519Recipient
+	if key := backupConfig.EncryptionPublicKey(); key != "" {
+		recipient, err := age.ParseX25519Recipient(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid Database.Backup.EncryptionPublicKey")
+		}
+		encryptTo = recipient
+	}
+
+	var upload *backupUpload
+	if uploadConfig := backupConfig.Upload(); uploadConfig.Enabled() {
+		endpoint := uploadConfig.Endpoint()
+		if endpoint == nil {
+			return nil, errors.New("Database.Backup.Upload.Endpoint is required when uploads are enabled")
+		}
+		upload = &backupUpload{
+			client:  s3.NewClient(*endpoint, uploadConfig.Bucket(), uploadConfig.Region(), uploadConfig.AccessKeyID(), uploadConfig.SecretAccessKey(), uploadConfig.Timeout()),
+			prefix:  uploadConfig.Prefix(),
+			timeout: uploadConfig.Timeout(),
+		}
+	}
+
 	return &databaseBackup{
-		services.StateMachine{},
-		lggr,
-		dbUrl,
-		backupConfig.Mode(),
-		backupConfig.Frequency(),
-		outputParentDir,
-		make(chan bool),
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		mode:            backupConfig.Mode(),
+		frequency:       backupConfig.Frequency(),
+		outputParentDir: outputParentDir,
+		encryptTo:       encryptTo,
+		retention:       retentionPolicy{maxBackups: int(backupConfig.MaxBackups()), maxAge: backupConfig.MaxAge()},
+		upload:          upload,
+		done:            make(chan bool),
 	}, nil
 }
 
@@ -128,8 +179,20 @@ func (backup *databaseBackup) Name() string {
 	return backup.logger.Name()
 }
 
+// HealthReport includes the status of the last backup, which is healthy until a backup fails.
 func (backup *databaseBackup) HealthReport() map[string]error {
-	return map[string]error{backup.Name(): backup.Healthy()}
+	backup.lastBackupMu.RLock()
+	defer backup.lastBackupMu.RUnlock()
+	return map[string]error{
+		backup.Name():                 backup.Healthy(),
+		backup.Name() + ".LastBackup": backup.lastBackupErr,
+	}
+}
+
+func (backup *databaseBackup) setLastBackupErr(err error) {
+	backup.lastBackupMu.Lock()
+	defer backup.lastBackupMu.Unlock()
+	backup.lastBackupErr = err
 }
 
 func (backup *databaseBackup) frequencyIsTooSmall() bool {
@@ -139,8 +202,12 @@ func (backup *databaseBackup) frequencyIsTooSmall() bool {
 func (backup *databaseBackup) RunBackup(version string) error {
 	backup.logger.Debugw("Starting backup", "mode", backup.mode, "directory", backup.outputParentDir)
 	startAt := time.Now()
-	result, err := backup.runBackup(version)
+	result, err := backup.runBackup(version, startAt)
+	if err == nil {
+		err = backup.postProcess(version, result, startAt)
+	}
 	duration := time.Since(startAt)
+	backup.setLastBackupErr(err)
 	if err != nil {
 		backup.logger.Criticalw("Backup failed", "duration", duration, "err", err)
 		backup.SvcErrBuffer.Append(err)
@@ -150,7 +217,85 @@ func (backup *databaseBackup) RunBackup(version string) error {
 	return nil
 }
 
-func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
+// postProcess applies the retention policy to the local backups, and uploads the new backup if
+// uploads are enabled, before applying the retention policy to the uploaded backups.
+func (backup *databaseBackup) postProcess(version string, result *backupResult, startAt time.Time) error {
+	var errs error
+	if backup.retention.enabled() {
+		deleted, err := backup.retention.pruneLocal(backup.outputParentDir, time.Now())
+		if len(deleted) > 0 {
+			backup.logger.Infow("Deleted expired backups", "paths", deleted)
+		}
+		errs = stderrors.Join(errs, err)
+	}
+
+	if backup.upload == nil {
+		return errs
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), backup.upload.timeout)
+	defer cancel()
+
+	key := backup.upload.prefix + backup.fileName(version, startAt, true)
+	if err := backup.upload.client.PutFile(ctx, key, result.path); err != nil {
+		return stderrors.Join(errs, err)
+	}
+	backup.logger.Infow("Uploaded backup", "key", key)
+
+	if backup.retention.enabled() {
+		deleted, err := backup.pruneUploads(ctx)
+		if len(deleted) > 0 {
+			backup.logger.Infow("Deleted expired uploaded backups", "keys", deleted)
+		}
+		errs = stderrors.Join(errs, err)
+	}
+	return errs
+}
+
+// pruneUploads deletes the expired backups from the bucket, returning their keys.
+func (backup *databaseBackup) pruneUploads(ctx context.Context) ([]string, error) {
+	objects, err := backup.upload.client.List(ctx, backup.upload.prefix+"cl_backup_")
+	if err != nil {
+		return nil, err
+	}
+	var backups []backupFile
+	for _, object := range objects {
+		if isBackupFile(strings.TrimPrefix(object.Key, backup.upload.prefix)) {
+			backups = append(backups, backupFile{object.Key, object.LastModified})
+		}
+	}
+
+	var deleted []string
+	var errs error
+	for _, key := range backup.retention.expired(backups, time.Now()) {
+		if err := backup.upload.client.Delete(ctx, key); err != nil {
+			errs = stderrors.Join(errs, err)
+			continue
+		}
+		deleted = append(deleted, key)
+	}
+	return deleted, errs
+}
+
+// fileName returns the name of the backup of version started at startAt. Unless timestamped, it is
+// the same for all the backups of a version.
+func (backup *databaseBackup) fileName(version string, startAt time.Time, timestamped bool) string {
+	if version == "" {
+		version = "unknown"
+	}
+	name := fmt.Sprintf(filePattern, version)
+	if timestamped {
+		name = fmt.Sprintf(timestampedPattern, version, startAt.UTC().Format(timestampFormat))
+	}
+	if backup.encryptTo != nil {
+		name += encryptedFileSuffix
+	}
+	return name
+}
+
+// runBackup dumps the database to the backup file of version. When a retention policy is set, each
+// backup is kept in its own timestamped file for the policy to prune, instead of overwriting the
+// previous backup of the version.
+func (backup *databaseBackup) runBackup(version string, startAt time.Time) (*backupResult, error) {
 	err := os.MkdirAll(backup.outputParentDir, os.ModePerm)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to create directories on the path: %s", backup.outputParentDir)
@@ -206,14 +351,19 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return partialResult, errors.Wrap(err, "pg_dump failed")
 	}
 
-	if version == "" {
-		version = "unknown"
+	finalFilePath := filepath.Join(backup.outputParentDir, backup.fileName(version, startAt, backup.retention.enabled()))
+	dumpPath := tmpFile.Name()
+	if backup.encryptTo != nil {
+		dumpPath, err = backup.encrypt(tmpFile.Name())
+		_ = os.Remove(tmpFile.Name())
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to encrypt the backup")
+		}
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
 	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	err = os.Rename(dumpPath, finalFilePath)
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
+		_ = os.Remove(dumpPath)
 		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
 	}
 
@@ -229,3 +379,26 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		pgDumpArguments: args,
 	}, nil
 }
+
+// encrypt encrypts the dump at path to a new temp file, returning its path.
+func (backup *databaseBackup) encrypt(path string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp(backup.outputParentDir, "cl_backup_tmp_")
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to create a tmp file")
+	}
+	err = encryptAge(dst, src, backup.encryptTo)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", err
+	}
+	return dst.Name(), nil
+}
@@ -5,6 +5,13 @@ DB_SUPER_USER="postgres"
 DB_USER="postgres"
 DB_NAME="chainlink_fallback_db"
 DB_HOST_PORT="localhost:5432"
+# Identity file of the Database.Backup.EncryptionPublicKey, to restore encrypted backups
+AGE_IDENTITY_FILE="${AGE_IDENTITY_FILE:-key.txt}"
+
+if [[ "$DB_FILE" == *.age ]]; then
+  age -d -i "$AGE_IDENTITY_FILE" -o "${DB_FILE%.age}" "$DB_FILE" || exit 1
+  DB_FILE="${DB_FILE%.age}"
+fi
 
 psql "postgresql://$DB_SUPER_USER@$DB_HOST_PORT/postgres" -c "CREATE DATABASE $DB_NAME"
 psql "postgresql://$DB_SUPER_USER@$DB_HOST_PORT/postgres" -c "GRANT ALL PRIVILEGES ON DATABASE $DB_NAME TO $DB_USER;"
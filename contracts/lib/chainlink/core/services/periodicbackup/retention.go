@@ -0,0 +1,80 @@
+package periodicbackup
+
+import (
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// retentionPolicy selects the backups to delete: only the maxBackups most recent ones are kept,
+// and none older than maxAge. A zero value disables the corresponding limit.
+type retentionPolicy struct {
+	maxBackups int
+	maxAge     time.Duration
+}
+
+func (p retentionPolicy) enabled() bool {
+	return p.maxBackups > 0 || p.maxAge > 0
+}
+
+type backupFile struct {
+	name    string
+	modTime time.Time
+}
+
+// expired returns the names of the backups to delete, according to the policy.
+func (p retentionPolicy) expired(backups []backupFile, now time.Time) []string {
+	sorted := make([]backupFile, len(backups))
+	copy(sorted, backups)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].modTime.After(sorted[j].modTime)
+	})
+
+	var names []string
+	for i, b := range sorted {
+		if (p.maxBackups > 0 && i >= p.maxBackups) || (p.maxAge > 0 && now.Sub(b.modTime) > p.maxAge) {
+			names = append(names, b.name)
+		}
+	}
+	return names
+}
+
+func isBackupFile(name string) bool {
+	return strings.HasPrefix(name, "cl_backup_") && (strings.HasSuffix(name, ".dump") || strings.HasSuffix(name, ".dump"+encryptedFileSuffix))
+}
+
+// pruneLocal deletes the expired backups from dir, returning their paths.
+func (p retentionPolicy) pruneLocal(dir string, now time.Time) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backups in %s", dir)
+	}
+	var backups []backupFile
+	for _, entry := range entries {
+		if entry.IsDir() || !isBackupFile(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to access backup %s", entry.Name())
+		}
+		backups = append(backups, backupFile{entry.Name(), info.ModTime()})
+	}
+
+	var deleted []string
+	var errs error
+	for _, name := range p.expired(backups, now) {
+		path := filepath.Join(dir, name)
+		if err := os.Remove(path); err != nil {
+			errs = stderrors.Join(errs, errors.Wrapf(err, "failed to delete backup %s", path))
+			continue
+		}
+		deleted = append(deleted, path)
+	}
+	return deleted, errs
+}
@@ -32,6 +32,7 @@ const (
 	CronJobType                    string = "cron"
 	CCIPJobType                    string = "ccip"
 	DirectRequestJobType           string = "directrequest"
+	EVMLogJobType                  string = "evmlog"
 	FluxMonitorJobType             string = "fluxmonitor"
 	GatewayJobType                 string = "gateway"
 	KeeperJobType                  string = "keeper"
@@ -42,6 +42,7 @@ func makeHTTPRequest(
 		return
 	}
 	request.Header.Set("Content-Type", "application/json")
+	injectTraceContext(ctx, request.Header)
 	if len(reqHeaders)%2 != 0 {
 		panic("headers must have an even number of elements")
 	}
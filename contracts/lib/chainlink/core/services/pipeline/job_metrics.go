@@ -0,0 +1,161 @@
+package pipeline
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var jobMetricLabels = []string{"job_id", "job_name", "job_type", "external_job_id"}
+
+var (
+	promJobRunsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "job_runs_total",
+		Help: "The total number of finished pipeline runs of each job, by status",
+	},
+		append(jobMetricLabels, "status"),
+	)
+	promJobRunDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "job_run_duration_seconds",
+		Help:    "How long the pipeline runs of each job took to finish, from the moment they were created",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300},
+	},
+		jobMetricLabels,
+	)
+	jobLastSuccesses = newLastSuccessCollector()
+)
+
+func init() {
+	prometheus.MustRegister(jobLastSuccesses)
+}
+
+// lastSuccessCollector reports the time since the last successful run of each job, computed when scraped. Until their
+// first successful run, the jobs which declare an expected run interval or liveness checks report the time since they
+// were started.
+type lastSuccessCollector struct {
+	desc *prometheus.Desc
+
+	mu   sync.Mutex
+	last map[int32]lastSuccess
+}
+
+type lastSuccess struct {
+	labels []string
+	at     time.Time
+	errors uint32
+}
+
+func newLastSuccessCollector() *lastSuccessCollector {
+	return &lastSuccessCollector{
+		desc: prometheus.NewDesc("job_seconds_since_last_successful_run",
+			"How long ago the last pipeline run of each job completed without fatal errors",
+			jobMetricLabels, nil),
+		last: make(map[int32]lastSuccess),
+	}
+}
+
+func (c *lastSuccessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *lastSuccessCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for _, l := range c.last {
+		if l.at.IsZero() {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, now.Sub(l.at).Seconds(), l.labels...)
+	}
+}
+
+func (c *lastSuccessCollector) set(spec Spec, at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.last[spec.JobID] = lastSuccess{labels: jobLabelValues(spec), at: at}
+}
+
+func (c *lastSuccessCollector) setErrored(spec Spec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l := c.last[spec.JobID]
+	l.labels = jobLabelValues(spec)
+	l.errors++
+	c.last[spec.JobID] = l
+}
+
+func (c *lastSuccessCollector) get(jobID int32) (lastSuccess, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l, ok := c.last[jobID]
+	return l, ok
+}
+
+func (c *lastSuccessCollector) delete(jobID int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.last, jobID)
+}
+
+func jobLabelValues(spec Spec) []string {
+	return []string{strconv.Itoa(int(spec.JobID)), spec.JobName, spec.JobType, spec.ExternalJobID.String()}
+}
+
+// JobRunStats summarizes the recent runs of a job.
+type JobRunStats struct {
+	// LastSuccess is when the last run completed without fatal errors, or when the job was started if it has not had
+	// a successful run since. It is zero if neither is known.
+	LastSuccess time.Time
+	// ConsecutiveErrors is the number of runs with fatal errors since the last successful run.
+	ConsecutiveErrors uint32
+}
+
+// GetJobRunStats returns the stats of the runs of a job, and false if none were recorded since it was started.
+func GetJobRunStats(jobID int32) (JobRunStats, bool) {
+	l, ok := jobLastSuccesses.get(jobID)
+	return JobRunStats{LastSuccess: l.at, ConsecutiveErrors: l.errors}, ok
+}
+
+// ObserveJobStarted starts reporting the time since the last successful run of the job of spec, counting from now.
+func ObserveJobStarted(spec Spec) {
+	if spec.JobID == 0 {
+		return
+	}
+	jobLastSuccesses.set(spec, time.Now())
+}
+
+// ForgetJobMetrics stops reporting the time since the last successful run of a stopped job.
+func ForgetJobMetrics(jobID int32) {
+	jobLastSuccesses.delete(jobID)
+}
+
+// DeleteJobMetrics removes all the metrics of a deleted job, so that its series are no longer exported.
+func DeleteJobMetrics(jobID int32) {
+	jobLastSuccesses.delete(jobID)
+	labels := prometheus.Labels{"job_id": strconv.Itoa(int(jobID))}
+	promJobRunsTotal.DeletePartialMatch(labels)
+	promJobRunDuration.DeletePartialMatch(labels)
+}
+
+// observeJobRun records the outcome and duration of a finished run in the job metrics. Runs without a job ID are not
+// recorded.
+func observeJobRun(run *Run, runTime time.Duration) {
+	spec := run.PipelineSpec
+	if spec.JobID == 0 {
+		return
+	}
+	labels := jobLabelValues(spec)
+	status := "completed"
+	if run.HasFatalErrors() {
+		status = "errored"
+		jobLastSuccesses.setErrored(spec)
+	} else {
+		jobLastSuccesses.set(spec, run.FinishedAt.Time)
+	}
+	promJobRunsTotal.WithLabelValues(append(labels, status)...).Inc()
+	promJobRunDuration.WithLabelValues(labels...).Observe(runTime.Seconds())
+}
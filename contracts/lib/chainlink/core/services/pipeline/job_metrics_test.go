@@ -0,0 +1,89 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestObserveJobRun(t *testing.T) {
+	spec := Spec{JobID: 4242, JobName: "metrics", JobType: "cron", ExternalJobID: uuid.New()}
+	labels := jobLabelValues(spec)
+	t.Cleanup(func() { ForgetJobMetrics(spec.JobID) })
+
+	finished := time.Now().Add(-time.Minute)
+	observeJobRun(&Run{PipelineSpec: spec, FinishedAt: null.TimeFrom(finished)}, time.Second)
+	observeJobRun(&Run{PipelineSpec: spec, FinishedAt: null.TimeFrom(time.Now()), FatalErrors: RunErrors{null.StringFrom("boom")}}, 2*time.Second)
+	observeJobRun(&Run{PipelineSpec: Spec{}, FinishedAt: null.TimeFrom(time.Now())}, time.Second)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(promJobRunsTotal.WithLabelValues(append(labels, "completed")...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(promJobRunsTotal.WithLabelValues(append(labels, "errored")...)))
+
+	stats, ok := GetJobRunStats(spec.JobID)
+	require.True(t, ok)
+	assert.Equal(t, uint32(1), stats.ConsecutiveErrors)
+	assert.WithinDuration(t, finished, stats.LastSuccess, time.Millisecond)
+
+	since := lastSuccessSeconds(t, spec.ExternalJobID.String())
+	require.NotNil(t, since, "the time since the last successful run is reported")
+	assert.InDelta(t, time.Minute.Seconds(), *since, 5)
+
+	ObserveJobStarted(spec)
+	stats, ok = GetJobRunStats(spec.JobID)
+	require.True(t, ok)
+	assert.Zero(t, stats.ConsecutiveErrors, "starting the job resets its errors")
+
+	ForgetJobMetrics(spec.JobID)
+	assert.Nil(t, lastSuccessSeconds(t, spec.ExternalJobID.String()))
+	_, ok = GetJobRunStats(spec.JobID)
+	assert.False(t, ok)
+
+	errored := Spec{JobID: 4243, ExternalJobID: uuid.New()}
+	t.Cleanup(func() { ForgetJobMetrics(errored.JobID) })
+	observeJobRun(&Run{PipelineSpec: errored, FinishedAt: null.TimeFrom(time.Now()), FatalErrors: RunErrors{null.StringFrom("boom")}}, time.Second)
+	assert.Nil(t, lastSuccessSeconds(t, errored.ExternalJobID.String()), "jobs without successful runs are not reported")
+}
+
+func TestDeleteJobMetrics(t *testing.T) {
+	spec := Spec{JobID: 4244, JobName: "deleted", JobType: "cron", ExternalJobID: uuid.New()}
+	other := Spec{JobID: 4245, JobName: "kept", JobType: "cron", ExternalJobID: uuid.New()}
+	t.Cleanup(func() {
+		DeleteJobMetrics(spec.JobID)
+		DeleteJobMetrics(other.JobID)
+	})
+	observeJobRun(&Run{PipelineSpec: spec, FinishedAt: null.TimeFrom(time.Now())}, time.Second)
+	observeJobRun(&Run{PipelineSpec: spec, FinishedAt: null.TimeFrom(time.Now()), FatalErrors: RunErrors{null.StringFrom("boom")}}, time.Second)
+	observeJobRun(&Run{PipelineSpec: other, FinishedAt: null.TimeFrom(time.Now())}, time.Second)
+	series := testutil.CollectAndCount(promJobRunsTotal)
+	durations := testutil.CollectAndCount(promJobRunDuration)
+
+	DeleteJobMetrics(spec.JobID)
+	assert.Equal(t, series-2, testutil.CollectAndCount(promJobRunsTotal))
+	assert.Equal(t, durations-1, testutil.CollectAndCount(promJobRunDuration))
+	assert.Nil(t, lastSuccessSeconds(t, spec.ExternalJobID.String()))
+	assert.NotNil(t, lastSuccessSeconds(t, other.ExternalJobID.String()))
+}
+
+func lastSuccessSeconds(t *testing.T, externalJobID string) *float64 {
+	reg := prometheus.NewPedanticRegistry()
+	require.NoError(t, reg.Register(jobLastSuccesses))
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "external_job_id" && l.GetValue() == externalJobID {
+					v := m.GetGauge().GetValue()
+					return &v
+				}
+			}
+		}
+	}
+	return nil
+}
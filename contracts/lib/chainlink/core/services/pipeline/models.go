@@ -27,9 +27,10 @@ type Spec struct {
 	GasLimit          *uint32         `json:"-"`
 	ForwardingAllowed bool            `json:"-"`
 
-	JobID   int32  `json:"-"`
-	JobName string `json:"-"`
-	JobType string `json:"-"`
+	JobID         int32     `json:"-"`
+	JobName       string    `json:"-"`
+	JobType       string    `json:"-"`
+	ExternalJobID uuid.UUID `json:"-"`
 
 	Pipeline *Pipeline `json:"-" db:"-"` // This may be nil, or may be populated manually as a cache. There is no locking on this, so be careful
 }
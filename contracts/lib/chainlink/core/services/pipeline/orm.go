@@ -647,7 +647,8 @@ func loadAssociations(ctx context.Context, ds sqlutil.DataSource, runs []*Run) e
 			ps.max_task_duration,
 			coalesce(jobs.id, 0) "job_id",
 			coalesce(jobs.name, '') "job_name",
-			coalesce(jobs.type, '') "job_type"
+			coalesce(jobs.type, '') "job_type",
+			coalesce(jobs.external_job_id, '00000000-0000-0000-0000-000000000000') "external_job_id"
 		FROM pipeline_specs ps
 		LEFT JOIN job_pipeline_specs jps ON jps.pipeline_spec_id=ps.id
 		LEFT JOIN jobs ON jobs.id=jps.job_id
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -55,8 +56,20 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+
+	// Drain stops starting new runs, which fail with ErrDraining, while the in-flight runs complete and the suspended
+	// runs can still be resumed. It blocks until no runs are in flight, or until ctx is done, in which case the runs
+	// still in flight are cancelled and ctx.Err() is returned.
+	Drain(ctx context.Context) error
+	// Undrain starts new runs again after Drain, which returns if it is still waiting.
+	Undrain()
+	// InFlightRuns returns the number of runs being executed.
+	InFlightRuns() int
 }
 
+// ErrDraining is returned when a new run is started while the runner is draining.
+var ErrDraining = errors.New("the node is draining, new pipeline runs are not started")
+
 type runner struct {
 	services.StateMachine
 	orm                    ORM
@@ -76,6 +89,13 @@ type runner struct {
 
 	chStop services.StopChan
 	wgDone sync.WaitGroup
+
+	drainMu  sync.Mutex
+	draining bool
+	inFlight int
+	chIdle   chan struct{}     // closed once draining with no runs in flight
+	chAbort  services.StopChan // closed to cancel the runs in flight when draining times out
+	aborted  bool
 }
 
 var (
@@ -131,6 +151,7 @@ func NewRunner(
 		vrfKeyStore:            vrfks,
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
+		chAbort:                make(chan struct{}),
 		runFinished:            func(*Run) {},
 		lggr:                   lggr,
 		httpClient:             httpClient,
@@ -195,6 +216,90 @@ func (r *runner) HealthReport() map[string]error {
 	return runnerHealth
 }
 
+func (r *runner) Drain(ctx context.Context) error {
+	r.drainMu.Lock()
+	if !r.draining {
+		r.draining = true
+		r.chIdle = make(chan struct{})
+		r.closeIdleIfDrained()
+		r.lggr.Infow("Draining, new runs are not started", "inFlightRuns", r.inFlight)
+	}
+	chIdle := r.chIdle
+	r.drainMu.Unlock()
+
+	select {
+	case <-chIdle:
+		return nil
+	case <-ctx.Done():
+	}
+
+	r.drainMu.Lock()
+	defer r.drainMu.Unlock()
+	// Undrain may have raced with ctx
+	if r.draining && !r.aborted && r.inFlight > 0 {
+		r.lggr.Warnw("Draining timed out, cancelling the runs in flight", "inFlightRuns", r.inFlight)
+		close(r.chAbort)
+		r.aborted = true
+	}
+	return ctx.Err()
+}
+
+func (r *runner) Undrain() {
+	r.drainMu.Lock()
+	defer r.drainMu.Unlock()
+	if !r.draining {
+		return
+	}
+	r.draining = false
+	select {
+	case <-r.chIdle:
+	default:
+		close(r.chIdle)
+	}
+	if r.aborted {
+		r.chAbort = make(chan struct{})
+		r.aborted = false
+	}
+	r.lggr.Info("Stopped draining, new runs are started again")
+}
+
+func (r *runner) InFlightRuns() int {
+	r.drainMu.Lock()
+	defer r.drainMu.Unlock()
+	return r.inFlight
+}
+
+// startRun records a run in flight, which is cancelled if draining times out, and returns the function to call when
+// it is done. New runs are refused while draining, but resumed runs are not, as they are already in flight.
+func (r *runner) startRun(ctx context.Context, resumed bool) (context.Context, func(), error) {
+	r.drainMu.Lock()
+	defer r.drainMu.Unlock()
+	if r.draining && !resumed {
+		return nil, nil, ErrDraining
+	}
+	r.inFlight++
+	ctx, cancel := r.chAbort.Ctx(ctx)
+	return ctx, func() {
+		cancel()
+		r.drainMu.Lock()
+		defer r.drainMu.Unlock()
+		r.inFlight--
+		r.closeIdleIfDrained()
+	}, nil
+}
+
+// closeIdleIfDrained signals Drain once no runs are in flight. drainMu must be held.
+func (r *runner) closeIdleIfDrained() {
+	if !r.draining || r.inFlight > 0 {
+		return
+	}
+	select {
+	case <-r.chIdle:
+	default:
+		close(r.chIdle)
+	}
+}
+
 func (r *runner) destroy() {
 	err := r.runReaperWorker.Stop()
 	if err != nil {
@@ -291,6 +396,12 @@ func overtimeContext(ctx context.Context) (context.Context, context.CancelFunc)
 }
 
 func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error) {
+	ctx, done, err := r.startRun(ctx, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer done()
+
 	// Pipeline runs may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
 	var cancel func()
@@ -383,6 +494,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		l.Debug("Initiating tasks for pipeline run of spec")
 	}
 
+	ctx, span := startRunSpan(ctx, run)
+	defer endRunSpan(span, run)
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -483,6 +597,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		} else {
 			run.State = RunStatusCompleted
 		}
+		observeJobRun(run, runTime)
 	}
 
 	// TODO: drop this once we stop using TaskRunResults
@@ -564,7 +679,9 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	ctx, span := startTaskSpan(ctx, taskRun)
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	endTaskSpan(span, result, runInfo)
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -638,6 +755,12 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 }
 
 func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool, fn func(tx sqlutil.DataSource) error) (incomplete bool, err error) {
+	ctx, done, err := r.startRun(ctx, run.ID != 0)
+	if err != nil {
+		return false, err
+	}
+	defer done()
+
 	pipeline, err := r.InitializePipeline(run.PipelineSpec)
 	if err != nil {
 		return false, err
@@ -19,6 +19,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -1077,3 +1082,115 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{
+		JobID:   1,
+		JobName: "traced",
+		DotDagSource: `
+a [type=memo value=1]
+b [type=fail msg="boom"]
+a -> b
+`,
+	}, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+	require.True(t, trrs.FinalResult().HasFatalErrors())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	runSpan := spans[len(spans)-1]
+	assert.Equal(t, "pipeline.run", runSpan.Name())
+	assert.Equal(t, codes.Error, runSpan.Status().Code)
+	assert.Contains(t, runSpan.Attributes(), attribute.String("job.name", "traced"))
+	for _, span := range spans[:2] {
+		assert.Equal(t, "pipeline.task", span.Name())
+		assert.Equal(t, runSpan.SpanContext().SpanID(), span.Parent().SpanID(), "task spans are children of the run span")
+	}
+	assert.Contains(t, spans[1].Attributes(), attribute.String("pipeline.task.dot_id", "b"))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
+
+func Test_PipelineRunner_Drain(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	// blockingSpec returns a spec whose run is in flight until release is closed or the run is cancelled.
+	blockingSpec := func(t *testing.T, release chan struct{}) (pipeline.Spec, chan struct{}) {
+		started := make(chan struct{}, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			started <- struct{}{}
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			_, _ = io.WriteString(w, `{"result": 1}`)
+		}))
+		t.Cleanup(srv.Close)
+		return pipeline.Spec{DotDagSource: fmt.Sprintf(`ds [type=http method=GET url="%s"]`, srv.URL)}, started
+	}
+	memo := pipeline.Spec{DotDagSource: `succeed [type=memo value=1]`}
+
+	t.Run("in-flight runs complete", func(t *testing.T) {
+		r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+		ctx := testutils.Context(t)
+		release := make(chan struct{})
+		spec, started := blockingSpec(t, release)
+
+		runErr := make(chan error, 1)
+		go func() {
+			_, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+			if err == nil && trrs.FinalResult().HasFatalErrors() {
+				err = trrs.FinalResult().CombinedError()
+			}
+			runErr <- err
+		}()
+		<-started
+		assert.Equal(t, 1, r.InFlightRuns())
+
+		drained := make(chan error, 1)
+		go func() { drained <- r.Drain(ctx) }()
+		require.Eventually(t, func() bool {
+			_, _, err := r.ExecuteRun(ctx, memo, pipeline.NewVarsFrom(nil))
+			return errors.Is(err, pipeline.ErrDraining)
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		close(release)
+		require.NoError(t, <-runErr)
+		require.NoError(t, <-drained)
+		assert.Equal(t, 0, r.InFlightRuns())
+
+		r.Undrain()
+		_, _, err := r.ExecuteRun(ctx, memo, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("in-flight runs are cancelled at the deadline", func(t *testing.T) {
+		r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+		ctx := testutils.Context(t)
+		spec, started := blockingSpec(t, make(chan struct{}))
+
+		runErr := make(chan error, 1)
+		go func() {
+			_, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+			if err == nil && trrs.FinalResult().HasFatalErrors() {
+				err = trrs.FinalResult().CombinedError()
+			}
+			runErr <- err
+		}()
+		<-started
+
+		drainCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, r.Drain(drainCtx), context.DeadlineExceeded)
+		require.Error(t, <-runErr)
+		assert.Equal(t, 0, r.InFlightRuns())
+	})
+}
@@ -5,6 +5,7 @@ import (
 	stderrors "errors"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -46,25 +47,93 @@ func (t *ETHABIDecodeLogTask) Run(_ context.Context, _ logger.Logger, vars Vars,
 		return Result{Error: err}, runInfo
 	}
 
-	_, args, indexedArgs, err := parseETHABIString([]byte(theABI), true)
+	decoder, err := NewETHABILogDecoder(string(theABI))
 	if err != nil {
 		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
 	}
 
+	out, err := decoder.Decode(data, topics)
+	if err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+	}
+	return Result{Value: out}, runInfo
+}
+
+// ETHABILogDecoder decodes the logs of a single event given in the same
+// `EventName(type [indexed] name, ...)` format as the ethabidecodelog task.
+type ETHABILogDecoder struct {
+	name        string
+	args        abi.Arguments
+	indexedArgs abi.Arguments
+}
+
+// NewETHABILogDecoder parses the event signature theABI.
+func NewETHABILogDecoder(theABI string) (*ETHABILogDecoder, error) {
+	name, args, indexedArgs, err := parseETHABIString([]byte(theABI), true)
+	if err != nil {
+		return nil, err
+	}
+	return &ETHABILogDecoder{name: name, args: args, indexedArgs: indexedArgs}, nil
+}
+
+// Name returns the event name.
+func (d *ETHABILogDecoder) Name() string {
+	return d.name
+}
+
+// EventSig returns the event signature, i.e. the first topic of its logs.
+func (d *ETHABILogDecoder) EventSig() common.Hash {
+	return abi.NewEvent(d.name, d.name, false, d.args).ID
+}
+
+// TopicIndex returns the index in the log topics of the indexed argument called argName.
+func (d *ETHABILogDecoder) TopicIndex(argName string) (int, error) {
+	for i, arg := range d.indexedArgs {
+		if arg.Name == argName {
+			return i + 1, nil
+		}
+	}
+	return 0, errors.Errorf("%s is not an indexed argument of %s", argName, d.name)
+}
+
+// Topics converts the given values of the indexed argument called argName to the topics they
+// are logged as.
+func (d *ETHABILogDecoder) Topics(argName string, values []interface{}) ([]common.Hash, error) {
+	i, err := d.TopicIndex(argName)
+	if err != nil {
+		return nil, err
+	}
+	arg := d.indexedArgs[i-1]
+	var converted []interface{}
+	for _, value := range values {
+		v, err := convertToETHABIType(value, arg.Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad value for %s", argName)
+		}
+		converted = append(converted, v)
+	}
+	topics, err := abi.MakeTopics(converted)
+	if err != nil {
+		return nil, errors.Wrapf(err, "bad value for %s", argName)
+	}
+	return topics[0], nil
+}
+
+// Decode decodes the data and topics of a log into a map of argument names to values.
+func (d *ETHABILogDecoder) Decode(data []byte, topics []common.Hash) (map[string]interface{}, error) {
 	out := make(map[string]interface{})
 	if len(data) > 0 {
-		if err2 := args.UnpackIntoMap(out, []byte(data)); err2 != nil {
-			return Result{Error: errors.Wrap(ErrBadInput, err2.Error())}, runInfo
+		if err := d.args.UnpackIntoMap(out, data); err != nil {
+			return nil, err
 		}
 	}
-	if len(indexedArgs) > 0 {
-		if len(topics) != len(indexedArgs)+1 {
-			return Result{Error: errors.Wrap(ErrBadInput, "topic/field count mismatch")}, runInfo
+	if len(d.indexedArgs) > 0 {
+		if len(topics) != len(d.indexedArgs)+1 {
+			return nil, errors.New("topic/field count mismatch")
 		}
-		err = abi.ParseTopicsIntoMap(out, indexedArgs, topics[1:])
-		if err != nil {
-			return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+		if err := abi.ParseTopicsIntoMap(out, d.indexedArgs, topics[1:]); err != nil {
+			return nil, err
 		}
 	}
-	return Result{Value: out}, runInfo
+	return out, nil
 }
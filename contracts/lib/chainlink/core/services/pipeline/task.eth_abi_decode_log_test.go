@@ -255,3 +255,50 @@ func TestETHABIDecodeLogTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHABILogDecoder(t *testing.T) {
+	decoder, err := pipeline.NewETHABILogDecoder("NewRound(uint256 indexed roundId, address indexed startedBy, uint256 startedAt)")
+	require.NoError(t, err)
+	assert.Equal(t, "NewRound", decoder.Name())
+	assert.Equal(t, common.HexToHash("0x0109fc6f55cf40689f02fbaad7af7fe7bbac8a3d2186600afc7d3e10cac60271"), decoder.EventSig())
+
+	t.Run("topics", func(t *testing.T) {
+		idx, err := decoder.TopicIndex("startedBy")
+		require.NoError(t, err)
+		assert.Equal(t, 2, idx)
+
+		topics, err := decoder.Topics("roundId", []interface{}{"9", 10})
+		require.NoError(t, err)
+		assert.Equal(t, []common.Hash{
+			common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000009"),
+			common.HexToHash("0x000000000000000000000000000000000000000000000000000000000000000a"),
+		}, topics)
+
+		topics, err = decoder.Topics("startedBy", []interface{}{"0xf17f52151ebef6c7334fad080c5704d77216b732"})
+		require.NoError(t, err)
+		assert.Equal(t, []common.Hash{common.HexToHash("0x000000000000000000000000f17f52151ebef6c7334fad080c5704d77216b732")}, topics)
+
+		_, err = decoder.Topics("startedAt", []interface{}{"1"})
+		require.ErrorContains(t, err, "startedAt is not an indexed argument of NewRound")
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		out, err := decoder.Decode(
+			hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000000f"),
+			[]common.Hash{
+				decoder.EventSig(),
+				common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000009"),
+				common.HexToHash("0x000000000000000000000000f17f52151ebef6c7334fad080c5704d77216b732"),
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"roundId":   big.NewInt(9),
+			"startedBy": common.HexToAddress("0xf17f52151ebef6c7334fad080c5704d77216b732"),
+			"startedAt": big.NewInt(15),
+		}, out)
+
+		_, err = decoder.Decode(nil, []common.Hash{decoder.EventSig()})
+		require.ErrorContains(t, err, "topic/field count mismatch")
+	})
+}
@@ -0,0 +1,62 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans of pipeline runs.
+const tracerName = "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+
+// startRunSpan starts the span of a pipeline run, which is the parent of the spans of its tasks. It is a child of the
+// span of ctx, if any, like the span of the webhook request which triggered the run.
+func startRunSpan(ctx context.Context, run *Run) (context.Context, trace.Span) {
+	spec := run.PipelineSpec
+	return otel.Tracer(tracerName).Start(ctx, "pipeline.run", trace.WithAttributes(
+		attribute.Int64("pipeline.run.id", run.ID),
+		attribute.Int64("pipeline.spec.id", int64(run.PipelineSpecID)),
+		attribute.Int64("job.id", int64(spec.JobID)),
+		attribute.String("job.name", spec.JobName),
+		attribute.String("job.type", spec.JobType),
+		attribute.String("job.external_id", spec.ExternalJobID.String()),
+	))
+}
+
+// endRunSpan records the outcome of a run in its span and ends it.
+func endRunSpan(span trace.Span, run *Run) {
+	defer span.End()
+	span.SetAttributes(attribute.String("pipeline.run.state", string(run.State)))
+	if run.HasFatalErrors() {
+		span.SetStatus(codes.Error, run.FatalErrors.ToError().Error())
+	}
+}
+
+// startTaskSpan starts the span of a task run.
+func startTaskSpan(ctx context.Context, taskRun *memoryTaskRun) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "pipeline.task", trace.WithAttributes(
+		attribute.String("pipeline.task.dot_id", taskRun.task.DotID()),
+		attribute.String("pipeline.task.type", string(taskRun.task.Type())),
+		attribute.Int64("pipeline.task.attempt", int64(taskRun.attempts)),
+	))
+}
+
+// endTaskSpan records the result of a task run in its span and ends it.
+func endTaskSpan(span trace.Span, result Result, runInfo RunInfo) {
+	defer span.End()
+	span.SetAttributes(attribute.Bool("pipeline.task.pending", runInfo.IsPending))
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+	}
+}
+
+// injectTraceContext propagates the W3C trace context of ctx in the headers of an outgoing request.
+func injectTraceContext(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
@@ -64,7 +64,7 @@ func NewLegacyChains(
 		cid := enabled[i].ChainID.ToInt()
 		opts := legacyevm.ChainRelayOpts{
 			Logger:    logger.Named(lggr, cid.String()),
-			KeyStore:  newChainStore(keystore.NewEthSigner(ks, cid), cid),
+			KeyStore:  newChainStore(keystore.NewEthSigner(ks, cid).WithCaller("TXM", nil), cid),
 			ChainOpts: chainOpts,
 		}
 
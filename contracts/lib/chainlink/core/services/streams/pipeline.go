@@ -48,6 +48,7 @@ func newMultiStreamPipeline(lggr logger.Logger, jb job.Job, runner Runner, rrs R
 	spec.JobID = jb.ID
 	spec.JobName = jb.Name.ValueOrZero()
 	spec.JobType = string(jb.Type)
+	spec.ExternalJobID = jb.ExternalJobID
 	if spec.Pipeline == nil {
 		pipeline, err := spec.ParsePipeline()
 		if err != nil {
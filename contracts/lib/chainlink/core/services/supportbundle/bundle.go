@@ -0,0 +1,381 @@
+// Package supportbundle gathers the state of a node which is needed to troubleshoot it into a single zip archive.
+package supportbundle
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// MaxLogSize is how much of the end of the debug log file is included.
+	MaxLogSize = 10 * utils.MB
+	// MaxJobs is how many jobs are included.
+	MaxJobs = 1000
+	// MaxErroredRuns is how many of the most recent errored pipeline runs are included.
+	MaxErroredRuns = 50
+	// MaxProfileCaptures is how many of the most recent profile captures are included.
+	MaxProfileCaptures = 3
+)
+
+type (
+	// Config is the configuration of the node.
+	Config interface {
+		ConfigTOML() (user, effective string)
+		Log() config.Log
+	}
+
+	// HealthChecker reports the health of the services of the node.
+	HealthChecker interface {
+		IsHealthy() (healthy bool, errors map[string]error)
+	}
+
+	// JobORM reads the jobs and their runs.
+	JobORM interface {
+		FindJobs(ctx context.Context, offset, limit int) ([]job.Job, int, error)
+		FindRecentErroredPipelineRuns(ctx context.Context, limit int) ([]pipeline.Run, error)
+	}
+
+	// Sources are where the contents of a bundle are gathered from. Unset sources are skipped, like the health report
+	// of a bundle generated without a running node.
+	Sources struct {
+		Config        Config
+		HealthChecker HealthChecker
+		JobORM        JobORM
+		Nurse         *services.Nurse
+	}
+
+	// BuildInfo describes the build of the node, and when the bundle was generated.
+	BuildInfo struct {
+		Version     string    `json:"version"`
+		Commit      string    `json:"commit"`
+		GoVersion   string    `json:"goVersion"`
+		OS          string    `json:"os"`
+		Arch        string    `json:"arch"`
+		GeneratedAt time.Time `json:"generatedAt"`
+	}
+
+	// HealthCheck is the status of a service of the node.
+	HealthCheck struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+		Output string `json:"output,omitempty"`
+	}
+
+	// Job describes a job without its specs, which may hold credentials, like the headers of http tasks or the URLs of
+	// bridges.
+	Job struct {
+		ID            int32      `json:"id"`
+		ExternalJobID uuid.UUID  `json:"externalJobID"`
+		Name          string     `json:"name"`
+		Type          string     `json:"type"`
+		SchemaVersion uint32     `json:"schemaVersion"`
+		CreatedAt     time.Time  `json:"createdAt"`
+		Errors        []JobError `json:"errors,omitempty"`
+	}
+
+	// JobError is an error of a job, with the URLs in its description redacted.
+	JobError struct {
+		Description  string    `json:"description"`
+		Occurrences  uint      `json:"occurrences"`
+		LastOccurred time.Time `json:"lastOccurred"`
+	}
+
+	// ErroredRun describes an errored pipeline run without its inputs, outputs and metadata, with the URLs in its
+	// errors redacted.
+	ErroredRun struct {
+		ID          int64            `json:"id"`
+		JobID       int32            `json:"jobID"`
+		State       string           `json:"state"`
+		CreatedAt   time.Time        `json:"createdAt"`
+		FinishedAt  null.Time        `json:"finishedAt"`
+		FatalErrors []string         `json:"fatalErrors"`
+		TaskRuns    []ErroredTaskRun `json:"taskRuns"`
+	}
+
+	// ErroredTaskRun describes a task run of an errored pipeline run, without its output.
+	ErroredTaskRun struct {
+		DotID      string    `json:"dotId"`
+		Type       string    `json:"type"`
+		Error      string    `json:"error,omitempty"`
+		CreatedAt  time.Time `json:"createdAt"`
+		FinishedAt null.Time `json:"finishedAt"`
+	}
+)
+
+// Write gathers a support bundle from src and writes it to w as a zip archive. Gathering is best effort: the parts
+// which cannot be gathered are listed in errors.txt, and only the failures to write to w are returned.
+func Write(ctx context.Context, w io.Writer, src Sources, lggr logger.Logger) error {
+	b := &bundle{zw: zip.NewWriter(w), lggr: logger.Sugared(lggr.Named("SupportBundle"))}
+
+	b.add("build.json", func(w io.Writer) error {
+		return writeJSON(w, BuildInfo{
+			Version:     static.Version,
+			Commit:      static.Sha,
+			GoVersion:   runtime.Version(),
+			OS:          runtime.GOOS,
+			Arch:        runtime.GOARCH,
+			GeneratedAt: time.Now().UTC(),
+		})
+	})
+	if src.Config != nil {
+		b.add("config.toml", func(w io.Writer) error {
+			_, effective := src.Config.ConfigTOML()
+			_, err := io.WriteString(w, RedactTOML(effective))
+			return err
+		})
+		b.addLogs(logger.Config{Dir: src.Config.Log().File().Dir()}.LogsFile())
+	} else {
+		b.skip("config.toml", "no configuration")
+	}
+	if src.HealthChecker != nil {
+		b.add("health.json", func(w io.Writer) error {
+			return writeJSON(w, healthChecks(src.HealthChecker))
+		})
+	} else {
+		b.skip("health.json", "the node is not running")
+	}
+	if src.JobORM != nil {
+		b.add("jobs.json", func(w io.Writer) error {
+			jobs, _, err := src.JobORM.FindJobs(ctx, 0, MaxJobs)
+			if err != nil {
+				return err
+			}
+			return writeJSON(w, newJobs(jobs))
+		})
+		b.add("errored_runs.json", func(w io.Writer) error {
+			runs, err := src.JobORM.FindRecentErroredPipelineRuns(ctx, MaxErroredRuns)
+			if err != nil {
+				return err
+			}
+			return writeJSON(w, newErroredRuns(runs))
+		})
+	} else {
+		b.skip("jobs.json", "no database")
+		b.skip("errored_runs.json", "no database")
+	}
+	if src.Nurse != nil {
+		b.addProfiles(src.Nurse)
+	} else {
+		b.skip("profiles.zip", "automatic profiling is disabled, set AutoPprof.Enabled to capture profiles")
+	}
+
+	if len(b.errs) > 0 {
+		b.add("errors.txt", func(w io.Writer) error {
+			_, err := io.WriteString(w, strings.Join(b.errs, "\n")+"\n")
+			return err
+		})
+	}
+	return errors.Join(b.werr, b.zw.Close())
+}
+
+// bundle writes the entries of a support bundle, recording the ones which could not be gathered.
+type bundle struct {
+	zw   *zip.Writer
+	lggr logger.SugaredLogger
+
+	errs []string // the entries which could not be gathered
+	werr error    // the first failure to write the archive, after which nothing is written
+}
+
+// add adds the entry name to the bundle, with the contents written by write.
+func (b *bundle) add(name string, write func(io.Writer) error) {
+	if b.werr != nil {
+		return
+	}
+	ew, err := b.zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		b.werr = err
+		return
+	}
+	ww := &writeErrWriter{w: ew}
+	if err = write(ww); err != nil {
+		if ww.err != nil {
+			b.werr = ww.err
+			return
+		}
+		b.skip(name, err.Error())
+	}
+}
+
+// skip records that the entry name could not be gathered, for the given reason.
+func (b *bundle) skip(name string, reason string) {
+	b.lggr.Warnw("Could not gather support bundle entry", "entry", name, "reason", reason)
+	b.errs = append(b.errs, fmt.Sprintf("%s: %s", name, reason))
+}
+
+// addLogs adds the end of the debug log file at path.
+func (b *bundle) addLogs(path string) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		b.skip("logs", "no debug log file, set Log.File.MaxSize to write logs to disk")
+		return
+	} else if err != nil {
+		b.skip("logs", err.Error())
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		b.skip("logs", err.Error())
+		return
+	}
+
+	b.add("logs/"+info.Name(), func(w io.Writer) error {
+		r := bufio.NewReader(f)
+		if offset := info.Size() - int64(MaxLogSize); offset > 0 {
+			if _, err := f.Seek(offset, io.SeekStart); err != nil {
+				return err
+			}
+			// start at the first complete line
+			if _, err := r.ReadBytes('\n'); err != nil {
+				return err
+			}
+		}
+		// the lines are redacted as they are copied, since the logs may hold credentials, like the URLs of nodes
+		for {
+			line, err := r.ReadString('\n')
+			if line != "" {
+				if _, werr := io.WriteString(w, RedactLogLine(line)); werr != nil {
+					return werr
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// addProfiles adds the most recent completed profile captures of nurse, as a zip archive.
+func (b *bundle) addProfiles(nurse *services.Nurse) {
+	captures, err := nurse.Captures()
+	if err != nil {
+		b.skip("profiles.zip", err.Error())
+		return
+	}
+	captures = slices.DeleteFunc(captures, func(c services.ProfileCapture) bool { return c.InProgress })
+	if len(captures) == 0 {
+		b.skip("profiles.zip", "no profile captures")
+		return
+	}
+	var ids []string
+	for _, c := range captures[:min(len(captures), MaxProfileCaptures)] {
+		ids = append(ids, c.ID)
+	}
+	b.add("profiles.zip", func(w io.Writer) error {
+		return nurse.WriteCapturesZip(w, ids...)
+	})
+}
+
+func healthChecks(checker HealthChecker) []HealthCheck {
+	_, errs := checker.IsHealthy()
+	checks := make([]HealthCheck, 0, len(errs))
+	for name, err := range errs {
+		check := HealthCheck{Name: name, Status: "passing"}
+		if err != nil {
+			check.Status = "failing"
+			check.Output = err.Error()
+		}
+		checks = append(checks, check)
+	}
+	slices.SortFunc(checks, func(a, b HealthCheck) int { return strings.Compare(a.Name, b.Name) })
+	return checks
+}
+
+func newJobs(jobs []job.Job) []Job {
+	summaries := make([]Job, 0, len(jobs))
+	for _, j := range jobs {
+		s := Job{
+			ID:            j.ID,
+			ExternalJobID: j.ExternalJobID,
+			Name:          j.Name.ValueOrZero(),
+			Type:          string(j.Type),
+			SchemaVersion: j.SchemaVersion,
+			CreatedAt:     j.CreatedAt,
+		}
+		for _, e := range j.JobSpecErrors {
+			s.Errors = append(s.Errors, JobError{
+				Description:  RedactText(e.Description),
+				Occurrences:  e.Occurrences,
+				LastOccurred: e.UpdatedAt,
+			})
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries
+}
+
+func newErroredRuns(runs []pipeline.Run) []ErroredRun {
+	summaries := make([]ErroredRun, 0, len(runs))
+	for _, r := range runs {
+		s := ErroredRun{
+			ID:          r.ID,
+			JobID:       r.PipelineSpec.JobID,
+			State:       string(r.State),
+			CreatedAt:   r.CreatedAt,
+			FinishedAt:  r.FinishedAt,
+			FatalErrors: []string{},
+			TaskRuns:    make([]ErroredTaskRun, 0, len(r.PipelineTaskRuns)),
+		}
+		for _, e := range r.FatalErrors {
+			if e.Valid {
+				s.FatalErrors = append(s.FatalErrors, RedactText(e.String))
+			}
+		}
+		for _, tr := range r.PipelineTaskRuns {
+			s.TaskRuns = append(s.TaskRuns, ErroredTaskRun{
+				DotID:      tr.DotID,
+				Type:       string(tr.Type),
+				Error:      RedactText(tr.Error.ValueOrZero()),
+				CreatedAt:  tr.CreatedAt,
+				FinishedAt: tr.FinishedAt,
+			})
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// writeErrWriter records the errors of w, to tell the failures to write the archive from the failures to gather an
+// entry.
+type writeErrWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *writeErrWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	if err != nil && w.err == nil {
+		w.err = err
+	}
+	return n, err
+}
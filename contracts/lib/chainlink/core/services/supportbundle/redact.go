@@ -0,0 +1,108 @@
+package supportbundle
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces the sensitive values.
+const redacted = "xxxxx"
+
+var (
+	tomlKeyValue    = regexp.MustCompile(`^(\s*[A-Za-z0-9_.-]+\s*=\s*)(.*)$`)
+	tomlString      = regexp.MustCompile(`'[^']*'|"(?:[^"\\]|\\.)*"`)
+	jsonStringField = regexp.MustCompile(`"([A-Za-z0-9_.-]+)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	textURL         = regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^\s'"<>\\]+`)
+)
+
+// sensitiveKeys are the suffixes of the names of the configuration keys whose values are masked, in lowercase.
+var sensitiveKeys = []string{"password", "secret", "token", "apikey", "headers"}
+
+// RedactTOML masks the sensitive values of a TOML configuration: the values of the keys which look like credentials,
+// and the credentials, paths and query parameters of URLs, which often embed API keys. The layout of the
+// configuration is preserved. Secrets are not part of the configuration, so this is a defense against credentials set
+// in the wrong place.
+func RedactTOML(toml string) string {
+	lines := strings.Split(toml, "\n")
+	for i, line := range lines {
+		m := tomlKeyValue.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		prefix, value := m[1], m[2]
+		if isSensitiveKey(prefix) {
+			value = tomlString.ReplaceAllString(value, "'"+redacted+"'")
+		} else {
+			value = tomlString.ReplaceAllStringFunc(value, func(s string) string {
+				return s[:1] + redactURL(s[1:len(s)-1]) + s[len(s)-1:]
+			})
+		}
+		lines[i] = prefix + value
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RedactLogLine masks the sensitive values of a JSON log line: the string fields whose names look like credentials,
+// and the URLs anywhere in the line, like in the messages and errors.
+func RedactLogLine(line string) string {
+	line = jsonStringField.ReplaceAllStringFunc(line, func(field string) string {
+		m := jsonStringField.FindStringSubmatch(field)
+		if !isSensitiveName(m[1]) {
+			return field
+		}
+		return `"` + m[1] + `"` + m[2] + `"` + redacted + `"`
+	})
+	return RedactText(line)
+}
+
+// RedactText masks the credentials, paths and query parameters of the URLs in s, like in an error message.
+func RedactText(s string) string {
+	return textURL.ReplaceAllStringFunc(s, func(u string) string {
+		// the punctuation after a URL, like in "GET https://host/path: 401", is kept
+		trimmed := strings.TrimRight(u, ".,;:!?)]}")
+		return redactURL(trimmed) + u[len(trimmed):]
+	})
+}
+
+// isSensitiveKey returns whether the key of prefix, like "Password = ", looks like a credential.
+func isSensitiveKey(prefix string) bool {
+	return isSensitiveName(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(prefix), "=")))
+}
+
+// isSensitiveName returns whether the name of a key or field looks like a credential.
+func isSensitiveName(name string) bool {
+	key := strings.ToLower(name)
+	for _, s := range sensitiveKeys {
+		if strings.HasSuffix(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL masks the credentials, path and query parameters of s if it is a URL, keeping the scheme and host.
+func redactURL(s string) string {
+	if !strings.Contains(s, "://") {
+		return s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return s
+	}
+	if u.User != nil {
+		u.User = url.User(redacted)
+	}
+	if u.Path != "" && u.Path != "/" {
+		u.Path, u.RawPath = "/"+redacted, ""
+	}
+	if u.RawQuery != "" {
+		q := u.Query()
+		for k := range q {
+			q[k] = []string{redacted}
+		}
+		u.RawQuery = q.Encode()
+	}
+	u.Fragment, u.RawFragment = "", ""
+	return u.String()
+}
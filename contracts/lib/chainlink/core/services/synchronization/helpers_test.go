@@ -19,7 +19,7 @@ func NewTestTelemetryIngressClient(t *testing.T, url *url.URL, serverPubKeyHex s
 
 // NewTestTelemetryIngressBatchClient calls NewTelemetryIngressBatchClient and injects telemClient.
 func NewTestTelemetryIngressBatchClient(t *testing.T, url *url.URL, serverPubKeyHex string, csaKeyStore keystore.CSA, logging bool, telemClient telemPb.TelemClient, sendInterval time.Duration, uniconn bool) TelemetryService {
-	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, csaKeyStore, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn)
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, csaKeyStore, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn, "", 0)
 	tc.(*telemetryIngressBatchClient).closeFn = func() error { return nil }
 	tc.(*telemetryIngressBatchClient).telemClient = telemClient
 	return tc
@@ -26,6 +26,16 @@ var (
 		Help: "Number of telemetry messages dropped",
 	}, []string{"endpoint", "telemetry_type"})
 
+	TelemetryClientSpoolDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "telemetry_client_spool_depth",
+		Help: "Number of telemetry batches spooled on disk, waiting to be sent to the telemetry ingress server",
+	}, []string{"endpoint"})
+
+	TelemetryClientSpoolDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_client_spool_dropped",
+		Help: "Number of spooled telemetry batches dropped because the spool was full",
+	}, []string{"endpoint"})
+
 	TelemetryClientWorkers = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "telemetry_client_workers",
 		Help: "Number of telemetry workers",
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 
 	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // NoopTelemetryIngressBatchClient is a no-op interface for TelemetryIngressBatchClient
@@ -67,12 +68,16 @@ type telemetryIngressBatchClient struct {
 
 	useUniConn bool
 
+	// spool persists the batches which could not be sent, nil unless enabled
+	spool *telemetrySpool
+
 	healthMonitorCancel context.CancelFunc
 }
 
 // NewTelemetryIngressBatchClient returns a client backed by wsrpc that
-// can send telemetry to the telemetry ingress server
-func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, csaKeyStore keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool) TelemetryService {
+// can send telemetry to the telemetry ingress server. When spoolMaxSize is
+// positive, the batches which could not be sent are spooled under spoolDir.
+func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, csaKeyStore keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool, spoolDir string, spoolMaxSize utils.FileSize) TelemetryService {
 	c := &telemetryIngressBatchClient{
 		telemBufferSize:   telemBufferSize,
 		telemMaxBatchSize: telemMaxBatchSize,
@@ -90,6 +95,9 @@ func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, csaKey
 		Start: c.start,
 		Close: c.close,
 	}.NewServiceEngine(lggr)
+	if spoolMaxSize > 0 {
+		c.spool = newTelemetrySpool(spoolDir, spoolMaxSize, url.String(), serverPubKeyHex, c.eng)
+	}
 
 	return c
 }
@@ -110,7 +118,7 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		tc.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: tc.csaKeyStore}.Sign)
+		tc.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: tc.csaKeyStore, Subsystem: "TelemetryIngress"}.Sign)
 		if err != nil {
 			return err
 		}
@@ -153,9 +161,48 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 		}
 	}
 
+	if tc.spool != nil {
+		if err := tc.spool.open(); err != nil {
+			return err
+		}
+		tc.eng.GoTick(timeutil.NewTicker(func() time.Duration {
+			return tc.telemSendInterval
+		}), tc.replaySpool)
+	}
+
 	return nil
 }
 
+// MoveSpool moves the batches spooled by the closed client from to the spool of to, so that they are sent to the
+// endpoint of to once it is reachable. It does nothing unless both are batch clients with a spool.
+func MoveSpool(from, to TelemetryService) (int, error) {
+	src, ok := from.(*telemetryIngressBatchClient)
+	if !ok || src.spool == nil {
+		return 0, nil
+	}
+	dst, ok := to.(*telemetryIngressBatchClient)
+	if !ok || dst.spool == nil || dst.spool.dir == src.spool.dir {
+		return 0, nil
+	}
+	return src.spool.moveTo(dst.spool)
+}
+
+// replaySpool sends the spooled batches to the ingress server, in order.
+func (tc *telemetryIngressBatchClient) replaySpool(ctx context.Context) {
+	if tc.useUniConn && !tc.connected.Load() {
+		return
+	}
+	tc.spool.replay(ctx, func(ctx context.Context, req *telemPb.TelemBatchRequest) error {
+		ctx, cancel := context.WithTimeout(ctx, tc.telemSendTimeout)
+		defer cancel()
+		if _, err := tc.telemClient.TelemBatch(ctx, req); err != nil {
+			return err
+		}
+		TelemetryClientMessagesSent.WithLabelValues(tc.url.String(), req.TelemetryType).Inc()
+		return nil
+	})
+}
+
 // startHealthMonitoring starts a goroutine to monitor the connection state and update other relevant metrics every 5 seconds
 func (tc *telemetryIngressBatchClient) startHealthMonitoring(ctx context.Context, conn *wsrpc.ClientConn) {
 	_, cancel := context.WithCancel(ctx)
@@ -240,6 +287,7 @@ func (tc *telemetryIngressBatchClient) findOrCreateWorker(payload TelemPayload)
 			tc.logging,
 			tc.url.String(),
 		)
+		worker.spool = tc.spool
 		tc.eng.GoTick(timeutil.NewTicker(func() time.Duration {
 			return tc.telemSendInterval
 		}), worker.Send)
@@ -25,6 +25,7 @@ type telemetryIngressBatchWorker struct {
 	logging           bool
 	lggr              logger.Logger
 	dropMessageCount  atomic.Uint32
+	spool             *telemetrySpool // nil unless the spool is enabled
 
 	// endpointURL is used for reporting metrics
 	endpointURL string
@@ -64,6 +65,11 @@ func (tw *telemetryIngressBatchWorker) Send(ctx context.Context) {
 
 	// Send batched telemetry to the ingress server, log any errors
 	telemBatchReq := tw.BuildTelemBatchReq()
+	if tw.spool != nil && tw.spool.Len() > 0 {
+		// older batches are still spooled, spool behind them to keep the telemetry in order
+		tw.spoolBatch(telemBatchReq)
+		return
+	}
 	ctx, cancel := context.WithTimeout(ctx, tw.telemSendTimeout)
 	_, err := tw.telemClient.TelemBatch(ctx, telemBatchReq)
 	cancel()
@@ -71,6 +77,9 @@ func (tw *telemetryIngressBatchWorker) Send(ctx context.Context) {
 	if err != nil {
 		tw.lggr.Warnf("Could not send telemetry: %v", err)
 		TelemetryClientMessagesSendErrors.WithLabelValues(tw.endpointURL, string(tw.telemType)).Inc()
+		if tw.spool != nil {
+			tw.spoolBatch(telemBatchReq)
+		}
 		return
 	}
 	TelemetryClientMessagesSent.WithLabelValues(tw.endpointURL, string(tw.telemType)).Inc()
@@ -79,6 +88,14 @@ func (tw *telemetryIngressBatchWorker) Send(ctx context.Context) {
 	}
 }
 
+// spoolBatch writes a batch to the spool, to be replayed once the ingress server is reachable.
+func (tw *telemetryIngressBatchWorker) spoolBatch(telemBatchReq *telemPb.TelemBatchRequest) {
+	if err := tw.spool.push(telemBatchReq); err != nil {
+		tw.lggr.Errorw("Failed to spool telemetry, dropping it", "err", err)
+		TelemetryClientMessagesDropped.WithLabelValues(tw.endpointURL, string(tw.telemType)).Add(float64(len(telemBatchReq.Telemetry)))
+	}
+}
+
 // logBufferFullWithExpBackoff logs messages at
 // 1
 // 2
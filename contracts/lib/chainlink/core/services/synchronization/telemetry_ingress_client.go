@@ -84,7 +84,7 @@ func (tc *telemetryIngressClient) start(context.Context) error {
 			if err != nil {
 				return nil, err
 			}
-			tc.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: tc.csaKeyStore}.Sign)
+			tc.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: tc.csaKeyStore, Subsystem: "TelemetryIngress"}.Sign)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,264 @@
+package synchronization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const spoolFileSuffix = ".pb"
+
+// maxSpoolReplayAttempts is the number of failed replays after which a spooled batch is dropped, so that a batch the
+// endpoint keeps rejecting does not block the ones behind it.
+const maxSpoolReplayAttempts = 10
+
+// telemetrySpool persists the telemetry batches which could not be sent to an ingress endpoint, so that they are
+// replayed in order once the endpoint is reachable, including after a restart. The spool is bounded by maxSize, the
+// oldest batches are dropped when it is exceeded.
+type telemetrySpool struct {
+	dir         string
+	maxSize     utils.FileSize
+	endpointURL string
+	lggr        logger.Logger
+
+	mu      sync.Mutex   // guards the files of the spool and the fields below
+	spooled []spoolEntry // spooled batches, oldest first
+	size    int64        // total size of the spooled batches
+	nextSeq uint64       // number of the next spooled batch
+	depth   atomic.Int64
+}
+
+type spoolEntry struct {
+	name     string
+	size     int64
+	attempts int // failed replays since the spool was opened
+}
+
+// newTelemetrySpool returns the spool of the endpoint at endpointURL with the server public key serverPubKey, in a
+// directory of rootDir dedicated to it.
+func newTelemetrySpool(rootDir string, maxSize utils.FileSize, endpointURL string, serverPubKey string, lggr logger.Logger) *telemetrySpool {
+	sum := sha256.Sum256([]byte(endpointURL + "\n" + serverPubKey))
+	return &telemetrySpool{
+		dir:         filepath.Join(rootDir, hex.EncodeToString(sum[:8])),
+		maxSize:     maxSize,
+		endpointURL: endpointURL,
+		lggr:        logger.Named(lggr, "TelemetrySpool"),
+	}
+}
+
+// open creates the directory of the spool and resumes from the batches spooled before a restart.
+func (s *telemetrySpool) open() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create telemetry spool directory: %w", err)
+	}
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read telemetry spool: %w", err)
+	}
+	s.spooled, s.size = nil, 0
+	for _, entry := range entries {
+		// zero padded names, so that the directory order is the spool order
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || !strings.HasSuffix(entry.Name(), spoolFileSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to read telemetry spool: %w", err)
+		}
+		s.spooled = append(s.spooled, spoolEntry{name: entry.Name(), size: info.Size()})
+		s.size += info.Size()
+	}
+	if len(s.spooled) > 0 {
+		last, _ := strconv.ParseUint(strings.TrimSuffix(s.spooled[len(s.spooled)-1].name, spoolFileSuffix), 10, 64)
+		s.nextSeq = last + 1
+		s.lggr.Infow("Resuming telemetry spool", "endpoint", s.endpointURL, "spooled", len(s.spooled))
+	}
+	s.setDepth()
+	return nil
+}
+
+// Len returns the number of spooled batches.
+func (s *telemetrySpool) Len() int {
+	return int(s.depth.Load())
+}
+
+// push writes a batch to the spool, dropping the oldest batches once the spool exceeds its max size.
+func (s *telemetrySpool) push(req *telemPb.TelemBatchRequest) error {
+	b, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal telemetry batch: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pushBytes(b)
+}
+
+// pushBytes writes a marshaled batch to the spool. The caller must hold mu.
+func (s *telemetrySpool) pushBytes(b []byte) error {
+	name := fmt.Sprintf("%020d%s", s.nextSeq, spoolFileSuffix)
+	tmp := filepath.Join(s.dir, "."+name+".tmp")
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return fmt.Errorf("failed to spool telemetry batch: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(s.dir, name)); err != nil {
+		return fmt.Errorf("failed to spool telemetry batch: %w", err)
+	}
+	s.nextSeq++
+	s.spooled = append(s.spooled, spoolEntry{name: name, size: int64(len(b))})
+	s.size += int64(len(b))
+
+	var dropped int
+	for s.size > int64(s.maxSize) && len(s.spooled) > 1 {
+		if err := s.removeOldest(); err != nil {
+			s.setDepth()
+			return fmt.Errorf("failed to drop spooled telemetry batch: %w", err)
+		}
+		dropped++
+	}
+	s.setDepth()
+	if dropped > 0 {
+		TelemetryClientSpoolDropped.WithLabelValues(s.endpointURL).Add(float64(dropped))
+		s.lggr.Errorw("Telemetry spool is full, dropped the oldest spooled batches", "endpoint", s.endpointURL, "dropped", dropped, "maxSize", s.maxSize)
+	}
+	return nil
+}
+
+// replay sends the spooled batches in order with send, stopping at the first failure. A batch which failed to be sent
+// maxSpoolReplayAttempts times is dropped instead, and the replay carries on with the next one.
+func (s *telemetrySpool) replay(ctx context.Context, send func(context.Context, *telemPb.TelemBatchRequest) error) {
+	s.mu.Lock()
+	spooled := slices.Clone(s.spooled)
+	s.mu.Unlock()
+
+	var sent int
+	for _, entry := range spooled {
+		if ctx.Err() != nil {
+			return
+		}
+		path := filepath.Join(s.dir, entry.name)
+		b, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue // dropped while the spool was full
+		} else if err != nil {
+			s.lggr.Errorw("Failed to read spooled telemetry batch", "file", path, "err", err)
+			return
+		}
+		req := new(telemPb.TelemBatchRequest)
+		if err = proto.Unmarshal(b, req); err != nil {
+			s.lggr.Errorw("Dropping corrupt spooled telemetry batch", "file", path, "err", err)
+			TelemetryClientSpoolDropped.WithLabelValues(s.endpointURL).Inc()
+		} else if err = send(ctx, req); err != nil {
+			if attempts := s.failed(entry.name); attempts < maxSpoolReplayAttempts {
+				s.lggr.Warnw("Failed to send spooled telemetry batches, will retry", "endpoint", s.endpointURL, "spooled", s.Len(), "attempts", attempts, "err", err)
+				return
+			}
+			s.lggr.Errorw("Dropping spooled telemetry batch which could not be sent", "file", path, "attempts", maxSpoolReplayAttempts, "err", err)
+			TelemetryClientSpoolDropped.WithLabelValues(s.endpointURL).Inc()
+		} else {
+			sent++
+		}
+		if err = s.remove(entry.name); err != nil {
+			s.lggr.Errorw("Failed to remove sent telemetry batch from the spool", "file", path, "err", err)
+			return
+		}
+	}
+	if sent > 0 {
+		s.lggr.Infow("Sent spooled telemetry batches", "endpoint", s.endpointURL, "count", sent)
+	}
+}
+
+// failed records a failed replay of a batch, returning the number of failed replays of the batch.
+func (s *telemetrySpool) failed(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// as in remove, a batch still spooled is the oldest one
+	if len(s.spooled) == 0 || s.spooled[0].name != name {
+		return 0
+	}
+	s.spooled[0].attempts++
+	return s.spooled[0].attempts
+}
+
+// remove removes a replayed batch from the spool, unless it was dropped in the meantime.
+func (s *telemetrySpool) remove(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// batches are replayed and dropped oldest first, so a replayed batch still spooled is the oldest one
+	if len(s.spooled) == 0 || s.spooled[0].name != name {
+		return nil
+	}
+	err := s.removeOldest()
+	s.setDepth()
+	return err
+}
+
+// moveTo moves the spooled batches to dst, behind its own, and removes the directory of the spool. It is used when the
+// URL or the server public key of an endpoint changes, so that the batches are sent to the new endpoint instead of
+// being orphaned. The spool must no longer be in use.
+func (s *telemetrySpool) moveTo(dst *telemetrySpool) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dst.mu.Lock()
+	defer dst.mu.Unlock()
+
+	var moved int
+	for len(s.spooled) > 0 {
+		b, err := os.ReadFile(filepath.Join(s.dir, s.spooled[0].name))
+		if err != nil {
+			s.setDepth()
+			return moved, fmt.Errorf("failed to read spooled telemetry batch: %w", err)
+		}
+		if err = dst.pushBytes(b); err != nil {
+			s.setDepth()
+			return moved, err
+		}
+		if err = s.removeOldest(); err != nil {
+			s.setDepth()
+			return moved, err
+		}
+		moved++
+	}
+	s.setDepth()
+	if err := os.RemoveAll(s.dir); err != nil {
+		return moved, fmt.Errorf("failed to remove telemetry spool directory: %w", err)
+	}
+	return moved, nil
+}
+
+// removeOldest removes the oldest spooled batch. The caller must hold mu.
+func (s *telemetrySpool) removeOldest() error {
+	if err := os.Remove(filepath.Join(s.dir, s.spooled[0].name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	s.size -= s.spooled[0].size
+	s.spooled = s.spooled[1:]
+	return nil
+}
+
+// setDepth reports the number of spooled batches. The caller must hold mu.
+func (s *telemetrySpool) setDepth() {
+	s.depth.Store(int64(len(s.spooled)))
+	TelemetryClientSpoolDepth.WithLabelValues(s.endpointURL).Set(float64(len(s.spooled)))
+}
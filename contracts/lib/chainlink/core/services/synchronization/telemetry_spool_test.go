@@ -0,0 +1,170 @@
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func newTestTelemetrySpool(t *testing.T, dir string, maxSize utils.FileSize) *telemetrySpool {
+	s := newTelemetrySpool(dir, maxSize, "test-endpoint", "test-pub-key", logger.TestLogger(t))
+	require.NoError(t, s.open())
+	return s
+}
+
+func testBatch(contractID string) *telemPb.TelemBatchRequest {
+	return &telemPb.TelemBatchRequest{
+		ContractId:    contractID,
+		TelemetryType: string(OCR),
+		Telemetry:     [][]byte{[]byte("telemetry of " + contractID)},
+	}
+}
+
+func replayed(t *testing.T, s *telemetrySpool, sendErr error) (contractIDs []string) {
+	s.replay(t.Context(), func(_ context.Context, req *telemPb.TelemBatchRequest) error {
+		if sendErr != nil {
+			return sendErr
+		}
+		contractIDs = append(contractIDs, req.ContractId)
+		return nil
+	})
+	return
+}
+
+func TestTelemetrySpool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replays in order until the first failure", func(t *testing.T) {
+		s := newTestTelemetrySpool(t, t.TempDir(), utils.MB)
+		for _, id := range []string{"0xa", "0xb", "0xc"} {
+			require.NoError(t, s.push(testBatch(id)))
+		}
+		assert.Equal(t, 3, s.Len())
+
+		assert.Empty(t, replayed(t, s, errors.New("unreachable")))
+		assert.Equal(t, 3, s.Len())
+
+		assert.Equal(t, []string{"0xa", "0xb", "0xc"}, replayed(t, s, nil))
+		assert.Equal(t, 0, s.Len())
+		assert.Empty(t, replayed(t, s, nil))
+	})
+
+	t.Run("drops a batch which keeps failing", func(t *testing.T) {
+		s := newTelemetrySpool(t.TempDir(), utils.MB, "rejecting-endpoint", "test-pub-key", logger.TestLogger(t))
+		require.NoError(t, s.open())
+		require.NoError(t, s.push(testBatch("0xa")))
+		require.NoError(t, s.push(testBatch("0xb")))
+
+		var sent []string
+		rejectFirst := func(_ context.Context, req *telemPb.TelemBatchRequest) error {
+			if req.ContractId == "0xa" {
+				return errors.New("rejected")
+			}
+			sent = append(sent, req.ContractId)
+			return nil
+		}
+		for range maxSpoolReplayAttempts - 1 {
+			s.replay(t.Context(), rejectFirst)
+		}
+		assert.Empty(t, sent)
+		assert.Equal(t, 2, s.Len())
+
+		s.replay(t.Context(), rejectFirst)
+		assert.Equal(t, []string{"0xb"}, sent)
+		assert.Equal(t, 0, s.Len())
+		assert.Equal(t, 1.0, testutil.ToFloat64(TelemetryClientSpoolDropped.WithLabelValues("rejecting-endpoint")))
+	})
+
+	t.Run("persists across restarts", func(t *testing.T) {
+		dir := t.TempDir()
+		s := newTestTelemetrySpool(t, dir, utils.MB)
+		require.NoError(t, s.push(testBatch("0xa")))
+		require.NoError(t, s.push(testBatch("0xb")))
+
+		s = newTestTelemetrySpool(t, dir, utils.MB)
+		assert.Equal(t, 2, s.Len())
+		require.NoError(t, s.push(testBatch("0xc")))
+		assert.Equal(t, []string{"0xa", "0xb", "0xc"}, replayed(t, s, nil))
+	})
+
+	t.Run("drops the oldest batches when full", func(t *testing.T) {
+		s := newTestTelemetrySpool(t, t.TempDir(), 1)
+		for _, id := range []string{"0xa", "0xb", "0xc"} {
+			require.NoError(t, s.push(testBatch(id)))
+		}
+		// the newest batch is always kept
+		assert.Equal(t, 1, s.Len())
+		assert.Equal(t, []string{"0xc"}, replayed(t, s, nil))
+		assert.Zero(t, s.size)
+	})
+
+	t.Run("separates endpoints", func(t *testing.T) {
+		dir := t.TempDir()
+		a := newTelemetrySpool(dir, utils.MB, "endpoint-a", "test-pub-key", logger.TestLogger(t))
+		b := newTelemetrySpool(dir, utils.MB, "endpoint-b", "test-pub-key", logger.TestLogger(t))
+		require.NoError(t, a.open())
+		require.NoError(t, b.open())
+		require.NoError(t, a.push(testBatch("0xa")))
+		assert.Equal(t, 1, a.Len())
+		assert.Equal(t, 0, b.Len())
+	})
+
+	t.Run("moves to the spool of a new endpoint", func(t *testing.T) {
+		dir := t.TempDir()
+		from := newTelemetrySpool(dir, utils.MB, "endpoint-a", "test-pub-key", logger.TestLogger(t))
+		to := newTelemetrySpool(dir, utils.MB, "endpoint-b", "test-pub-key", logger.TestLogger(t))
+		require.NoError(t, from.open())
+		require.NoError(t, to.open())
+		require.NoError(t, from.push(testBatch("0xa")))
+		require.NoError(t, from.push(testBatch("0xb")))
+		require.NoError(t, to.push(testBatch("0xc")))
+
+		moved, err := from.moveTo(to)
+		require.NoError(t, err)
+		assert.Equal(t, 2, moved)
+		assert.Equal(t, 0, from.Len())
+		assert.NoDirExists(t, from.dir)
+		assert.Equal(t, []string{"0xc", "0xa", "0xb"}, replayed(t, to, nil))
+	})
+}
+
+// unreachableTelemClient fails to send any telemetry.
+type unreachableTelemClient struct{ telemPb.TelemClient }
+
+func (unreachableTelemClient) TelemBatch(context.Context, *telemPb.TelemBatchRequest) (*telemPb.TelemResponse, error) {
+	return nil, errors.New("unreachable")
+}
+
+func TestTelemetryIngressBatchWorker_Spool(t *testing.T) {
+	telemClient := unreachableTelemClient{}
+	chTelemetry := make(chan TelemPayload, 10)
+	worker := NewTelemetryIngressBatchWorker(10, time.Second, telemClient, chTelemetry, "0xa", OCR, logger.TestLogger(t), false, "test-endpoint")
+	worker.spool = newTestTelemetrySpool(t, t.TempDir(), utils.MB)
+
+	// a batch which cannot be sent is spooled
+	chTelemetry <- TelemPayload{Telemetry: []byte("first"), ContractID: "0xa"}
+	worker.Send(t.Context())
+	assert.Equal(t, 1, worker.spool.Len())
+
+	// later batches queue behind the spooled ones
+	chTelemetry <- TelemPayload{Telemetry: []byte("second"), ContractID: "0xa"}
+	worker.Send(t.Context())
+	assert.Equal(t, 2, worker.spool.Len())
+
+	var sent []string
+	worker.spool.replay(t.Context(), func(_ context.Context, req *telemPb.TelemBatchRequest) error {
+		sent = append(sent, string(req.Telemetry[0]))
+		return nil
+	})
+	assert.Equal(t, []string{"first", "second"}, sent)
+	assert.Equal(t, 0, worker.spool.Len())
+}
@@ -1,8 +1,10 @@
 package telemetry
 
 import (
+	"context"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -34,12 +36,38 @@ type Manager struct {
 	MonitoringEndpointGenerator MonitoringEndpointGenerator
 }
 
+// telemetryEndpoint is the telemetry service of a network and chain. It forwards to its client, which is replaced when
+// the URL or the public key of the endpoint is reloaded, so that the agents of running jobs keep sending telemetry.
 type telemetryEndpoint struct {
 	ChainID string
 	Network string
-	URL     *url.URL
-	client  synchronization.TelemetryService
-	PubKey  string
+
+	mu     sync.RWMutex
+	URL    *url.URL
+	client synchronization.TelemetryService
+	PubKey string
+}
+
+var _ synchronization.TelemetryService = (*telemetryEndpoint)(nil)
+
+func (e *telemetryEndpoint) currentClient() synchronization.TelemetryService {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.client
+}
+
+func (e *telemetryEndpoint) Start(ctx context.Context) error { return e.currentClient().Start(ctx) }
+
+func (e *telemetryEndpoint) Close() error { return e.currentClient().Close() }
+
+func (e *telemetryEndpoint) Ready() error { return e.currentClient().Ready() }
+
+func (e *telemetryEndpoint) HealthReport() map[string]error { return e.currentClient().HealthReport() }
+
+func (e *telemetryEndpoint) Name() string { return e.currentClient().Name() }
+
+func (e *telemetryEndpoint) Send(ctx context.Context, telemetry []byte, contractID string, telemType synchronization.TelemetryType) {
+	e.currentClient().Send(ctx, telemetry, contractID, telemType)
 }
 
 // NewManager create a new telemetry manager that is responsible for configuring telemetry agents and generating the defined telemetry endpoints and monitoring endpoints
@@ -81,10 +109,10 @@ func (m *Manager) GenMonitoringEndpoint(network string, chainID string, contract
 	}
 
 	if m.useBatchSend {
-		return NewTypedIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+		return NewTypedIngressAgentBatch(e, network, chainID, contractID, telemType)
 	}
 
-	return NewTypedIngressAgent(e.client, network, chainID, contractID, telemType)
+	return NewTypedIngressAgent(e, network, chainID, contractID, telemType)
 }
 
 func (m *Manager) GenMultitypeMonitoringEndpoint(network string, chainID string, contractID string) MultitypeMonitoringEndpoint {
@@ -96,10 +124,10 @@ func (m *Manager) GenMultitypeMonitoringEndpoint(network string, chainID string,
 	}
 
 	if m.useBatchSend {
-		return NewMultiIngressAgentBatch(e.client, network, chainID, contractID)
+		return NewMultiIngressAgentBatch(e, network, chainID, contractID)
 	}
 
-	return NewMultiIngressAgent(e.client, network, chainID, contractID)
+	return NewMultiIngressAgent(e, network, chainID, contractID)
 }
 
 func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Logger, cfg config.TelemetryIngress) (services.Service, error) {
@@ -123,24 +151,62 @@ func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Log
 		return nil, errors.Errorf("cannot add telemetry endpoint for network %q and chainID %q, endpoint already exists", e.Network(), e.ChainID())
 	}
 
-	lggr = logger.Sugared(lggr).Named(e.Network()).Named(e.ChainID())
-	var tClient synchronization.TelemetryService
-	if m.useBatchSend {
-		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize(), cfg.MaxBatchSize(), cfg.SendInterval(), cfg.SendTimeout(), cfg.UniConn())
-	} else {
-		tClient = synchronization.NewTelemetryIngressClient(e.URL(), e.ServerPubKey(), m.ks, lggr, cfg.BufferSize())
-	}
-
-	te := telemetryEndpoint{
+	te := &telemetryEndpoint{
 		Network: strings.ToUpper(e.Network()),
 		ChainID: strings.ToUpper(e.ChainID()),
 		URL:     e.URL(),
 		PubKey:  e.ServerPubKey(),
-		client:  tClient,
+		client:  m.newClient(e, lggr, cfg),
 	}
 
-	m.endpoints = append(m.endpoints, &te)
-	return te.client, nil
+	m.endpoints = append(m.endpoints, te)
+	return te, nil
+}
+
+func (m *Manager) newClient(e config.TelemetryIngressEndpoint, lggr logger.Logger, cfg config.TelemetryIngress) synchronization.TelemetryService {
+	lggr = logger.Sugared(lggr).Named(e.Network()).Named(e.ChainID())
+	if m.useBatchSend {
+		return synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize(), cfg.MaxBatchSize(), cfg.SendInterval(), cfg.SendTimeout(), cfg.UniConn(), cfg.SpoolDir(), cfg.SpoolMaxSize())
+	}
+	return synchronization.NewTelemetryIngressClient(e.URL(), e.ServerPubKey(), m.ks, lggr, cfg.BufferSize())
+}
+
+// ReloadEndpoints reconnects the endpoints whose URL or server public key changed in cfg. Endpoints cannot be added or
+// removed at runtime: unknown endpoints are ignored.
+func (m *Manager) ReloadEndpoints(ctx context.Context, cfg config.TelemetryIngress) error {
+	for _, e := range cfg.Endpoints() {
+		te, found := m.getEndpoint(e.Network(), e.ChainID())
+		if !found {
+			m.eng.Warnf("cannot reload telemetry endpoint for network %q and chainID %q: a restart is required to add it", e.Network(), e.ChainID())
+			continue
+		}
+		te.mu.RLock()
+		unchanged := te.URL.String() == e.URL().String() && te.PubKey == e.ServerPubKey()
+		te.mu.RUnlock()
+		if unchanged {
+			continue
+		}
+
+		client := m.newClient(e, m.eng, cfg)
+		if err := client.Start(ctx); err != nil {
+			return errors.Wrapf(err, "failed to start telemetry client for network %q and chainID %q", e.Network(), e.ChainID())
+		}
+		te.mu.Lock()
+		old := te.client
+		te.URL, te.PubKey, te.client = e.URL(), e.ServerPubKey(), client
+		te.mu.Unlock()
+		if err := old.Close(); err != nil {
+			m.eng.Errorw("Failed to close replaced telemetry client", "network", e.Network(), "chainID", e.ChainID(), "err", err)
+		}
+		// the batches spooled for the old URL or public key are sent to the reloaded endpoint
+		if moved, err := synchronization.MoveSpool(old, client); err != nil {
+			m.eng.Errorw("Failed to move the telemetry spool of the replaced client", "network", e.Network(), "chainID", e.ChainID(), "moved", moved, "err", err)
+		} else if moved > 0 {
+			m.eng.Infow("Moved the telemetry spool of the replaced client", "network", e.Network(), "chainID", e.ChainID(), "moved", moved)
+		}
+		m.eng.Infow("Reloaded telemetry endpoint", "network", e.Network(), "chainID", e.ChainID(), "url", e.URL().String())
+	}
+	return nil
 }
 
 func (m *Manager) getEndpoint(network string, chainID string) (*telemetryEndpoint, bool) {
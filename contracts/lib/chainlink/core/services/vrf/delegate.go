@@ -40,6 +40,7 @@ type Delegate struct {
 	legacyChains legacyevm.LegacyChainContainer
 	lggr         logger.Logger
 	mailMon      *mailbox.Monitor
+	inspectors   *v2.InspectorRegistry
 }
 
 func NewDelegate(
@@ -58,9 +59,15 @@ func NewDelegate(
 		legacyChains: legacyChains,
 		lggr:         lggr.Named("VRF"),
 		mailMon:      mailMon,
+		inspectors:   v2.NewInspectorRegistry(),
 	}
 }
 
+// RequestInspectors returns the registry of request inspectors of the running VRF v2 and v2.5 jobs.
+func (d *Delegate) RequestInspectors() *v2.InspectorRegistry {
+	return d.inspectors
+}
+
 func (d *Delegate) JobType() job.Type {
 	return job.VRF
 }
@@ -199,6 +206,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 					// otherwise we will end up re-delivering logs that were already delivered.
 					vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
 					vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
+					d.inspectors,
 				),
 			}, nil
 		}
@@ -253,6 +261,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				// otherwise we will end up re-delivering logs that were already delivered.
 				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
 				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
+				d.inspectors,
 			),
 			}, nil
 		}
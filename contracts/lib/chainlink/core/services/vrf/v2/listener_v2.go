@@ -83,6 +83,7 @@ func New(
 	reqAdded func(),
 	inflightCache vrfcommon.InflightCache,
 	fulfillmentDeduper *vrfcommon.LogDeduper,
+	inspectors *InspectorRegistry,
 ) job.ServiceCtx {
 	return &listenerV2{
 		cfg:                   cfg,
@@ -105,6 +106,8 @@ func New(
 		aggregator:            aggregator,
 		inflightCache:         inflightCache,
 		fulfillmentLogDeduper: fulfillmentDeduper,
+		requests:              newRequestTracker(),
+		inspectors:            inspectors,
 	}
 }
 
@@ -154,6 +157,11 @@ type listenerV2 struct {
 	// inflightCache is a cache of in-flight requests, used to prevent
 	// re-processing of requests that are in-flight or already fulfilled.
 	inflightCache vrfcommon.InflightCache
+
+	// requests tracks the state of requests that have not been fulfilled yet,
+	// so that they can be inspected through the inspectors registry.
+	requests   *requestTracker
+	inspectors *InspectorRegistry
 }
 
 func (lsn *listenerV2) HealthReport() map[string]error {
@@ -209,6 +217,7 @@ func (lsn *listenerV2) Start(ctx context.Context) error {
 			lsn.runLogListener(spec.PollPeriod, spec.MinIncomingConfirmations)
 		}()
 
+		lsn.inspectors.register(lsn.job.ID, lsn)
 		return nil
 	})
 }
@@ -271,6 +280,7 @@ func (lsn *listenerV2) getLatestHead() uint64 {
 // Close complies with job.Service
 func (lsn *listenerV2) Close() error {
 	return lsn.StopOnce("VRFListenerV2", func() error {
+		lsn.inspectors.unregister(lsn.job.ID)
 		close(lsn.chStop)
 		// wait on the request handler, log listener
 		lsn.wg.Wait()
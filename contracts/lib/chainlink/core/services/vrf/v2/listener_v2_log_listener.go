@@ -375,6 +375,7 @@ func (lsn *listenerV2) handleFulfilled(fulfilled map[string]RandomWordsFulfilled
 			continue
 		}
 		lsn.l.Debugw("Received fulfilled log", "reqID", v.RequestID(), "success", v.Success())
+		lsn.requests.remove(v.RequestID().String())
 		lsn.respCount[v.RequestID().String()]++
 		lsn.blockNumberToReqID.Insert(fulfilledReqV2{
 			blockNumber: v.Raw().BlockNumber,
@@ -406,6 +407,7 @@ func (lsn *listenerV2) handleRequested(requested []RandomWordsRequested, request
 			req:              req,
 			utcTimestamp:     requestedLP[i].CreatedAt.UTC(),
 		})
+		lsn.requests.observe(req, confirmedAt, requestedLP[i].CreatedAt.UTC())
 		lsn.reqAdded()
 	}
 
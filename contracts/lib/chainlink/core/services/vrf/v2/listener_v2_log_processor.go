@@ -42,6 +42,10 @@ func (lsn *listenerV2) getConfirmedLogsBySub(latestHead uint64, pendingRequests
 	for _, request := range pendingRequests {
 		if lsn.ready(request, latestHead) {
 			toProcess[request.req.SubID().String()] = append(toProcess[request.req.SubID().String()], request)
+		} else if request.confirmedAtBlock > latestHead {
+			lsn.requests.setReason(request.req.RequestID().String(), "waiting for confirmations until block %d", request.confirmedAtBlock)
+		} else {
+			lsn.requests.setReason(request.req.RequestID().String(), "backing off after %d attempts", request.attempts)
 		}
 	}
 	return toProcess
@@ -113,6 +117,7 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 	var processedMu sync.Mutex
 	processed := make(map[string]struct{})
 	start := time.Now()
+	lsn.requests.prune(start.UTC().Add(-lsn.job.VRFSpec.RequestTimeout))
 
 	defer func() {
 		for _, subReqs := range confirmed {
@@ -120,6 +125,7 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 				if _, ok := processed[req.req.RequestID().String()]; ok {
 					// add to the inflight cache so that we don't re-process this request
 					lsn.inflightCache.Add(req.req.Raw())
+					lsn.requests.markInflight(req.req.RequestID().String())
 				}
 			}
 		}
@@ -158,6 +164,9 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 			if !strings.Contains(err.Error(), "execution reverted") {
 				// Most likely this is an RPC error, so we re-try later.
 				l.Errorw("Unable to read subscription balance", "err", err)
+				for _, req := range reqs {
+					lsn.requests.setReason(req.req.RequestID().String(), "unable to read subscription balance: %v", err)
+				}
 				return
 			}
 			// "execution reverted" indicates that the subscription no longer exists.
@@ -178,6 +187,7 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 			}
 			subIsActive = true
 		}
+		lsn.requests.setSubscription(reqs, subIsActive, startLinkBalance, startEthBalance)
 
 		// Sort requests in ascending order by CallbackGasLimit
 		// so that we process the "cheapest" requests for each subscription
@@ -412,6 +422,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
 					ll.Criticalw("Pipeline error", "err", p.err)
+					lsn.requests.setReason(p.req.req.RequestID().String(), "blockhash not in store, run the blockhash store feeder in backwards mode: %v", p.err)
 				} else if errors.Is(p.err, errProofVerificationFailed{}) {
 					// This occurs when the proof reverts in the simulation
 					// This is almost always (if not always) due to a proof generated with an out-of-date
@@ -419,14 +430,17 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 					// we can simply mark as processed and move on, since we will eventually
 					// process the request with the right blockhash
 					ll.Infow("proof reverted in simulation, likely stale blockhash")
+					lsn.requests.setReason(p.req.req.RequestID().String(), "proof reverted in simulation, likely stale blockhash")
 					processed[p.req.req.RequestID().String()] = struct{}{}
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
+					lsn.requests.setReason(p.req.req.RequestID().String(), "pipeline error: %v", p.err)
 					if !subIsActive {
 						ll.Warnw("Force-fulfilling a request with insufficient funds on a cancelled sub")
 						etx, err := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err)
+							lsn.requests.setReason(p.req.req.RequestID().String(), "error enqueuing force-fulfillment: %v", err)
 							continue
 						}
 						ll.Infow("Successfully enqueued force-fulfillment", "ethTxID", etx.ID)
@@ -441,6 +455,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 && errors.Is(p.err, errPossiblyInsufficientFunds{}) {
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, breaking", "err", p.err)
+						lsn.requests.setReason(p.req.req.RequestID().String(), "insufficient subscription balance based on estimate, needs %s", p.fundsNeeded)
 						outOfBalance = true
 
 						// break out of this inner loop to process the currently constructed batch
@@ -456,6 +471,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 							"blockNumber", p.req.req.Raw().BlockNumber,
 							"blockHash", p.req.req.Raw().BlockHash,
 						)
+						lsn.requests.setReason(p.req.req.RequestID().String(), "dropped, consumer %s is not a contract", p.req.req.Sender())
 						processed[p.req.req.RequestID().String()] = struct{}{}
 						continue
 					}
@@ -468,6 +484,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 				// Break out of the loop now and process what we are able to process
 				// in the constructed batches.
 				ll.Infow("Insufficient balance to fulfill a request, breaking")
+				lsn.requests.setReason(p.req.req.RequestID().String(), "insufficient subscription balance, needs %s", p.maxFee)
 				break
 			}
 
@@ -742,6 +759,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
 					ll.Criticalw("Pipeline error", "err", p.err)
+					lsn.requests.setReason(p.req.req.RequestID().String(), "blockhash not in store, run the blockhash store feeder in backwards mode: %v", p.err)
 				} else if errors.Is(p.err, errProofVerificationFailed{}) {
 					// This occurs when the proof reverts in the simulation
 					// This is almost always (if not always) due to a proof generated with an out-of-date
@@ -749,15 +767,18 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 					// we can simply mark as processed and move on, since we will eventually
 					// process the request with the right blockhash
 					ll.Infow("proof reverted in simulation, likely stale blockhash")
+					lsn.requests.setReason(p.req.req.RequestID().String(), "proof reverted in simulation, likely stale blockhash")
 					processed[p.req.req.RequestID().String()] = struct{}{}
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
+					lsn.requests.setReason(p.req.req.RequestID().String(), "pipeline error: %v", p.err)
 
 					if !subIsActive {
 						lsn.l.Warnw("Force-fulfilling a request with insufficient funds on a cancelled sub")
 						etx, err2 := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err2 != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err2)
+							lsn.requests.setReason(p.req.req.RequestID().String(), "error enqueuing force-fulfillment: %v", err2)
 							continue
 						}
 						ll.Infow("Enqueued force-fulfillment", "ethTxID", etx.ID)
@@ -772,6 +793,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 {
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, returning", "err", p.err)
+						lsn.requests.setReason(p.req.req.RequestID().String(), "insufficient subscription balance based on estimate, needs %s", p.fundsNeeded)
 						return processed
 					}
 
@@ -784,6 +806,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 							"blockNumber", p.req.req.Raw().BlockNumber,
 							"blockHash", p.req.req.Raw().BlockHash,
 						)
+						lsn.requests.setReason(p.req.req.RequestID().String(), "dropped, consumer %s is not a contract", p.req.req.Sender())
 						processed[p.req.req.RequestID().String()] = struct{}{}
 						continue
 					}
@@ -794,6 +817,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 			if startBalanceNoReserved.Cmp(p.maxFee) < 0 {
 				// Insufficient funds, have to wait for a user top up. Leave it unprocessed for now
 				ll.Infow("Insufficient balance to fulfill a request, returning")
+				lsn.requests.setReason(p.req.req.RequestID().String(), "insufficient subscription balance, needs %s", p.maxFee)
 				return processed
 			}
 
@@ -847,9 +871,11 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 			})
 			if err != nil {
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
+				lsn.requests.setReason(p.req.req.RequestID().String(), "error enqueuing fulfillment: %v", err)
 				continue
 			}
 			ll.Infow("Enqueued fulfillment", "ethTxID", transaction.GetID())
+			lsn.requests.setReason(p.req.req.RequestID().String(), "fulfillment enqueued as tx %s", transaction.GetID())
 
 			// If we successfully enqueued for the txm, subtract that balance
 			// And loop to attempt to enqueue another fulfillment
@@ -259,6 +259,9 @@ func (lsn *listenerV2) processBatch(
 	})
 	if err != nil {
 		ll.Errorw("Error enqueuing batch fulfillments, requeuing requests", "err", err)
+		for _, reqID := range batch.reqIDs {
+			lsn.requests.setReason(reqID.String(), "error enqueuing batch fulfillment: %v", err)
+		}
 		return
 	}
 	ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.GetID())
@@ -267,6 +270,7 @@ func (lsn *listenerV2) processBatch(
 	// to the txm.
 	for _, reqID := range batch.reqIDs {
 		processedRequestIDs = append(processedRequestIDs, reqID.String())
+		lsn.requests.setReason(reqID.String(), "batch fulfillment enqueued as tx %s", ethTX.GetID())
 		vrfcommon.IncProcessedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, vrfcommon.V2)
 	}
 
@@ -284,6 +288,7 @@ func (lsn *listenerV2) getReadyAndExpired(l logger.Logger, reqs []pendingRequest
 			l.Infow("Request too old, dropping it",
 				"reqID", req.req.RequestID().String(),
 				"txHash", req.req.Raw().TxHash)
+			lsn.requests.setReason(req.req.RequestID().String(), "request timed out after %s, dropped", lsn.job.VRFSpec.RequestTimeout)
 			expired = append(expired, req.req.RequestID().String())
 			vrfcommon.IncDroppedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, vrfcommon.V2, vrfcommon.ReasonAge)
 			continue
@@ -0,0 +1,348 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// RequestState is the state of a VRF request as seen by a running listener.
+type RequestState string
+
+const (
+	// RequestStatePending requests have been seen on chain and are waiting to be fulfilled.
+	// Reason explains why the last processing round did not fulfill them.
+	RequestStatePending RequestState = "pending"
+	// RequestStateInflight requests have been handed to the TXM or otherwise marked as processed,
+	// and will not be processed again until they are pruned from the inflight cache.
+	RequestStateInflight RequestState = "inflight"
+	// RequestStateReverted requests had their fulfillment transaction revert on chain.
+	RequestStateReverted RequestState = "reverted"
+)
+
+// ErrRequestNotFound is returned when retrying a request the listener does not know about.
+var ErrRequestNotFound = errors.New("request not found")
+
+// RequestStatus is a point in time view of a single VRF request.
+type RequestStatus struct {
+	RequestID          string
+	SubID              string
+	Sender             common.Address
+	RequestTxHash      common.Hash
+	RequestBlockNumber uint64
+	ConfirmedAtBlock   uint64
+	CallbackGasLimit   uint32
+	NativePayment      bool
+	State              RequestState
+	Reason             string
+	SubActive          *bool
+	SubLinkBalance     *big.Int
+	SubNativeBalance   *big.Int
+	FulfillmentTxHash  *common.Hash
+	RequestedAt        time.Time
+	UpdatedAt          time.Time
+
+	raw types.Log
+}
+
+// RequestInspector exposes the in-memory request state of a running VRF v2 / v2.5 listener.
+type RequestInspector interface {
+	// Requests returns the pending and in-flight requests of the listener, along with
+	// requests whose fulfillment recently reverted.
+	Requests(ctx context.Context) ([]RequestStatus, error)
+	// RetryRequest evicts the request from the inflight cache so that it is picked up
+	// again on the next processing round.
+	RetryRequest(requestID string) error
+}
+
+// InspectorRegistry keeps track of the RequestInspectors of running VRF jobs.
+type InspectorRegistry struct {
+	mu         sync.RWMutex
+	inspectors map[int32]RequestInspector
+}
+
+func NewInspectorRegistry() *InspectorRegistry {
+	return &InspectorRegistry{inspectors: make(map[int32]RequestInspector)}
+}
+
+func (r *InspectorRegistry) register(jobID int32, inspector RequestInspector) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.inspectors[jobID] = inspector
+}
+
+func (r *InspectorRegistry) unregister(jobID int32) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.inspectors, jobID)
+}
+
+// Get returns the RequestInspector of the running VRF job with the given ID.
+func (r *InspectorRegistry) Get(jobID int32) (RequestInspector, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	inspector, ok := r.inspectors[jobID]
+	return inspector, ok
+}
+
+// requestTracker records the state of every request the listener is currently
+// aware of. All methods are safe to call on a nil tracker.
+type requestTracker struct {
+	mu   sync.RWMutex
+	reqs map[string]*RequestStatus
+}
+
+func newRequestTracker() *requestTracker {
+	return &requestTracker{reqs: make(map[string]*RequestStatus)}
+}
+
+// observe records a request that was seen on chain and is not in the inflight cache.
+func (t *requestTracker) observe(req RandomWordsRequested, confirmedAt uint64, requestedAt time.Time) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	reqID := req.RequestID().String()
+	status, ok := t.reqs[reqID]
+	if !ok {
+		status = &RequestStatus{
+			RequestID:          reqID,
+			SubID:              req.SubID().String(),
+			Sender:             req.Sender(),
+			RequestTxHash:      req.Raw().TxHash,
+			RequestBlockNumber: req.Raw().BlockNumber,
+			CallbackGasLimit:   req.CallbackGasLimit(),
+			NativePayment:      req.NativePayment(),
+			RequestedAt:        requestedAt,
+			raw:                req.Raw(),
+		}
+		t.reqs[reqID] = status
+	}
+	status.State = RequestStatePending
+	status.ConfirmedAtBlock = confirmedAt
+	status.UpdatedAt = time.Now()
+}
+
+// setReason records why the last processing round left the request in its current state.
+func (t *requestTracker) setReason(reqID string, format string, args ...any) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if status, ok := t.reqs[reqID]; ok {
+		status.Reason = fmt.Sprintf(format, args...)
+		status.UpdatedAt = time.Now()
+	}
+}
+
+// setSubscription records the result of the subscription balance check for every request of the sub.
+func (t *requestTracker) setSubscription(reqs []pendingRequest, active bool, linkBalance, nativeBalance *big.Int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, req := range reqs {
+		if status, ok := t.reqs[req.req.RequestID().String()]; ok {
+			status.SubActive = &active
+			status.SubLinkBalance = linkBalance
+			status.SubNativeBalance = nativeBalance
+		}
+	}
+}
+
+func (t *requestTracker) markInflight(reqID string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if status, ok := t.reqs[reqID]; ok {
+		status.State = RequestStateInflight
+		status.UpdatedAt = time.Now()
+	}
+}
+
+// markPending moves a request back to the pending state, e.g. after it was evicted from the inflight cache.
+func (t *requestTracker) markPending(reqID string, reason string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if status, ok := t.reqs[reqID]; ok {
+		status.State = RequestStatePending
+		status.Reason = reason
+		status.UpdatedAt = time.Now()
+	}
+}
+
+// remove drops requests that have been fulfilled on chain.
+func (t *requestTracker) remove(reqID string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.reqs, reqID)
+}
+
+// prune drops requests made before the given time, as the listener no longer processes them.
+func (t *requestTracker) prune(before time.Time) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for reqID, status := range t.reqs {
+		if status.RequestedAt.Before(before) {
+			delete(t.reqs, reqID)
+		}
+	}
+}
+
+func (t *requestTracker) get(reqID string) (RequestStatus, bool) {
+	if t == nil {
+		return RequestStatus{}, false
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	status, ok := t.reqs[reqID]
+	if !ok {
+		return RequestStatus{}, false
+	}
+	return *status, true
+}
+
+func (t *requestTracker) list() []RequestStatus {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	statuses := make([]RequestStatus, 0, len(t.reqs))
+	for _, status := range t.reqs {
+		statuses = append(statuses, *status)
+	}
+	return statuses
+}
+
+var _ RequestInspector = (*listenerV2)(nil)
+
+// Requests implements RequestInspector.
+func (lsn *listenerV2) Requests(ctx context.Context) ([]RequestStatus, error) {
+	statuses := lsn.requests.list()
+
+	reverted, err := lsn.revertedFulfillments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]int, len(statuses))
+	for i, status := range statuses {
+		byID[status.RequestID] = i
+	}
+	for _, r := range reverted {
+		reqID, ok := requestIDFromHex(r.RequestID)
+		if !ok {
+			continue
+		}
+		txHash := r.TxHash
+		i, tracked := byID[reqID]
+		if !tracked {
+			statuses = append(statuses, RequestStatus{
+				RequestID:     reqID,
+				SubID:         fmt.Sprintf("%d", r.SubID),
+				RequestTxHash: common.HexToHash(r.RequestTxHash),
+				State:         RequestStateReverted,
+			})
+			i = len(statuses) - 1
+			byID[reqID] = i
+		}
+		statuses[i].State = RequestStateReverted
+		statuses[i].FulfillmentTxHash = &txHash
+		statuses[i].Reason = fmt.Sprintf("fulfillment transaction %s reverted", txHash)
+	}
+
+	slices.SortFunc(statuses, func(a, b RequestStatus) int {
+		if a.RequestBlockNumber != b.RequestBlockNumber {
+			if a.RequestBlockNumber < b.RequestBlockNumber {
+				return -1
+			}
+			return 1
+		}
+		if a.RequestID < b.RequestID {
+			return -1
+		} else if a.RequestID > b.RequestID {
+			return 1
+		}
+		return 0
+	})
+	return statuses, nil
+}
+
+// RetryRequest implements RequestInspector.
+func (lsn *listenerV2) RetryRequest(requestID string) error {
+	status, ok := lsn.requests.get(requestID)
+	if !ok {
+		return errors.Wrapf(ErrRequestNotFound, "request %s", requestID)
+	}
+	lsn.inflightCache.Remove(status.raw)
+	lsn.requests.markPending(requestID, "retry requested")
+	lsn.l.Infow("Retry requested for VRF request, evicted from inflight cache", "reqID", requestID)
+	return nil
+}
+
+// revertedFulfillments returns fulfillments of this listener's coordinator that recently reverted on chain.
+func (lsn *listenerV2) revertedFulfillments(ctx context.Context) ([]TxnReceiptDB, error) {
+	if lsn.job.VRFSpec == nil {
+		return nil, nil
+	}
+	pollPeriod := lsn.job.VRFSpec.PollPeriod
+	single, err := lsn.fetchRecentSingleTxns(ctx, lsn.ds, lsn.chainID.Uint64(), pollPeriod)
+	if err != nil {
+		return nil, err
+	}
+	forced, err := lsn.fetchRevertedForceFulfilmentTxns(ctx, lsn.ds, lsn.chainID.Uint64(), pollPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []common.Address{lsn.coordinator.Address()}
+	if lsn.job.VRFSpec.VRFOwnerAddress != nil {
+		targets = append(targets, lsn.job.VRFSpec.VRFOwnerAddress.Address())
+	}
+	var reverted []TxnReceiptDB
+	for _, r := range append(single, forced...) {
+		if slices.Contains(targets, r.ToAddress) {
+			reverted = append(reverted, r)
+		}
+	}
+	return reverted, nil
+}
+
+// requestIDFromHex converts a request ID stored in tx meta as a 0x-prefixed hash into its decimal form.
+func requestIDFromHex(s string) (string, bool) {
+	if len(s) < 2 {
+		return "", false
+	}
+	reqID, ok := new(big.Int).SetString(s[2:], 16)
+	if !ok {
+		return "", false
+	}
+	return reqID.String(), true
+}
@@ -0,0 +1,123 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+func newTestRandomWordsRequested(reqID int64, blockNumber uint64) RandomWordsRequested {
+	return NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId:        big.NewInt(reqID),
+		SubId:            7,
+		CallbackGasLimit: 100_000,
+		Raw: types.Log{
+			BlockNumber: blockNumber,
+			BlockHash:   common.HexToHash("0x01"),
+			TxHash:      common.HexToHash("0xd8d7ecc4800d25fa53ce0372f13a416d98907a7ef3d8d3bdd79cf4fe75529c65"),
+			Index:       uint(reqID),
+		},
+	})
+}
+
+func TestRequestTracker(t *testing.T) {
+	tracker := newRequestTracker()
+	now := time.Now().UTC()
+	req1 := newTestRandomWordsRequested(1, 10)
+	req2 := newTestRandomWordsRequested(2, 11)
+
+	tracker.observe(req1, 13, now)
+	tracker.observe(req2, 14, now.Add(-2*time.Hour))
+	tracker.setReason("1", "waiting for confirmations until block %d", 13)
+	tracker.setSubscription([]pendingRequest{{req: req1}}, true, big.NewInt(100), nil)
+
+	status, ok := tracker.get("1")
+	require.True(t, ok)
+	assert.Equal(t, RequestStatePending, status.State)
+	assert.Equal(t, "7", status.SubID)
+	assert.Equal(t, uint64(10), status.RequestBlockNumber)
+	assert.Equal(t, uint64(13), status.ConfirmedAtBlock)
+	assert.Equal(t, uint32(100_000), status.CallbackGasLimit)
+	assert.Equal(t, "waiting for confirmations until block 13", status.Reason)
+	require.NotNil(t, status.SubActive)
+	assert.True(t, *status.SubActive)
+	assert.Equal(t, "100", status.SubLinkBalance.String())
+
+	tracker.markInflight("1")
+	status, _ = tracker.get("1")
+	assert.Equal(t, RequestStateInflight, status.State)
+
+	tracker.markPending("1", "retry requested")
+	status, _ = tracker.get("1")
+	assert.Equal(t, RequestStatePending, status.State)
+	assert.Equal(t, "retry requested", status.Reason)
+
+	tracker.prune(now.Add(-time.Hour))
+	_, ok = tracker.get("2")
+	assert.False(t, ok, "requests older than the timeout should be pruned")
+	require.Len(t, tracker.list(), 1)
+
+	tracker.remove("1")
+	assert.Empty(t, tracker.list())
+
+	// A nil tracker is a no-op, listeners built without New don't track requests.
+	var nilTracker *requestTracker
+	nilTracker.observe(req1, 13, now)
+	nilTracker.setReason("1", "ignored")
+	assert.Empty(t, nilTracker.list())
+}
+
+func TestListenerV2_RetryRequest(t *testing.T) {
+	lsn := &listenerV2{
+		l:             logger.Sugared(logger.Test(t)),
+		inflightCache: vrfcommon.NewInflightCache(10),
+		requests:      newRequestTracker(),
+	}
+	req := newTestRandomWordsRequested(1, 10)
+	lsn.requests.observe(req, 13, time.Now())
+	lsn.inflightCache.Add(req.Raw())
+	lsn.requests.markInflight("1")
+
+	require.ErrorIs(t, lsn.RetryRequest("2"), ErrRequestNotFound)
+
+	require.NoError(t, lsn.RetryRequest("1"))
+	assert.False(t, lsn.inflightCache.Contains(req.Raw()))
+	status, ok := lsn.requests.get("1")
+	require.True(t, ok)
+	assert.Equal(t, RequestStatePending, status.State)
+}
+
+func TestInspectorRegistry(t *testing.T) {
+	registry := NewInspectorRegistry()
+	lsn := &listenerV2{}
+
+	_, ok := registry.Get(1)
+	assert.False(t, ok)
+
+	registry.register(1, lsn)
+	inspector, ok := registry.Get(1)
+	require.True(t, ok)
+	assert.Equal(t, RequestInspector(lsn), inspector)
+
+	registry.unregister(1)
+	_, ok = registry.Get(1)
+	assert.False(t, ok)
+}
+
+func TestRequestIDFromHex(t *testing.T) {
+	reqID, ok := requestIDFromHex(common.BytesToHash(big.NewInt(255).Bytes()).Hex())
+	require.True(t, ok)
+	assert.Equal(t, "255", reqID)
+
+	_, ok = requestIDFromHex("0xzz")
+	assert.False(t, ok)
+}
@@ -9,6 +9,7 @@ import (
 type InflightCache interface {
 	Add(lg types.Log)
 	Contains(lg types.Log) bool
+	Remove(lg types.Log)
 	Size() int
 }
 
@@ -69,6 +70,18 @@ func (c *inflightCache) Contains(lg types.Log) bool {
 	return ok
 }
 
+// Remove evicts the log from the cache, so that its request is processed again.
+func (c *inflightCache) Remove(lg types.Log) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.cache, logKey{
+		blockHash:   lg.BlockHash,
+		blockNumber: lg.BlockNumber,
+		logIndex:    lg.Index,
+	})
+}
+
 func (c *inflightCache) prune(logBlock uint64) {
 	// Only prune every pruneInterval blocks
 	if int(logBlock)-int(c.lastPruneHeight) < cachePruneInterval {
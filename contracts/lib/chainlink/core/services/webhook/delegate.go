@@ -180,7 +180,10 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 	run := pipeline.NewRun(*spec.PipelineSpec, vars)
 
 	_, err := r.runner.Run(ctx, run, true, nil)
-	if err != nil {
+	if errors.Is(err, pipeline.ErrDraining) {
+		jobLggr.Infow("Refusing webhook run, the node is draining")
+		return 0, err
+	} else if err != nil {
 		jobLggr.Errorw("Error running pipeline for webhook job", "err", err)
 		return 0, err
 	}
@@ -0,0 +1,74 @@
+package apitokens
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+// LastUsedPrecision is how stale the last use of a token may be, so that it is not written on
+// every request.
+const LastUsedPrecision = time.Minute
+
+// Token is a named API token of a user. Unlike the token stored with the user itself, a user may
+// have several of them, each with an optional expiry and an optional set of permissions.
+type Token struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	// Grants are the only permissions the token may use, within those of its user. A token
+	// without grants has all the permissions of its user.
+	Grants     rbac.Grants
+	ExpiresAt  null.Time
+	LastUsedAt null.Time
+	CreatedAt  time.Time
+}
+
+// Restricted reports whether the token only has some of the permissions of its user.
+func (t Token) Restricted() bool {
+	return len(t.Grants) > 0
+}
+
+// Expired reports whether the token has expired at the given time.
+func (t Token) Expired(now time.Time) bool {
+	return t.ExpiresAt.Valid && !now.Before(t.ExpiresAt.Time)
+}
+
+// NeedsLastUsedUpdate reports whether the last use of the token is too stale to be left as is.
+func (t Token) NeedsLastUsedUpdate(now time.Time) bool {
+	return !t.LastUsedAt.Valid || now.Sub(t.LastUsedAt.Time) >= LastUsedPrecision
+}
+
+// Authenticate reports whether the credentials are those of the token.
+func (t Token) Authenticate(credentials *auth.Token) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(credentials, t.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.TokenHashedSecret)) == 1, nil
+}
+
+// Validate checks the name, expiry and grants of a new token.
+func (t Token) Validate(now time.Time) error {
+	if t.Name == "" {
+		return pkgerrors.New("token name is required")
+	}
+	if t.Expired(now) {
+		return pkgerrors.New("token expiry must be in the future")
+	}
+	for i, g := range t.Grants {
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("grant %d: %w", i, err)
+		}
+	}
+	return nil
+}
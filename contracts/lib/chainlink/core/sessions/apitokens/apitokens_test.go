@@ -0,0 +1,72 @@
+package apitokens_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestToken_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	credentials := auth.NewToken()
+	hashedSecret, err := auth.HashedSecret(credentials, "salt")
+	require.NoError(t, err)
+	token := apitokens.Token{TokenKey: credentials.AccessKey, TokenSalt: "salt", TokenHashedSecret: hashedSecret}
+
+	ok, err := token.Authenticate(credentials)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = token.Authenticate(&auth.Token{AccessKey: credentials.AccessKey, Secret: "wrong"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestToken_Expiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	assert.False(t, apitokens.Token{}.Expired(now))
+	assert.False(t, apitokens.Token{ExpiresAt: null.TimeFrom(now.Add(time.Hour))}.Expired(now))
+	assert.True(t, apitokens.Token{ExpiresAt: null.TimeFrom(now)}.Expired(now))
+
+	assert.True(t, apitokens.Token{}.NeedsLastUsedUpdate(now))
+	assert.False(t, apitokens.Token{LastUsedAt: null.TimeFrom(now.Add(-time.Second))}.NeedsLastUsedUpdate(now))
+	assert.True(t, apitokens.Token{LastUsedAt: null.TimeFrom(now.Add(-apitokens.LastUsedPrecision))}.NeedsLastUsedUpdate(now))
+}
+
+func TestToken_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name    string
+		token   apitokens.Token
+		wantErr string
+	}{
+		{"unrestricted", apitokens.Token{Name: "ci"}, ""},
+		{"restricted", apitokens.Token{Name: "ci", Grants: rbac.Grants{{Permission: rbac.JobsRun, JobIDs: []int32{1}}}}, ""},
+		{"expiring", apitokens.Token{Name: "ci", ExpiresAt: null.TimeFrom(now.Add(time.Hour))}, ""},
+		{"no name", apitokens.Token{}, "name is required"},
+		{"expired", apitokens.Token{Name: "ci", ExpiresAt: null.TimeFrom(now.Add(-time.Hour))}, "must be in the future"},
+		{"unknown permission", apitokens.Token{Name: "ci", Grants: rbac.Grants{{Permission: "jobs:fly"}}}, "grant 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.token.Validate(now)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package apitokens
+
+import (
+	"context"
+	"database/sql"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ORM stores the named API tokens of users. Users are referenced by email, so that tokens can be
+// created by users of any authentication provider.
+type ORM interface {
+	CreateToken(ctx context.Context, token *Token) (*auth.Token, error)
+	ListTokens(ctx context.Context, email string) ([]Token, error)
+	FindTokenByKey(ctx context.Context, accessKey string) (Token, error)
+	DeleteToken(ctx context.Context, email string, name string) error
+	MarkUsed(ctx context.Context, id int64) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// CreateToken generates the credentials of a new token and stores their hash. The credentials
+// are returned, and cannot be retrieved afterwards.
+func (o *orm) CreateToken(ctx context.Context, token *Token) (*auth.Token, error) {
+	credentials := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(credentials, salt)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to hash token secret")
+	}
+	token.TokenKey = credentials.AccessKey
+	token.TokenSalt = salt
+	token.TokenHashedSecret = hashedSecret
+
+	query, args, err := o.ds.BindNamed(`INSERT INTO user_api_tokens (user_email, name, token_key, token_salt, token_hashed_secret, grants, expires_at, created_at)
+		VALUES (lower(:user_email), :name, :token_key, :token_salt, :token_hashed_secret, :grants, :expires_at, NOW())
+		RETURNING *;`, token)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error binding arg")
+	}
+	if err = o.ds.GetContext(ctx, token, query, args...); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// ListTokens returns the tokens of the user with the given email, sorted by name.
+func (o *orm) ListTokens(ctx context.Context, email string) (tokens []Token, err error) {
+	err = o.ds.SelectContext(ctx, &tokens, "SELECT * FROM user_api_tokens WHERE user_email = lower($1) ORDER BY name", email)
+	return
+}
+
+// FindTokenByKey looks up a token by its access key.
+// Returns sql.ErrNoRows if no token has this key
+func (o *orm) FindTokenByKey(ctx context.Context, accessKey string) (token Token, err error) {
+	err = o.ds.GetContext(ctx, &token, "SELECT * FROM user_api_tokens WHERE token_key = $1", accessKey)
+	return
+}
+
+// DeleteToken revokes a token of the user with the given email.
+// Returns sql.ErrNoRows if the user has no token with this name
+func (o *orm) DeleteToken(ctx context.Context, email string, name string) error {
+	res, err := o.ds.ExecContext(ctx, "DELETE FROM user_api_tokens WHERE user_email = lower($1) AND name = $2", email, name)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// MarkUsed records that a token was just used.
+func (o *orm) MarkUsed(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, "UPDATE user_api_tokens SET last_used_at = NOW() WHERE id = $1", id)
+	return err
+}
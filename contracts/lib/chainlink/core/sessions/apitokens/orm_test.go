@@ -0,0 +1,62 @@
+package apitokens_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestORM_Tokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := apitokens.NewORM(pgtest.NewSqlxDB(t))
+
+	token := apitokens.Token{
+		UserEmail: "Operator@chain.link",
+		Name:      "ci",
+		Grants:    rbac.Grants{{Permission: rbac.JobsRun, JobIDs: []int32{1}}},
+	}
+	credentials, err := orm.CreateToken(ctx, &token)
+	require.NoError(t, err)
+	assert.NotZero(t, token.ID)
+	assert.Equal(t, "operator@chain.link", token.UserEmail)
+	assert.Equal(t, credentials.AccessKey, token.TokenKey)
+	assert.NotEqual(t, credentials.Secret, token.TokenHashedSecret)
+
+	_, err = orm.CreateToken(ctx, &apitokens.Token{UserEmail: "operator@chain.link", Name: "ci"})
+	require.Error(t, err)
+	_, err = orm.CreateToken(ctx, &apitokens.Token{UserEmail: "operator@chain.link", Name: "full"})
+	require.NoError(t, err)
+
+	found, err := orm.FindTokenByKey(ctx, credentials.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, token.Grants, found.Grants)
+	assert.False(t, found.LastUsedAt.Valid)
+	ok, err := found.Authenticate(credentials)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, orm.MarkUsed(ctx, found.ID))
+	found, err = orm.FindTokenByKey(ctx, credentials.AccessKey)
+	require.NoError(t, err)
+	assert.True(t, found.LastUsedAt.Valid)
+
+	tokens, err := orm.ListTokens(ctx, "OPERATOR@chain.link")
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "ci", tokens[0].Name)
+	assert.False(t, tokens[1].Restricted())
+
+	require.NoError(t, orm.DeleteToken(ctx, "operator@chain.link", "ci"))
+	require.ErrorIs(t, orm.DeleteToken(ctx, "operator@chain.link", "ci"), sql.ErrNoRows)
+	_, err = orm.FindTokenByKey(ctx, credentials.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
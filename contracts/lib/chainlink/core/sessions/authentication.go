@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -29,6 +30,19 @@ var ErrNotSupported = fmt.Errorf("functionality not supported with current authe
 // ErrEmptySessionID captures the empty case error message
 var ErrEmptySessionID = errors.New("session ID cannot be empty")
 
+// ErrSessionNotFound is returned when no active session matches
+var ErrSessionNotFound = errors.New("session not found")
+
+// LoginLockedError is returned when a login is refused, without checking the credentials, after too many failed
+// attempts for the account or from the IP address.
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, try again in %s", e.RetryAfter.Round(time.Second))
+}
+
 // BasicAdminUsersORM is the interface that defines the functionality required for supporting basic admin functionality
 // adjacent to the identity provider authentication provider implementation. It is currently implemented by the local
 // users/sessions ORM containing local admin CLI actions. This is separate from the AuthenticationProvider,
@@ -59,6 +73,12 @@ type AuthenticationProvider interface {
 	SetPassword(ctx context.Context, user *User, newPassword string) error
 	TestPassword(ctx context.Context, email, password string) error
 	Sessions(ctx context.Context, offset, limit int) ([]Session, error)
+	// ActiveSessions returns the unexpired sessions of all users, most recently used first.
+	ActiveSessions(ctx context.Context) ([]Session, error)
+	// DeleteSessionsForUser logs the user out of all their sessions, returning how many were deleted.
+	DeleteSessionsForUser(ctx context.Context, email string) (int64, error)
+	// UnlockUser clears the failed logins of the user, lifting any lockout of the account.
+	UnlockUser(ctx context.Context, email string) error
 	GetUserWebAuthn(ctx context.Context, email string) ([]WebAuthn, error)
 	SaveWebAuthn(ctx context.Context, token *WebAuthn) error
 	ExtendRouter(r *gin.RouterGroup) error
@@ -354,7 +354,7 @@ func (l *ldapAuthenticator) AuthorizedUserWithSession(ctx context.Context, sessi
 		Valid     bool
 	}
 	if err := l.ds.GetContext(ctx, &foundSession,
-		"SELECT user_email, user_role, created_at + $2 >= now() as valid FROM ldap_sessions WHERE id = $1",
+		"UPDATE ldap_sessions SET last_used = now() WHERE id = $1 RETURNING user_email, user_role, created_at + $2 >= now() as valid",
 		sessionID, l.config.SessionTimeout().Duration(),
 	); err != nil {
 		return sessions.User{}, sessions.ErrUserSessionExpired
@@ -440,11 +440,13 @@ func (l *ldapAuthenticator) CreateSession(ctx context.Context, sr sessions.Sessi
 	session := sessions.NewSession()
 	_, err = l.ds.ExecContext(
 		ctx,
-		"INSERT INTO ldap_sessions (id, user_email, user_role, localauth_user, created_at) VALUES ($1, $2, $3, $4, now())",
+		"INSERT INTO ldap_sessions (id, user_email, user_role, localauth_user, ip_address, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, $5, $6, now(), now())",
 		session.ID,
 		strings.ToLower(sr.Email),
 		foundUser.Role,
 		isLocalUser,
+		sr.IPAddress,
+		sr.UserAgent,
 	)
 	if err != nil {
 		l.lggr.Errorf("unable to create new session in ldap_sessions table %v", err)
@@ -601,13 +603,38 @@ func (l *ldapAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.We
 // Sessions returns all sessions limited by the parameters.
 func (l *ldapAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]sessions.Session, error) {
 	var sessions []sessions.Session
-	sql := `SELECT * FROM ldap_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
+	sql := `SELECT ` + sessionColumns + ` FROM ldap_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
 	if err := l.ds.SelectContext(ctx, &sessions, sql, limit, offset); err != nil {
 		return sessions, nil
 	}
 	return sessions, nil
 }
 
+// sessionColumns maps the ldap_sessions columns to sessions.Session
+const sessionColumns = "id, user_email AS email, ip_address, user_agent, last_used, created_at"
+
+// ActiveSessions returns the unexpired ldap_sessions, most recently used first
+func (l *ldapAuthenticator) ActiveSessions(ctx context.Context) ([]sessions.Session, error) {
+	var sessions []sessions.Session
+	sql := `SELECT ` + sessionColumns + ` FROM ldap_sessions WHERE created_at + $1 >= now() ORDER BY last_used DESC, id;`
+	err := l.ds.SelectContext(ctx, &sessions, sql, l.config.SessionTimeout().Duration())
+	return sessions, err
+}
+
+// DeleteSessionsForUser removes all the ldap_sessions of the user
+func (l *ldapAuthenticator) DeleteSessionsForUser(ctx context.Context, email string) (int64, error) {
+	res, err := l.ds.ExecContext(ctx, "DELETE FROM ldap_sessions WHERE user_email = lower($1)", email)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// UnlockUser is not supported, failed logins are handled by the upstream LDAP server
+func (l *ldapAuthenticator) UnlockUser(ctx context.Context, email string) error {
+	return sessions.ErrNotSupported
+}
+
 // FindExternalInitiator supports the 'Run' role external intiator header auth functionality
 func (l *ldapAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	exi := &bridges.ExternalInitiator{}
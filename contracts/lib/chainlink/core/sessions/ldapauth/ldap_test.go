@@ -487,6 +487,46 @@ func TestORM_CreateSession_LocalAdminFallbackLogin(t *testing.T) {
 	require.ErrorContains(t, err, "invalid password")
 }
 
+func TestORM_ActiveSessions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	mockLdapClient := mocks.NewLDAPClient(t)
+	mockLdapConnProvider := mocks.NewLDAPConn(t)
+	mockLdapClient.On("CreateEphemeralConnection").Return(mockLdapConnProvider, nil)
+	mockLdapConnProvider.On("Close").Return(nil)
+	db, ldapAuthProvider := setupAuthenticationProvider(t, mockLdapClient)
+
+	// Local admin fallback login, the session is stored in ldap_sessions as well
+	mockLdapConnProvider.On("Bind", mock.Anything, cltest.Password).Return(errors.New("unable to login via LDAP server")).Once()
+	sessionID, err := ldapAuthProvider.CreateSession(ctx, sessions.SessionRequest{
+		Email:     cltest.APIEmailAdmin,
+		Password:  cltest.Password,
+		IPAddress: "10.0.0.1",
+		UserAgent: "curl/8.0",
+	})
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO ldap_sessions (id, user_email, user_role, localauth_user, created_at) VALUES ($1, $2, $3, false, now())", "ldap-session", "ldap-user@chainlink.test", sessions.UserRoleView)
+	require.NoError(t, err)
+
+	active, err := ldapAuthProvider.ActiveSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	found, err := sessions.FindSessionByPublicID(active, sessions.Session{ID: sessionID}.PublicID())
+	require.NoError(t, err)
+	assert.Equal(t, cltest.APIEmailAdmin, found.Email)
+	assert.Equal(t, "10.0.0.1", found.IPAddress)
+	assert.Equal(t, "curl/8.0", found.UserAgent)
+
+	deleted, err := ldapAuthProvider.DeleteSessionsForUser(ctx, "LDAP-user@chainlink.test")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	active, err = ldapAuthProvider.ActiveSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, sessionID, active[0].ID)
+}
+
 func TestORM_SetPassword_LocalAdminFallbackLogin(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
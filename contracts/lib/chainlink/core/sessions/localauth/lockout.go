@@ -0,0 +1,148 @@
+package localauth
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// baseLoginDelay is the delay after the second consecutive failed login of an account. The first failure is free, and
+// the delay doubles with every further failure.
+const baseLoginDelay = time.Second
+
+const (
+	failureKindEmail = "email"
+	failureKindIP    = "ip"
+)
+
+// LockoutConfig configures the failed login tracking. The zero value disables it.
+type LockoutConfig struct {
+	MaxFailures      uint32
+	MaxFailuresPerIP uint32
+	LockoutDuration  time.Duration
+	MaxDelay         time.Duration
+}
+
+// NewLockoutConfig returns the LockoutConfig of the WebServer.LoginLockout config.
+func NewLockoutConfig(c config.LoginLockout) LockoutConfig {
+	return LockoutConfig{
+		MaxFailures:      c.MaxFailures(),
+		MaxFailuresPerIP: c.MaxFailuresPerIP(),
+		LockoutDuration:  c.LockoutDuration(),
+		MaxDelay:         c.MaxDelay(),
+	}
+}
+
+// loginDelay returns how long the account is refused logins after its nth consecutive failure.
+func (c LockoutConfig) loginDelay(failures uint32) time.Duration {
+	if failures < 2 {
+		return 0
+	}
+	if shift := failures - 2; shift < 30 && baseLoginDelay<<shift < c.MaxDelay {
+		return baseLoginDelay << shift
+	}
+	return c.MaxDelay
+}
+
+// checkLoginLockout returns a *sessions.LoginLockedError if logins are currently refused for the email or the IP
+// address.
+func (o *orm) checkLoginLockout(ctx context.Context, email, ip string) error {
+	if o.lockout.MaxFailures == 0 {
+		return nil
+	}
+	var seconds float64
+	err := o.ds.GetContext(ctx, &seconds, `SELECT COALESCE(EXTRACT(EPOCH FROM max(locked_until) - now()), 0)::float8 FROM login_failures
+		WHERE ((kind = $1 AND key = lower($2)) OR (kind = $3 AND key = lower($4))) AND locked_until > now()`,
+		failureKindEmail, email, failureKindIP, ip)
+	if err != nil {
+		return err
+	}
+	if seconds <= 0 {
+		return nil
+	}
+	return &sessions.LoginLockedError{RetryAfter: time.Duration(math.Ceil(seconds)) * time.Second}
+}
+
+// recordLoginFailure counts a failed login against the email and the IP address, delaying the next attempt or locking
+// them out as configured. Failures older than the lockout duration are forgotten.
+func (o *orm) recordLoginFailure(ctx context.Context, email, ip string) {
+	if o.lockout.MaxFailures == 0 {
+		return
+	}
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM login_failures WHERE last_failure_at + $1 < now() AND (locked_until IS NULL OR locked_until < now())`, o.lockout.LockoutDuration); err != nil {
+			return err
+		}
+
+		failures, err := incrementLoginFailures(ctx, tx, failureKindEmail, email, o.lockout.LockoutDuration)
+		if err != nil {
+			return err
+		}
+		lockFor := o.lockout.loginDelay(failures)
+		if failures >= o.lockout.MaxFailures {
+			lockFor = o.lockout.LockoutDuration
+			o.lggr.Warnw("Locked out account after too many failed logins", "email", email, "failures", failures, "duration", lockFor)
+			o.auditLogger.Audit(audit.AuthLoginLockedOut, map[string]interface{}{"email": email, "ip": ip, "failures": failures, "duration": lockFor.String()})
+		}
+		if err = lockLogins(ctx, tx, failureKindEmail, email, lockFor); err != nil {
+			return err
+		}
+
+		if o.lockout.MaxFailuresPerIP == 0 || ip == "" {
+			return nil
+		}
+		failures, err = incrementLoginFailures(ctx, tx, failureKindIP, ip, o.lockout.LockoutDuration)
+		if err != nil {
+			return err
+		}
+		if failures < o.lockout.MaxFailuresPerIP {
+			return nil
+		}
+		o.lggr.Warnw("Locked out IP address after too many failed logins", "ip", ip, "failures", failures, "duration", o.lockout.LockoutDuration)
+		o.auditLogger.Audit(audit.AuthLoginIPLockedOut, map[string]interface{}{"ip": ip, "failures": failures, "duration": o.lockout.LockoutDuration.String()})
+		return lockLogins(ctx, tx, failureKindIP, ip, o.lockout.LockoutDuration)
+	})
+	if err != nil {
+		o.lggr.Errorw("Failed to record failed login", "email", email, "err", err)
+	}
+}
+
+func incrementLoginFailures(ctx context.Context, ds sqlutil.DataSource, kind, key string, window time.Duration) (failures uint32, err error) {
+	err = ds.GetContext(ctx, &failures, `INSERT INTO login_failures (kind, key, failures, last_failure_at) VALUES ($1, lower($2), 1, now())
+		ON CONFLICT (kind, key) DO UPDATE SET
+			failures = CASE WHEN login_failures.last_failure_at + $3 < now() THEN 1 ELSE login_failures.failures + 1 END,
+			last_failure_at = now()
+		RETURNING failures`, kind, key, window)
+	return
+}
+
+func lockLogins(ctx context.Context, ds sqlutil.DataSource, kind, key string, lockFor time.Duration) error {
+	if lockFor <= 0 {
+		return nil
+	}
+	_, err := ds.ExecContext(ctx, `UPDATE login_failures SET locked_until = now() + $3 WHERE kind = $1 AND key = lower($2)`, kind, key, lockFor)
+	return err
+}
+
+// resetLoginFailures forgets the failed logins of the email after a successful login. The failures of the IP address
+// are kept, as they may be spread over many accounts.
+func (o *orm) resetLoginFailures(ctx context.Context, email string) {
+	if o.lockout.MaxFailures == 0 {
+		return
+	}
+	if _, err := o.ds.ExecContext(ctx, `DELETE FROM login_failures WHERE kind = $1 AND key = lower($2)`, failureKindEmail, email); err != nil {
+		o.lggr.Errorw("Failed to reset failed logins", "email", email, "err", err)
+	}
+}
+
+// UnlockUser clears the failed logins of the email, lifting any lockout of the account.
+func (o *orm) UnlockUser(ctx context.Context, email string) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM login_failures WHERE kind = $1 AND key = lower($2)`, failureKindEmail, email)
+	return err
+}
@@ -25,18 +25,20 @@ type orm struct {
 	sessionDuration time.Duration
 	lggr            logger.Logger
 	auditLogger     audit.AuditLogger
+	lockout         LockoutConfig
 }
 
 // orm implements sessions.AuthenticationProvider and sessions.BasicAdminUsersORM interfaces
 var _ sessions.AuthenticationProvider = (*orm)(nil)
 var _ sessions.BasicAdminUsersORM = (*orm)(nil)
 
-func NewORM(ds sqlutil.DataSource, sd time.Duration, lggr logger.Logger, auditLogger audit.AuditLogger) sessions.AuthenticationProvider {
+func NewORM(ds sqlutil.DataSource, sd time.Duration, lggr logger.Logger, auditLogger audit.AuditLogger, lockout LockoutConfig) sessions.AuthenticationProvider {
 	return &orm{
 		ds:              ds,
 		sessionDuration: sd,
 		lggr:            lggr.Named("LocalAuthAuthenticationProviderORM"),
 		auditLogger:     auditLogger,
+		lockout:         lockout,
 	}
 }
 
@@ -113,6 +115,13 @@ func (o *orm) DeleteUser(ctx context.Context, email string) error {
 		if _, err := tx.ExecContext(ctx, "DELETE FROM users WHERE email = $1", email); err != nil {
 			return err
 		}
+		// custom roles and API tokens are linked by email and would otherwise be inherited by a new user with the same email
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_custom_roles WHERE user_email = lower($1)", email); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_api_tokens WHERE user_email = lower($1)", email); err != nil {
+			return err
+		}
 		return nil
 	})
 }
@@ -142,8 +151,14 @@ func (o *orm) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.Web
 // the hashed API User password in the db. Also will check WebAuthn if it's
 // enabled for that user.
 func (o *orm) CreateSession(ctx context.Context, sr sessions.SessionRequest) (string, error) {
+	// Refuse the login before checking the credentials while the account or IP address is locked out
+	if err := o.checkLoginLockout(ctx, sr.Email, sr.IPAddress); err != nil {
+		return "", err
+	}
+
 	user, err := o.FindUser(ctx, sr.Email)
 	if err != nil {
+		o.recordLoginFailure(ctx, sr.Email, sr.IPAddress)
 		return "", err
 	}
 	lggr := o.lggr.With("user", user.Email)
@@ -153,11 +168,13 @@ func (o *orm) CreateSession(ctx context.Context, sr sessions.SessionRequest) (st
 	// for MFA tokens leaking if an account has MFA tokens or not.
 	if !constantTimeEmailCompare(strings.ToLower(sr.Email), strings.ToLower(user.Email)) {
 		o.auditLogger.Audit(audit.AuthLoginFailedEmail, map[string]interface{}{"email": sr.Email})
+		o.recordLoginFailure(ctx, sr.Email, sr.IPAddress)
 		return "", pkgerrors.New("Invalid email")
 	}
 
 	if !utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
 		o.auditLogger.Audit(audit.AuthLoginFailedPassword, map[string]interface{}{"email": sr.Email})
+		o.recordLoginFailure(ctx, sr.Email, sr.IPAddress)
 		return "", pkgerrors.New("Invalid password")
 	}
 
@@ -173,8 +190,9 @@ func (o *orm) CreateSession(ctx context.Context, sr sessions.SessionRequest) (st
 	if len(uwas) == 0 {
 		lggr.Infof("No MFA for user. Creating Session")
 		session := sessions.NewSession()
-		_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+		_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, ip_address, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())", session.ID, user.Email, sr.IPAddress, sr.UserAgent)
 		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": sr.Email})
+		o.resetLoginFailures(ctx, user.Email)
 		return session.ID, err
 	}
 
@@ -206,14 +224,16 @@ func (o *orm) CreateSession(ctx context.Context, sr sessions.SessionRequest) (st
 	if err != nil {
 		// The user does have WebAuthn enabled but failed the check
 		o.auditLogger.Audit(audit.AuthLoginFailed2FA, map[string]interface{}{"email": sr.Email, "error": err})
+		o.recordLoginFailure(ctx, sr.Email, sr.IPAddress)
 		lggr.Errorf("User sent an invalid attestation: %v", err)
 		return "", pkgerrors.New("MFA Error")
 	}
 
 	lggr.Infof("User passed MFA authentication and login will proceed")
+	o.resetLoginFailures(ctx, user.Email)
 	// This is a success so we can create the sessions
 	session := sessions.NewSession()
-	_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+	_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, ip_address, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())", session.ID, user.Email, sr.IPAddress, sr.UserAgent)
 	if err != nil {
 		return "", err
 	}
@@ -357,6 +377,22 @@ func (o *orm) Sessions(ctx context.Context, offset, limit int) (sessions []sessi
 	return
 }
 
+// ActiveSessions returns the unexpired sessions, most recently used first.
+func (o *orm) ActiveSessions(ctx context.Context) (sessions []sessions.Session, err error) {
+	sql := `SELECT * FROM sessions WHERE last_used + $1 >= now() ORDER BY last_used DESC, id;`
+	err = o.ds.SelectContext(ctx, &sessions, sql, o.sessionDuration)
+	return
+}
+
+// DeleteSessionsForUser deletes all the sessions of the user.
+func (o *orm) DeleteSessionsForUser(ctx context.Context, email string) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, "DELETE FROM sessions WHERE lower(email) = lower($1)", email)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
 // NOTE: this is duplicated from the bridges ORM to appease the AuthStorer interface
 func (o *orm) FindExternalInitiator(
 	ctx context.Context,
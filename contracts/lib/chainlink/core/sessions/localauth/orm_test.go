@@ -2,6 +2,7 @@ package localauth_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -28,7 +29,7 @@ func setupORM(t *testing.T) (*sqlx.DB, sessions.AuthenticationProvider) {
 	t.Helper()
 
 	db := pgtest.NewSqlxDB(t)
-	orm := localauth.NewORM(db, time.Minute, logger.TestLogger(t), &audit.AuditLoggerService{})
+	orm := localauth.NewORM(db, time.Minute, logger.TestLogger(t), &audit.AuditLoggerService{}, localauth.LockoutConfig{})
 
 	return db, orm
 }
@@ -71,7 +72,7 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := testutils.Context(t)
 			db := pgtest.NewSqlxDB(t)
-			orm := localauth.NewORM(db, test.sessionDuration, logger.TestLogger(t), &audit.AuditLoggerService{})
+			orm := localauth.NewORM(db, test.sessionDuration, logger.TestLogger(t), &audit.AuditLoggerService{}, localauth.LockoutConfig{})
 
 			user := cltest.MustRandomUser(t)
 			require.NoError(t, orm.CreateUser(ctx, &user))
@@ -197,6 +198,111 @@ func TestORM_CreateSession(t *testing.T) {
 	}
 }
 
+func TestORM_ActiveSessions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db, orm := setupORM(t)
+	alice := cltest.MustRandomUser(t)
+	bob := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &alice))
+	require.NoError(t, orm.CreateUser(ctx, &bob))
+
+	aliceSession, err := orm.CreateSession(ctx, sessions.SessionRequest{Email: alice.Email, Password: cltest.Password, IPAddress: "10.0.0.1", UserAgent: "curl/8.0"})
+	require.NoError(t, err)
+	_, err = orm.CreateSession(ctx, sessions.SessionRequest{Email: bob.Email, Password: cltest.Password})
+	require.NoError(t, err)
+	_, err = orm.CreateSession(ctx, sessions.SessionRequest{Email: bob.Email, Password: cltest.Password})
+	require.NoError(t, err)
+	expired := sessions.NewSession()
+	_, err = db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now() - interval '1 hour', now() - interval '1 hour')", expired.ID, alice.Email)
+	require.NoError(t, err)
+
+	active, err := orm.ActiveSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 3)
+	found, err := sessions.FindSessionByPublicID(active, sessions.Session{ID: aliceSession}.PublicID())
+	require.NoError(t, err)
+	assert.Equal(t, alice.Email, found.Email)
+	assert.Equal(t, "10.0.0.1", found.IPAddress)
+	assert.Equal(t, "curl/8.0", found.UserAgent)
+	_, err = sessions.FindSessionByPublicID(active, expired.PublicID())
+	require.ErrorIs(t, err, sessions.ErrSessionNotFound)
+
+	deleted, err := orm.DeleteSessionsForUser(ctx, bob.Email)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	active, err = orm.ActiveSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, aliceSession, active[0].ID)
+}
+
+func TestORM_LoginLockout(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	orm := localauth.NewORM(db, time.Minute, logger.TestLogger(t), &audit.AuditLoggerService{}, localauth.LockoutConfig{
+		MaxFailures:      3,
+		MaxFailuresPerIP: 5,
+		LockoutDuration:  15 * time.Minute,
+		MaxDelay:         30 * time.Second,
+	})
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &user))
+
+	// the delays between failed logins are over
+	expireDelays := func() {
+		_, err := db.Exec("UPDATE login_failures SET locked_until = now() - interval '1 second' WHERE locked_until < now() + interval '1 minute'")
+		require.NoError(t, err)
+	}
+	login := func(email, password, ip string) error {
+		_, err := orm.CreateSession(ctx, sessions.SessionRequest{Email: email, Password: password, IPAddress: ip})
+		return err
+	}
+
+	t.Run("progressive delay and lockout", func(t *testing.T) {
+		var lockedErr *sessions.LoginLockedError
+
+		// the first failure is free
+		require.ErrorContains(t, login(user.Email, "wrong", "10.0.0.1"), "Invalid password")
+		require.ErrorContains(t, login(user.Email, "wrong", "10.0.0.1"), "Invalid password")
+
+		// the next attempt is delayed, whatever the credentials
+		require.ErrorAs(t, login(user.Email, cltest.Password, "10.0.0.2"), &lockedErr)
+		assert.Equal(t, time.Second, lockedErr.RetryAfter)
+
+		expireDelays()
+		require.ErrorContains(t, login(user.Email, "wrong", "10.0.0.1"), "Invalid password")
+		require.ErrorAs(t, login(user.Email, cltest.Password, "10.0.0.2"), &lockedErr)
+		assert.Equal(t, 15*time.Minute, lockedErr.RetryAfter)
+
+		expireDelays()
+		require.ErrorAs(t, login(user.Email, cltest.Password, "10.0.0.2"), &lockedErr, "still locked out")
+
+		require.NoError(t, orm.UnlockUser(ctx, user.Email))
+		require.NoError(t, login(user.Email, cltest.Password, "10.0.0.2"))
+	})
+
+	t.Run("success resets the failures", func(t *testing.T) {
+		require.Error(t, login(user.Email, "wrong", "10.0.0.3"))
+		require.NoError(t, login(user.Email, cltest.Password, "10.0.0.3"))
+		require.Error(t, login(user.Email, "wrong", "10.0.0.3"))
+		require.NoError(t, login(user.Email, cltest.Password, "10.0.0.3"), "the first failure is free again")
+	})
+
+	t.Run("IP address lockout", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			require.ErrorContains(t, login(fmt.Sprintf("unknown%d@chainlink.test", i), "wrong", "10.0.0.4"), "no rows")
+		}
+		var lockedErr *sessions.LoginLockedError
+		require.ErrorAs(t, login(user.Email, cltest.Password, "10.0.0.4"), &lockedErr)
+		assert.Equal(t, 15*time.Minute, lockedErr.RetryAfter)
+		require.NoError(t, login(user.Email, cltest.Password, "10.0.0.5"))
+	})
+}
+
 func TestORM_WebAuthn(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -28,6 +28,64 @@ func (_m *AuthenticationProvider) EXPECT() *AuthenticationProvider_Expecter {
 	return &AuthenticationProvider_Expecter{mock: &_m.Mock}
 }
 
+// ActiveSessions provides a mock function with given fields: ctx
+func (_m *AuthenticationProvider) ActiveSessions(ctx context.Context) ([]sessions.Session, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveSessions")
+	}
+
+	var r0 []sessions.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sessions.Session, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []sessions.Session); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_ActiveSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActiveSessions'
+type AuthenticationProvider_ActiveSessions_Call struct {
+	*mock.Call
+}
+
+// ActiveSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthenticationProvider_Expecter) ActiveSessions(ctx interface{}) *AuthenticationProvider_ActiveSessions_Call {
+	return &AuthenticationProvider_ActiveSessions_Call{Call: _e.mock.On("ActiveSessions", ctx)}
+}
+
+func (_c *AuthenticationProvider_ActiveSessions_Call) Run(run func(ctx context.Context)) *AuthenticationProvider_ActiveSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_ActiveSessions_Call) Return(_a0 []sessions.Session, _a1 error) *AuthenticationProvider_ActiveSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_ActiveSessions_Call) RunAndReturn(run func(context.Context) ([]sessions.Session, error)) *AuthenticationProvider_ActiveSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AuthorizedUserWithSession provides a mock function with given fields: ctx, sessionID
 func (_m *AuthenticationProvider) AuthorizedUserWithSession(ctx context.Context, sessionID string) (sessions.User, error) {
 	ret := _m.Called(ctx, sessionID)
@@ -342,6 +400,63 @@ func (_c *AuthenticationProvider_DeleteAuthToken_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DeleteSessionsForUser provides a mock function with given fields: ctx, email
+func (_m *AuthenticationProvider) DeleteSessionsForUser(ctx context.Context, email string) (int64, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSessionsForUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_DeleteSessionsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSessionsForUser'
+type AuthenticationProvider_DeleteSessionsForUser_Call struct {
+	*mock.Call
+}
+
+// DeleteSessionsForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthenticationProvider_Expecter) DeleteSessionsForUser(ctx interface{}, email interface{}) *AuthenticationProvider_DeleteSessionsForUser_Call {
+	return &AuthenticationProvider_DeleteSessionsForUser_Call{Call: _e.mock.On("DeleteSessionsForUser", ctx, email)}
+}
+
+func (_c *AuthenticationProvider_DeleteSessionsForUser_Call) Run(run func(ctx context.Context, email string)) *AuthenticationProvider_DeleteSessionsForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_DeleteSessionsForUser_Call) Return(_a0 int64, _a1 error) *AuthenticationProvider_DeleteSessionsForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_DeleteSessionsForUser_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *AuthenticationProvider_DeleteSessionsForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUser provides a mock function with given fields: ctx, email
 func (_m *AuthenticationProvider) DeleteUser(ctx context.Context, email string) error {
 	ret := _m.Called(ctx, email)
@@ -1023,6 +1138,53 @@ func (_c *AuthenticationProvider_TestPassword_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// UnlockUser provides a mock function with given fields: ctx, email
+func (_m *AuthenticationProvider) UnlockUser(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_UnlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUser'
+type AuthenticationProvider_UnlockUser_Call struct {
+	*mock.Call
+}
+
+// UnlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthenticationProvider_Expecter) UnlockUser(ctx interface{}, email interface{}) *AuthenticationProvider_UnlockUser_Call {
+	return &AuthenticationProvider_UnlockUser_Call{Call: _e.mock.On("UnlockUser", ctx, email)}
+}
+
+func (_c *AuthenticationProvider_UnlockUser_Call) Run(run func(ctx context.Context, email string)) *AuthenticationProvider_UnlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_UnlockUser_Call) Return(_a0 error) *AuthenticationProvider_UnlockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_UnlockUser_Call) RunAndReturn(run func(context.Context, string) error) *AuthenticationProvider_UnlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRole provides a mock function with given fields: ctx, email, newRole
 func (_m *AuthenticationProvider) UpdateRole(ctx context.Context, email string, newRole string) (sessions.User, error) {
 	ret := _m.Called(ctx, email, newRole)
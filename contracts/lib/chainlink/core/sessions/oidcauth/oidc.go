@@ -248,10 +248,12 @@ func (oi *oidcAuthenticator) handleTokenExchange(c *gin.Context) {
 	clSession := clsessions.NewSession()
 	_, err = oi.ds.ExecContext(
 		ctx,
-		"INSERT INTO oidc_sessions (id, user_email, user_role, created_at) VALUES ($1, $2, $3, now())",
+		"INSERT INTO oidc_sessions (id, user_email, user_role, ip_address, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, $5, now(), now())",
 		clSession.ID,
 		strings.ToLower(email),
 		role,
+		c.ClientIP(),
+		c.Request.UserAgent(),
 	)
 	if err != nil {
 		oi.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
@@ -360,7 +362,7 @@ func (oi *oidcAuthenticator) AuthorizedUserWithSession(ctx context.Context, sess
 			Valid     bool
 		}
 		if err := tx.GetContext(ctx, &foundSession,
-			"SELECT user_email, user_role, created_at + $2 >= now() as valid FROM oidc_sessions WHERE id = $1",
+			"UPDATE oidc_sessions SET last_used = now() WHERE id = $1 RETURNING user_email, user_role, created_at + $2 >= now() as valid",
 			sessionID, oi.config.SessionTimeout().Duration(),
 		); err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -422,10 +424,12 @@ func (oi *oidcAuthenticator) CreateSession(ctx context.Context, sr clsessions.Se
 	// Sessions are set to expire after the duration + creation date elapsed
 	session := clsessions.NewSession()
 	_, err = oi.ds.ExecContext(ctx,
-		"INSERT INTO oidc_sessions (id, user_email, user_role, created_at) VALUES ($1, $2, $3, now())",
+		"INSERT INTO oidc_sessions (id, user_email, user_role, ip_address, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, $5, now(), now())",
 		session.ID,
 		strings.ToLower(sr.Email),
 		foundUser.Role,
+		sr.IPAddress,
+		sr.UserAgent,
 	)
 	if err != nil {
 		oi.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
@@ -556,13 +560,38 @@ func (oi *oidcAuthenticator) SaveWebAuthn(ctx context.Context, token *clsessions
 // Sessions returns all sessions limited by the parameters.
 func (oi *oidcAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]clsessions.Session, error) {
 	var sessions []clsessions.Session
-	sql := `SELECT * FROM oidc_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
+	sql := `SELECT ` + sessionColumns + ` FROM oidc_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
 	if err := oi.ds.SelectContext(ctx, &sessions, sql, limit, offset); err != nil {
 		return sessions, nil
 	}
 	return sessions, nil
 }
 
+// sessionColumns maps the oidc_sessions columns to clsessions.Session
+const sessionColumns = "id, user_email AS email, ip_address, user_agent, last_used, created_at"
+
+// ActiveSessions returns the unexpired oidc_sessions, most recently used first
+func (oi *oidcAuthenticator) ActiveSessions(ctx context.Context) ([]clsessions.Session, error) {
+	var sessions []clsessions.Session
+	sql := `SELECT ` + sessionColumns + ` FROM oidc_sessions WHERE created_at + $1 >= now() ORDER BY last_used DESC, id;`
+	err := oi.ds.SelectContext(ctx, &sessions, sql, oi.config.SessionTimeout().Duration())
+	return sessions, err
+}
+
+// DeleteSessionsForUser removes all the oidc_sessions of the user
+func (oi *oidcAuthenticator) DeleteSessionsForUser(ctx context.Context, email string) (int64, error) {
+	res, err := oi.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE user_email = lower($1)", email)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// UnlockUser is not supported, failed logins are handled by the upstream OIDC provider
+func (oi *oidcAuthenticator) UnlockUser(ctx context.Context, email string) error {
+	return clsessions.ErrNotSupported
+}
+
 // FindExternalInitiator supports the 'Run' role external intiator header auth functionality
 func (oi *oidcAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	exi := &bridges.ExternalInitiator{}
@@ -0,0 +1,136 @@
+package rbac
+
+import (
+	"context"
+	"database/sql"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ORM stores custom roles and their assignment to users. Users are referenced by email, so
+// that roles can be assigned to users of any authentication provider.
+type ORM interface {
+	ListRoles(ctx context.Context) ([]Role, error)
+	FindRole(ctx context.Context, name string) (Role, error)
+	CreateRole(ctx context.Context, role *Role) error
+	UpdateRole(ctx context.Context, role *Role) error
+	DeleteRole(ctx context.Context, name string) error
+
+	AssignRole(ctx context.Context, email string, name string) error
+	UnassignRole(ctx context.Context, email string, name string) error
+	FindUserRoles(ctx context.Context, email string) ([]Role, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// ListRoles returns all the custom roles, sorted by name.
+func (o *orm) ListRoles(ctx context.Context) (roles []Role, err error) {
+	err = o.ds.SelectContext(ctx, &roles, "SELECT * FROM custom_roles ORDER BY name")
+	return
+}
+
+// FindRole looks up a custom role by name.
+// Returns sql.ErrNoRows if name not present
+func (o *orm) FindRole(ctx context.Context, name string) (role Role, err error) {
+	err = o.ds.GetContext(ctx, &role, "SELECT * FROM custom_roles WHERE name = $1", name)
+	return
+}
+
+// CreateRole validates and inserts a new custom role.
+func (o *orm) CreateRole(ctx context.Context, role *Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+	query, args, err := o.ds.BindNamed(`INSERT INTO custom_roles (name, description, grants, created_at, updated_at)
+		VALUES (:name, :description, :grants, NOW(), NOW())
+		RETURNING *;`, role)
+	if err != nil {
+		return pkgerrors.Wrap(err, "error binding arg")
+	}
+	return o.ds.GetContext(ctx, role, query, args...)
+}
+
+// UpdateRole validates and updates the description and grants of an existing custom role.
+// Returns sql.ErrNoRows if the role does not exist
+func (o *orm) UpdateRole(ctx context.Context, role *Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+	query, args, err := o.ds.BindNamed(`UPDATE custom_roles SET description = :description, grants = :grants, updated_at = NOW()
+		WHERE name = :name
+		RETURNING *;`, role)
+	if err != nil {
+		return pkgerrors.Wrap(err, "error binding arg")
+	}
+	return o.ds.GetContext(ctx, role, query, args...)
+}
+
+// DeleteRole deletes a custom role, unassigning it from all its users.
+// Returns sql.ErrNoRows if the role does not exist
+func (o *orm) DeleteRole(ctx context.Context, name string) error {
+	res, err := o.ds.ExecContext(ctx, "DELETE FROM custom_roles WHERE name = $1", name)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res)
+}
+
+// AssignRole assigns a custom role to the user with the given email. Assigning a role twice is
+// not an error.
+// Returns sql.ErrNoRows if the role does not exist
+func (o *orm) AssignRole(ctx context.Context, email string, name string) error {
+	res, err := o.ds.ExecContext(ctx, `INSERT INTO user_custom_roles (user_email, custom_role_id, created_at)
+		SELECT lower($1), id, NOW() FROM custom_roles WHERE name = $2
+		ON CONFLICT DO NOTHING`, email, name)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+	// Nothing inserted: the role is either already assigned or missing.
+	_, err = o.FindRole(ctx, name)
+	return err
+}
+
+// UnassignRole removes a custom role from the user with the given email.
+// Returns sql.ErrNoRows if the role was not assigned to the user
+func (o *orm) UnassignRole(ctx context.Context, email string, name string) error {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM user_custom_roles
+		WHERE user_email = lower($1) AND custom_role_id = (SELECT id FROM custom_roles WHERE name = $2)`, email, name)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res)
+}
+
+// FindUserRoles returns the custom roles assigned to the user with the given email, sorted by
+// name.
+func (o *orm) FindUserRoles(ctx context.Context, email string) (roles []Role, err error) {
+	err = o.ds.SelectContext(ctx, &roles, `SELECT custom_roles.* FROM custom_roles
+		JOIN user_custom_roles ON user_custom_roles.custom_role_id = custom_roles.id
+		WHERE user_custom_roles.user_email = lower($1)
+		ORDER BY custom_roles.name`, email)
+	return
+}
+
+func checkRowsAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package rbac_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestORM_Roles(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := rbac.NewORM(pgtest.NewSqlxDB(t))
+
+	role := rbac.Role{
+		Name:        "staging-operator",
+		Description: "Runs the staging jobs",
+		Grants:      rbac.Grants{{Permission: rbac.JobsRun, JobTags: []string{"staging"}}},
+	}
+	require.NoError(t, orm.CreateRole(ctx, &role))
+	assert.NotZero(t, role.ID)
+	assert.False(t, role.CreatedAt.IsZero())
+
+	err := orm.CreateRole(ctx, &rbac.Role{Name: "admin", Grants: role.Grants})
+	require.ErrorContains(t, err, "reserved")
+
+	found, err := orm.FindRole(ctx, "staging-operator")
+	require.NoError(t, err)
+	assert.Equal(t, role.Grants, found.Grants)
+	assert.Equal(t, "Runs the staging jobs", found.Description)
+
+	role.Grants = append(role.Grants, rbac.Grant{Permission: rbac.BridgesUpdate})
+	require.NoError(t, orm.UpdateRole(ctx, &role))
+	assert.Len(t, role.Grants, 2)
+
+	err = orm.UpdateRole(ctx, &rbac.Role{Name: "missing", Grants: role.Grants})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	roles, err := orm.ListRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Len(t, roles[0].Grants, 2)
+
+	require.NoError(t, orm.DeleteRole(ctx, "staging-operator"))
+	require.ErrorIs(t, orm.DeleteRole(ctx, "staging-operator"), sql.ErrNoRows)
+	_, err = orm.FindRole(ctx, "staging-operator")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_AssignRole(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := rbac.NewORM(pgtest.NewSqlxDB(t))
+
+	for _, name := range []string{"runner", "bridges"} {
+		require.NoError(t, orm.CreateRole(ctx, &rbac.Role{Name: name, Grants: rbac.Grants{{Permission: rbac.JobsRun}}}))
+	}
+
+	require.NoError(t, orm.AssignRole(ctx, "Operator@chain.link", "runner"))
+	require.NoError(t, orm.AssignRole(ctx, "operator@chain.link", "runner"))
+	require.NoError(t, orm.AssignRole(ctx, "operator@chain.link", "bridges"))
+	require.ErrorIs(t, orm.AssignRole(ctx, "operator@chain.link", "missing"), sql.ErrNoRows)
+
+	roles, err := orm.FindUserRoles(ctx, "OPERATOR@chain.link")
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, "bridges", roles[0].Name)
+	assert.Equal(t, "runner", roles[1].Name)
+
+	require.NoError(t, orm.UnassignRole(ctx, "operator@chain.link", "runner"))
+	require.ErrorIs(t, orm.UnassignRole(ctx, "operator@chain.link", "runner"), sql.ErrNoRows)
+
+	// Deleting a role unassigns it
+	require.NoError(t, orm.DeleteRole(ctx, "bridges"))
+	roles, err = orm.FindUserRoles(ctx, "operator@chain.link")
+	require.NoError(t, err)
+	assert.Empty(t, roles)
+}
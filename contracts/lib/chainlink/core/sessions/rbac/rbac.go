@@ -0,0 +1,278 @@
+package rbac
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// Permission is a named action a user may be allowed to perform, in addition to what their
+// base role allows.
+type Permission string
+
+const (
+	JobsRead   Permission = "jobs:read"
+	JobsCreate Permission = "jobs:create"
+	JobsUpdate Permission = "jobs:update"
+	JobsDelete Permission = "jobs:delete"
+	JobsRun    Permission = "jobs:run"
+
+	BridgesCreate Permission = "bridges:create"
+	BridgesUpdate Permission = "bridges:update"
+	BridgesDelete Permission = "bridges:delete"
+
+	ExternalInitiatorsCreate Permission = "external_initiators:create"
+	ExternalInitiatorsDelete Permission = "external_initiators:delete"
+
+	KeysCreate Permission = "keys:create"
+	KeysDelete Permission = "keys:delete"
+	KeysImport Permission = "keys:import"
+	KeysExport Permission = "keys:export"
+)
+
+// permissionRoles maps every permission to the least privileged base role granting it.
+var permissionRoles = map[Permission]sessions.UserRole{
+	JobsRead:                 sessions.UserRoleView,
+	JobsCreate:               sessions.UserRoleEdit,
+	JobsUpdate:               sessions.UserRoleEdit,
+	JobsDelete:               sessions.UserRoleEdit,
+	JobsRun:                  sessions.UserRoleRun,
+	BridgesCreate:            sessions.UserRoleEdit,
+	BridgesUpdate:            sessions.UserRoleEdit,
+	BridgesDelete:            sessions.UserRoleEdit,
+	ExternalInitiatorsCreate: sessions.UserRoleEdit,
+	ExternalInitiatorsDelete: sessions.UserRoleEdit,
+	KeysCreate:               sessions.UserRoleEdit,
+	KeysDelete:               sessions.UserRoleAdmin,
+	KeysImport:               sessions.UserRoleAdmin,
+	KeysExport:               sessions.UserRoleAdmin,
+}
+
+var roleRanks = map[sessions.UserRole]int{
+	sessions.UserRoleView:  0,
+	sessions.UserRoleRun:   1,
+	sessions.UserRoleEdit:  2,
+	sessions.UserRoleAdmin: 3,
+}
+
+// Permissions returns all the known permissions, sorted by name.
+func Permissions() []Permission {
+	var ps []Permission
+	for p := range permissionRoles {
+		ps = append(ps, p)
+	}
+	slices.Sort(ps)
+	return ps
+}
+
+// ParsePermission returns the permission called name.
+func ParsePermission(name string) (Permission, error) {
+	p := Permission(name)
+	if _, ok := permissionRoles[p]; !ok {
+		return "", pkgerrors.Errorf("unknown permission %q", name)
+	}
+	return p, nil
+}
+
+// Role returns the least privileged base role granting p.
+func (p Permission) Role() sessions.UserRole {
+	return permissionRoles[p]
+}
+
+// JobScoped reports whether grants of p may be restricted to some jobs.
+func (p Permission) JobScoped() bool {
+	return strings.HasPrefix(string(p), "jobs:")
+}
+
+// RoleAllows reports whether the base role grants p.
+func RoleAllows(role sessions.UserRole, p Permission) bool {
+	required, ok := permissionRoles[p]
+	if !ok {
+		return false
+	}
+	return roleRanks[role] >= roleRanks[required]
+}
+
+// Grant is a permission given by a custom role or an API token, optionally restricted to some
+// jobs.
+type Grant struct {
+	Permission Permission `json:"permission"`
+	// JobIDs restricts the grant to these jobs.
+	JobIDs []int32 `json:"jobIDs,omitempty"`
+	// JobTypes restricts the grant to jobs of these types.
+	JobTypes []string `json:"jobTypes,omitempty"`
+	// JobTags restricts the grant to jobs with at least one of these tags.
+	JobTags []string `json:"jobTags,omitempty"`
+}
+
+// Job is what job scoped grants are matched against.
+type Job struct {
+	// ID is zero for jobs being created.
+	ID   int32
+	Type string
+	Tags []string
+}
+
+// Scoped reports whether the grant is restricted to some jobs.
+func (g Grant) Scoped() bool {
+	return len(g.JobIDs) > 0 || len(g.JobTypes) > 0 || len(g.JobTags) > 0
+}
+
+// MatchesJob reports whether the grant applies to the job. When several restrictions are set,
+// the job must match all of them.
+func (g Grant) MatchesJob(job Job) bool {
+	if len(g.JobIDs) > 0 && !slices.Contains(g.JobIDs, job.ID) {
+		return false
+	}
+	if len(g.JobTypes) > 0 && !slices.Contains(g.JobTypes, job.Type) {
+		return false
+	}
+	if len(g.JobTags) > 0 && !slices.ContainsFunc(g.JobTags, func(tag string) bool {
+		return slices.Contains(job.Tags, tag)
+	}) {
+		return false
+	}
+	return true
+}
+
+// Validate checks the permission is known and only job permissions are scoped.
+func (g Grant) Validate() error {
+	if _, err := ParsePermission(string(g.Permission)); err != nil {
+		return err
+	}
+	if g.Scoped() && !g.Permission.JobScoped() {
+		return pkgerrors.Errorf("permission %s cannot be restricted to some jobs", g.Permission)
+	}
+	return nil
+}
+
+// Grants is a list of Grant, encoded as JSON in the database.
+type Grants []Grant
+
+// Value returns this instance serialized for database storage.
+func (g Grants) Value() (driver.Value, error) {
+	if g == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(g)
+}
+
+// Scan reads the database value and returns an instance.
+func (g *Grants) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return pkgerrors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, g)
+}
+
+// Role is a custom role, made of permissions given to the users it is assigned to on top of
+// their base role.
+type Role struct {
+	ID          int64
+	Name        string
+	Description string
+	Grants      Grants
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Validate checks the name and grants of the role.
+func (r Role) Validate() error {
+	if r.Name == "" {
+		return pkgerrors.New("role name is required")
+	}
+	if _, err := sessions.GetUserRole(r.Name); err == nil {
+		return pkgerrors.Errorf("role name %q is reserved", r.Name)
+	}
+	if len(r.Grants) == 0 {
+		return pkgerrors.New("role must grant at least one permission")
+	}
+	for i, g := range r.Grants {
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("grant %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Authorization holds everything a user is allowed to do: what their base role allows, plus the
+// grants of their custom roles, possibly limited to the grants of the API token they used.
+type Authorization struct {
+	Role   sessions.UserRole
+	Grants []Grant
+	// Limited is set when only the permissions in Limits may be used.
+	Limited bool
+	Limits  []Grant
+}
+
+// NewAuthorization returns the Authorization of a user with the given base role and custom roles.
+func NewAuthorization(role sessions.UserRole, roles []Role) Authorization {
+	a := Authorization{Role: role}
+	for _, r := range roles {
+		a.Grants = append(a.Grants, r.Grants...)
+	}
+	return a
+}
+
+// Limit returns a copy of the authorization only allowing what is also granted by limits.
+func (a Authorization) Limit(limits []Grant) Authorization {
+	a.Limited = true
+	a.Limits = limits
+	return a
+}
+
+// Allows reports whether p is granted, possibly for some jobs only. Handlers of job scoped
+// permissions must then check the job with AllowsJob.
+func (a Authorization) Allows(p Permission) bool {
+	if a.Limited && !slices.ContainsFunc(a.Limits, func(g Grant) bool { return g.Permission == p }) {
+		return false
+	}
+	if RoleAllows(a.Role, p) {
+		return true
+	}
+	return slices.ContainsFunc(a.Grants, func(g Grant) bool { return g.Permission == p })
+}
+
+// JobScopes returns the grants of p which a job must match for p to be granted for it: at least one grant of each of
+// the returned sets. It returns no sets when p is granted for all jobs, and an empty set when it is granted for none.
+func (a Authorization) JobScopes(p Permission) [][]Grant {
+	withPermission := func(grants []Grant) []Grant {
+		matching := []Grant{}
+		for _, g := range grants {
+			if g.Permission == p {
+				matching = append(matching, g)
+			}
+		}
+		return matching
+	}
+	var scopes [][]Grant
+	if a.Limited {
+		scopes = append(scopes, withPermission(a.Limits))
+	}
+	if !RoleAllows(a.Role, p) {
+		scopes = append(scopes, withPermission(a.Grants))
+	}
+	return scopes
+}
+
+// AllowsJob reports whether p is granted for the job.
+func (a Authorization) AllowsJob(p Permission, job Job) bool {
+	matches := func(g Grant) bool {
+		return g.Permission == p && g.MatchesJob(job)
+	}
+	if a.Limited && !slices.ContainsFunc(a.Limits, matches) {
+		return false
+	}
+	if RoleAllows(a.Role, p) {
+		return true
+	}
+	return slices.ContainsFunc(a.Grants, matches)
+}
@@ -0,0 +1,138 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestRoleAllows(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, rbac.RoleAllows(sessions.UserRoleView, rbac.JobsRead))
+	assert.True(t, rbac.RoleAllows(sessions.UserRoleRun, rbac.JobsRun))
+	assert.False(t, rbac.RoleAllows(sessions.UserRoleView, rbac.JobsRun))
+	assert.False(t, rbac.RoleAllows(sessions.UserRoleRun, rbac.JobsCreate))
+	assert.True(t, rbac.RoleAllows(sessions.UserRoleEdit, rbac.KeysCreate))
+	assert.False(t, rbac.RoleAllows(sessions.UserRoleEdit, rbac.KeysExport))
+	assert.True(t, rbac.RoleAllows(sessions.UserRoleAdmin, rbac.KeysExport))
+	assert.False(t, rbac.RoleAllows(sessions.UserRoleAdmin, rbac.Permission("jobs:explode")))
+}
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	p, err := rbac.ParsePermission("bridges:update")
+	require.NoError(t, err)
+	assert.Equal(t, rbac.BridgesUpdate, p)
+
+	_, err = rbac.ParsePermission("bridges:explode")
+	require.ErrorContains(t, err, `unknown permission "bridges:explode"`)
+
+	assert.Len(t, rbac.Permissions(), 14)
+}
+
+func TestGrant_MatchesJob(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		grant rbac.Grant
+		job   rbac.Job
+		want  bool
+	}{
+		{"unscoped", rbac.Grant{}, rbac.Job{Type: "cron"}, true},
+		{"id matches", rbac.Grant{JobIDs: []int32{1, 2}}, rbac.Job{ID: 2, Type: "cron"}, true},
+		{"id does not match", rbac.Grant{JobIDs: []int32{1}}, rbac.Job{ID: 2, Type: "cron"}, false},
+		{"new job", rbac.Grant{JobIDs: []int32{1}}, rbac.Job{Type: "cron"}, false},
+		{"type matches", rbac.Grant{JobTypes: []string{"cron", "webhook"}}, rbac.Job{Type: "webhook"}, true},
+		{"type does not match", rbac.Grant{JobTypes: []string{"cron"}}, rbac.Job{Type: "webhook"}, false},
+		{"tag matches", rbac.Grant{JobTags: []string{"staging"}}, rbac.Job{Type: "cron", Tags: []string{"eth", "staging"}}, true},
+		{"tag does not match", rbac.Grant{JobTags: []string{"staging"}}, rbac.Job{Type: "cron", Tags: []string{"production"}}, false},
+		{"untagged job", rbac.Grant{JobTags: []string{"staging"}}, rbac.Job{Type: "cron"}, false},
+		{"type and tag match", rbac.Grant{JobTypes: []string{"cron"}, JobTags: []string{"staging"}}, rbac.Job{Type: "cron", Tags: []string{"staging"}}, true},
+		{"only tag matches", rbac.Grant{JobTypes: []string{"cron"}, JobTags: []string{"staging"}}, rbac.Job{Type: "webhook", Tags: []string{"staging"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.grant.MatchesJob(tt.job))
+		})
+	}
+}
+
+func TestRole_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := rbac.Role{Name: "operator", Grants: rbac.Grants{
+		{Permission: rbac.JobsRun, JobTags: []string{"staging"}},
+		{Permission: rbac.BridgesUpdate},
+	}}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name    string
+		role    rbac.Role
+		wantErr string
+	}{
+		{"no name", rbac.Role{Grants: valid.Grants}, "role name is required"},
+		{"reserved name", rbac.Role{Name: "edit", Grants: valid.Grants}, `role name "edit" is reserved`},
+		{"no grants", rbac.Role{Name: "operator"}, "at least one permission"},
+		{"unknown permission", rbac.Role{Name: "operator", Grants: rbac.Grants{{Permission: "jobs:explode"}}}, "grant 0: unknown permission"},
+		{"scoped non job permission", rbac.Role{Name: "operator", Grants: rbac.Grants{{Permission: rbac.BridgesCreate, JobTypes: []string{"cron"}}}}, "cannot be restricted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorContains(t, tt.role.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	t.Parallel()
+
+	authz := rbac.NewAuthorization(sessions.UserRoleView, []rbac.Role{
+		{Name: "runner", Grants: rbac.Grants{{Permission: rbac.JobsRun, JobTypes: []string{"webhook"}}}},
+		{Name: "bridges", Grants: rbac.Grants{{Permission: rbac.BridgesCreate}}},
+	})
+
+	assert.True(t, authz.Allows(rbac.BridgesCreate))
+	assert.True(t, authz.Allows(rbac.JobsRun))
+	assert.False(t, authz.Allows(rbac.JobsCreate))
+
+	assert.True(t, authz.AllowsJob(rbac.JobsRun, rbac.Job{Type: "webhook"}))
+	assert.False(t, authz.AllowsJob(rbac.JobsRun, rbac.Job{Type: "cron"}))
+	assert.False(t, authz.AllowsJob(rbac.JobsDelete, rbac.Job{Type: "webhook"}))
+
+	admin := rbac.NewAuthorization(sessions.UserRoleAdmin, nil)
+	assert.True(t, admin.AllowsJob(rbac.JobsDelete, rbac.Job{Type: "cron"}))
+
+	limited := admin.Limit([]rbac.Grant{{Permission: rbac.JobsRun, JobIDs: []int32{7}}})
+	assert.True(t, limited.Allows(rbac.JobsRun))
+	assert.False(t, limited.Allows(rbac.JobsDelete))
+	assert.True(t, limited.AllowsJob(rbac.JobsRun, rbac.Job{ID: 7, Type: "webhook"}))
+	assert.False(t, limited.AllowsJob(rbac.JobsRun, rbac.Job{ID: 8, Type: "webhook"}))
+
+	// Limits never grant more than the user has
+	viewer := rbac.NewAuthorization(sessions.UserRoleView, nil).Limit([]rbac.Grant{{Permission: rbac.JobsRun}})
+	assert.False(t, viewer.Allows(rbac.JobsRun))
+}
+
+func TestAuthorization_JobScopes(t *testing.T) {
+	t.Parallel()
+
+	webhooks := rbac.Grant{Permission: rbac.JobsRun, JobTypes: []string{"webhook"}}
+	authz := rbac.NewAuthorization(sessions.UserRoleView, []rbac.Role{
+		{Name: "runner", Grants: rbac.Grants{webhooks, {Permission: rbac.BridgesCreate}}},
+	})
+	assert.Equal(t, [][]rbac.Grant{{webhooks}}, authz.JobScopes(rbac.JobsRun))
+	assert.Equal(t, [][]rbac.Grant{{}}, authz.JobScopes(rbac.JobsDelete))
+	assert.Empty(t, authz.JobScopes(rbac.JobsRead))
+
+	job7 := rbac.Grant{Permission: rbac.JobsRun, JobIDs: []int32{7}}
+	assert.Equal(t, [][]rbac.Grant{{job7}, {webhooks}}, authz.Limit([]rbac.Grant{job7}).JobScopes(rbac.JobsRun))
+	assert.Equal(t, [][]rbac.Grant{{job7}}, rbac.NewAuthorization(sessions.UserRoleAdmin, nil).Limit([]rbac.Grant{job7}).JobScopes(rbac.JobsRun))
+}
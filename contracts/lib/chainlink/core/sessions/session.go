@@ -1,7 +1,9 @@
 package sessions
 
 import (
+	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/hex"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
@@ -19,16 +21,38 @@ type SessionRequest struct {
 	WebAuthnData   string `json:"webauthndata"`
 	WebAuthnConfig WebAuthnConfiguration
 	SessionStore   *WebAuthnSessionStore
+	// IPAddress and UserAgent identify the client of the session, they are set by the server.
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 // Session holds the unique id for the authenticated session.
 type Session struct {
 	ID        string    `json:"id"`
 	Email     string    `json:"email"`
+	IPAddress string    `json:"ipAddress" db:"ip_address"`
+	UserAgent string    `json:"userAgent" db:"user_agent"`
 	LastUsed  time.Time `json:"lastUsed"`
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// PublicID identifies the session to administrators. Unlike the ID, which authenticates the
+// requests of the session, it cannot be used to take over the session.
+func (s Session) PublicID() string {
+	sum := sha256.Sum256([]byte(s.ID))
+	return hex.EncodeToString(sum[:8])
+}
+
+// FindSessionByPublicID returns the session with the given PublicID, or ErrSessionNotFound.
+func FindSessionByPublicID(sessions []Session, publicID string) (Session, error) {
+	for _, s := range sessions {
+		if subtle.ConstantTimeCompare([]byte(s.PublicID()), []byte(publicID)) == 1 {
+			return s, nil
+		}
+	}
+	return Session{}, ErrSessionNotFound
+}
+
 // NewSession returns a session instance with ID set to a random ID and
 // LastUsed to now.
 func NewSession() Session {
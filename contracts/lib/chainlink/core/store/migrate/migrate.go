@@ -165,6 +165,28 @@ func Current(ctx context.Context, db *sql.DB) (int64, error) {
 	return provider.GetDBVersion(ctx)
 }
 
+// Latest returns the version of the most recent migration embedded in this binary.
+func Latest() (int64, error) {
+	entries, err := fs.ReadDir(embedMigrations, MIGRATIONS_DIR)
+	if err != nil {
+		return -1, fmt.Errorf("failed to read embedded migration dir: %w", err)
+	}
+	var latest int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasSuffix(name, "_test.go") || (!strings.HasSuffix(name, ".sql") && !strings.HasSuffix(name, ".go")) {
+			continue
+		}
+		version, err := goose.NumericComponent(name)
+		if err != nil {
+			// not a migration
+			continue
+		}
+		latest = max(latest, version)
+	}
+	return latest, nil
+}
+
 func Status(ctx context.Context, db *sql.DB) error {
 	provider, err := NewProvider(ctx, db)
 	if err != nil {
@@ -413,6 +413,12 @@ func TestMigrate(t *testing.T) {
 	err = migrate.Migrate(ctx, db.DB)
 	require.NoError(t, err)
 
+	latest, err := migrate.Latest()
+	require.NoError(t, err)
+	ver, err = migrate.Current(ctx, db.DB)
+	require.NoError(t, err)
+	require.Equal(t, latest, ver)
+
 	err = migrate.Rollback(ctx, db.DB, null.IntFrom(99))
 	require.NoError(t, err)
 
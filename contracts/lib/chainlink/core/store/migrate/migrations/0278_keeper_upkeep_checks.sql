@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE keeper_upkeep_checks (
+    upkeep_registration_id bigint PRIMARY KEY REFERENCES upkeep_registrations(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    block_number bigint NOT NULL,
+    performable boolean NOT NULL,
+    perform_data bytea,
+    gas_limit bigint,
+    error text,
+    checked_at timestamp with time zone NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS keeper_upkeep_checks;
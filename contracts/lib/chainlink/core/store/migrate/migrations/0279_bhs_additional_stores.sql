@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE blockhash_store_specs ADD COLUMN additional_stores jsonb;
+ALTER TABLE block_header_feeder_specs ADD COLUMN additional_stores jsonb;
+
+-- +goose Down
+ALTER TABLE blockhash_store_specs DROP COLUMN additional_stores;
+ALTER TABLE block_header_feeder_specs DROP COLUMN additional_stores;
@@ -0,0 +1,74 @@
+-- +goose Up
+CREATE TABLE evm_log_specs (
+  id BIGSERIAL PRIMARY KEY,
+  contract_address bytea NOT NULL,
+  event_abi TEXT NOT NULL,
+  topic_filters jsonb,
+  min_confirmations bigint,
+  lookback_blocks integer NOT NULL,
+  poll_period bigint NOT NULL,
+  evm_chain_id numeric(78) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  CONSTRAINT evm_log_specs_contract_address_check CHECK ((octet_length(contract_address) = 20))
+);
+ALTER TABLE
+  jobs
+ADD
+  COLUMN evm_log_spec_id INT REFERENCES evm_log_specs (id),
+DROP
+  CONSTRAINT chk_specs,
+ADD
+  CONSTRAINT chk_specs CHECK (
+    num_nonnulls(
+      ocr_oracle_spec_id, ocr2_oracle_spec_id,
+      direct_request_spec_id, flux_monitor_spec_id,
+      keeper_spec_id, cron_spec_id, webhook_spec_id,
+      vrf_spec_id, blockhash_store_spec_id,
+      block_header_feeder_spec_id, bootstrap_spec_id,
+      gateway_spec_id,
+      legacy_gas_station_server_spec_id,
+      legacy_gas_station_sidecar_spec_id,
+      eal_spec_id,
+      workflow_spec_id,
+      standard_capabilities_spec_id,
+      ccip_spec_id,
+      ccip_bootstrap_spec_id,
+      bal_spec_id,
+      evm_log_spec_id,
+      CASE "type" WHEN 'stream' THEN 1 ELSE NULL END -- 'stream' type lacks a spec but should not cause validation to fail
+    ) = 1
+  );
+CREATE UNIQUE INDEX idx_jobs_unique_evm_log_spec_id ON jobs USING btree (evm_log_spec_id);
+
+-- +goose Down
+ALTER TABLE
+  jobs
+DROP
+  CONSTRAINT chk_specs,
+ADD
+  CONSTRAINT chk_specs CHECK (
+    num_nonnulls(
+      ocr_oracle_spec_id, ocr2_oracle_spec_id,
+      direct_request_spec_id, flux_monitor_spec_id,
+      keeper_spec_id, cron_spec_id, webhook_spec_id,
+      vrf_spec_id, blockhash_store_spec_id,
+      block_header_feeder_spec_id, bootstrap_spec_id,
+      gateway_spec_id,
+      legacy_gas_station_server_spec_id,
+      legacy_gas_station_sidecar_spec_id,
+      eal_spec_id,
+      workflow_spec_id,
+      standard_capabilities_spec_id,
+      ccip_spec_id,
+      ccip_bootstrap_spec_id,
+      bal_spec_id,
+      CASE "type" WHEN 'stream' THEN 1 ELSE NULL END -- 'stream' type lacks a spec but should not cause validation to fail
+    ) = 1
+  );
+ALTER TABLE
+  jobs
+DROP
+  COLUMN evm_log_spec_id;
+DROP
+  TABLE IF EXISTS evm_log_specs;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE custom_roles (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT '',
+  grants jsonb NOT NULL DEFAULT '[]',
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL
+);
+-- user_email is not a foreign key, so that roles can be assigned to users of any authentication provider
+CREATE TABLE user_custom_roles (
+  user_email TEXT NOT NULL,
+  custom_role_id BIGINT NOT NULL REFERENCES custom_roles (id) ON DELETE CASCADE,
+  created_at timestamp with time zone NOT NULL,
+  PRIMARY KEY (user_email, custom_role_id)
+);
+ALTER TABLE jobs ADD COLUMN tags TEXT[];
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN tags;
+DROP TABLE IF EXISTS user_custom_roles;
+DROP TABLE IF EXISTS custom_roles;
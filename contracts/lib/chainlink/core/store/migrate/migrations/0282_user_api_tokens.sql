@@ -0,0 +1,18 @@
+-- +goose Up
+-- user_email is not a foreign key, so that tokens can be created by users of any authentication provider
+CREATE TABLE user_api_tokens (
+  id BIGSERIAL PRIMARY KEY,
+  user_email TEXT NOT NULL,
+  name TEXT NOT NULL,
+  token_key TEXT NOT NULL UNIQUE,
+  token_salt TEXT NOT NULL,
+  token_hashed_secret TEXT NOT NULL,
+  grants jsonb NOT NULL DEFAULT '[]',
+  expires_at timestamp with time zone,
+  last_used_at timestamp with time zone,
+  created_at timestamp with time zone NOT NULL,
+  UNIQUE (user_email, name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_api_tokens;
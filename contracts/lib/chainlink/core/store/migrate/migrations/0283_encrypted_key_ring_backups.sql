@@ -0,0 +1,11 @@
+-- +goose Up
+-- Key rings encrypted with a previous keystore password, saved when it is rotated and purged once expired
+CREATE TABLE encrypted_key_ring_backups (
+  id BIGSERIAL PRIMARY KEY,
+  encrypted_keys jsonb NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  expires_at timestamp with time zone NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS encrypted_key_ring_backups;
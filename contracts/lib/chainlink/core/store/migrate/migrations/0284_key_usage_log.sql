@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE key_usage_log (
+  id BIGSERIAL PRIMARY KEY,
+  key_type TEXT NOT NULL,
+  key_id TEXT NOT NULL,
+  subsystem TEXT NOT NULL,
+  job_id INTEGER,
+  payload_hash TEXT NOT NULL,
+  created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_key_usage_log_key ON key_usage_log (key_type, key_id, created_at);
+CREATE INDEX idx_key_usage_log_created_at ON key_usage_log (created_at);
+
+-- The log is append-only: entries can neither be updated nor deleted.
+-- +goose StatementBegin
+CREATE FUNCTION key_usage_log_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'key_usage_log is append-only';
+END
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER key_usage_log_append_only BEFORE UPDATE OR DELETE OR TRUNCATE ON key_usage_log
+  FOR EACH STATEMENT EXECUTE PROCEDURE key_usage_log_append_only();
+
+-- +goose Down
+DROP TABLE IF EXISTS key_usage_log;
+DROP FUNCTION IF EXISTS key_usage_log_append_only();
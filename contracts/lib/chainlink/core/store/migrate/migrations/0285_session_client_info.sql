@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE sessions
+  ADD COLUMN ip_address TEXT NOT NULL DEFAULT '',
+  ADD COLUMN user_agent TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE ldap_sessions
+  ADD COLUMN ip_address TEXT NOT NULL DEFAULT '',
+  ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+  ADD COLUMN last_used timestamp with time zone;
+UPDATE ldap_sessions SET last_used = created_at;
+ALTER TABLE ldap_sessions ALTER COLUMN last_used SET NOT NULL, ALTER COLUMN last_used SET DEFAULT now();
+
+ALTER TABLE oidc_sessions
+  ADD COLUMN ip_address TEXT NOT NULL DEFAULT '',
+  ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+  ADD COLUMN last_used timestamp with time zone;
+UPDATE oidc_sessions SET last_used = created_at;
+ALTER TABLE oidc_sessions ALTER COLUMN last_used SET NOT NULL, ALTER COLUMN last_used SET DEFAULT now();
+
+-- +goose Down
+ALTER TABLE oidc_sessions DROP COLUMN ip_address, DROP COLUMN user_agent, DROP COLUMN last_used;
+ALTER TABLE ldap_sessions DROP COLUMN ip_address, DROP COLUMN user_agent, DROP COLUMN last_used;
+ALTER TABLE sessions DROP COLUMN ip_address, DROP COLUMN user_agent;
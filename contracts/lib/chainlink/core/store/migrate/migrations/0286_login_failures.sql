@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE login_failures (
+  kind TEXT NOT NULL CHECK (kind IN ('email', 'ip')),
+  key TEXT NOT NULL,
+  failures INTEGER NOT NULL,
+  last_failure_at timestamp with time zone NOT NULL,
+  locked_until timestamp with time zone,
+  PRIMARY KEY (kind, key)
+);
+
+-- +goose Down
+DROP TABLE login_failures;
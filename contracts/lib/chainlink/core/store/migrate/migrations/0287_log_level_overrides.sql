@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE log_level_overrides (
+  id BIGSERIAL PRIMARY KEY,
+  job_id INTEGER REFERENCES jobs (id) ON DELETE CASCADE,
+  job_type TEXT,
+  level TEXT NOT NULL,
+  expires_at timestamp with time zone,
+  created_at timestamp with time zone NOT NULL,
+  CONSTRAINT chk_log_level_overrides_target CHECK (num_nonnulls(job_id, job_type) = 1)
+);
+
+CREATE UNIQUE INDEX idx_log_level_overrides_job_id ON log_level_overrides (job_id) WHERE job_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_log_level_overrides_job_type ON log_level_overrides (job_type) WHERE job_type IS NOT NULL;
+
+-- +goose Down
+DROP TABLE log_level_overrides;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN expected_run_interval BIGINT;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN expected_run_interval;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN max_time_between_successful_runs BIGINT;
+ALTER TABLE jobs ADD COLUMN max_consecutive_errors BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN max_consecutive_errors;
+ALTER TABLE jobs DROP COLUMN max_time_between_successful_runs;
@@ -0,0 +1,7 @@
+package s3
+
+// SetPartSize sets the size of the parts of multipart uploads, which are used for the files larger
+// than it.
+func (c *Client) SetPartSize(size int64) {
+	c.partSize = size
+}
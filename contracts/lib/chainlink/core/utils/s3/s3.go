@@ -0,0 +1,323 @@
+// Package s3 is a minimal client for S3 compatible object stores.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	service          = "s3"
+	timeFormat       = "20060102T150405Z"
+	dateFormat       = "20060102"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	// defaultPartSize is the size of the parts of multipart uploads, which are used for the files
+	// larger than it. A single PUT request is limited to 5 GiB.
+	defaultPartSize = 64 << 20
+	// maxParts is the maximum number of parts of a multipart upload.
+	maxParts = 10_000
+	// maxObjectSize is the maximum size of an object.
+	maxObjectSize = 5 << 40
+
+	// Algorithm is the signing algorithm of the requests, prefixing their Authorization header.
+	Algorithm = "AWS4-HMAC-SHA256"
+)
+
+// Object is an object stored in a bucket.
+type Object struct {
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	Size         int64     `xml:"Size"`
+}
+
+// ListResult is the response body of a ListObjectsV2 request.
+type ListResult struct {
+	Contents              []Object `xml:"Contents"`
+	IsTruncated           bool     `xml:"IsTruncated"`
+	NextContinuationToken string   `xml:"NextContinuationToken"`
+}
+
+// InitiateMultipartUploadResult is the response body of a CreateMultipartUpload request.
+type InitiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+// CompletedPart is an uploaded part of a multipart upload.
+type CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// CompleteMultipartUpload is the request body of a CompleteMultipartUpload request.
+type CompleteMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []CompletedPart `xml:"Part"`
+}
+
+// Client is a minimal client for S3 compatible object stores, using path style requests
+// (<endpoint>/<bucket>/<key>) signed with AWS Signature Version 4.
+type Client struct {
+	httpClient      *http.Client
+	endpoint        url.URL
+	bucket          string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	partSize        int64
+	now             func() time.Time
+}
+
+// NewClient returns a client of bucket, served by the object store at endpoint.
+func NewClient(endpoint url.URL, bucket, region, accessKeyID, secretAccessKey string, timeout time.Duration) *Client {
+	return &Client{
+		httpClient:      &http.Client{Timeout: timeout},
+		endpoint:        endpoint,
+		bucket:          bucket,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		partSize:        defaultPartSize,
+		now:             time.Now,
+	}
+}
+
+// PutFile uploads the file at path to key. Files larger than the part size are uploaded in parts.
+func (c *Client) PutFile(ctx context.Context, key, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	if size > maxObjectSize {
+		return errors.Errorf("failed to upload %s: %d bytes exceed the maximum object size of %d bytes", key, size, int64(maxObjectSize))
+	}
+	if size > c.partSize {
+		return errors.Wrapf(c.putMultipart(ctx, key, f, size), "failed to upload %s", key)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, key, nil, f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	_, _, err = c.do(req, unsignedPayload)
+	return errors.Wrapf(err, "failed to upload %s", key)
+}
+
+// putMultipart uploads the size bytes of f to key with a multipart upload, which is aborted if any
+// part fails, so that the parts already uploaded are not kept.
+func (c *Client) putMultipart(ctx context.Context, key string, f io.ReaderAt, size int64) error {
+	req, err := c.newRequest(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return err
+	}
+	body, _, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the multipart upload")
+	}
+	var initiated InitiateMultipartUploadResult
+	if err = xml.Unmarshal(body, &initiated); err != nil || initiated.UploadID == "" {
+		return errors.Errorf("invalid multipart upload response: %s", strings.TrimSpace(string(body)))
+	}
+
+	err = c.putParts(ctx, key, initiated.UploadID, f, size)
+	if err != nil {
+		req, rerr := c.newRequest(context.WithoutCancel(ctx), http.MethodDelete, key, url.Values{"uploadId": {initiated.UploadID}}, nil)
+		if rerr == nil {
+			_, _, rerr = c.do(req, emptyPayloadHash)
+		}
+		return stderrors.Join(err, errors.Wrap(rerr, "failed to abort the multipart upload"))
+	}
+	return nil
+}
+
+// putParts uploads f in parts and completes the multipart upload uploadID.
+func (c *Client) putParts(ctx context.Context, key, uploadID string, f io.ReaderAt, size int64) error {
+	partSize := max(c.partSize, (size+maxParts-1)/maxParts)
+	var complete CompleteMultipartUpload
+	for offset := int64(0); offset < size; offset += partSize {
+		n := min(partSize, size-offset)
+		number := len(complete.Parts) + 1
+		query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {uploadID}}
+		req, err := c.newRequest(ctx, http.MethodPut, key, query, io.NewSectionReader(f, offset, n))
+		if err != nil {
+			return err
+		}
+		req.ContentLength = n
+		_, header, err := c.do(req, unsignedPayload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to upload part %d", number)
+		}
+		complete.Parts = append(complete.Parts, CompletedPart{PartNumber: number, ETag: header.Get("ETag")})
+	}
+
+	b, err := xml.Marshal(complete)
+	if err != nil {
+		return err
+	}
+	req, err := c.newRequest(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	body, _, err := c.do(req, hexSHA256(b))
+	if err != nil {
+		return errors.Wrap(err, "failed to complete the multipart upload")
+	}
+	// the completion may fail after the 200 OK status is sent
+	if bytes.Contains(body, []byte("<Error>")) {
+		return errors.Errorf("failed to complete the multipart upload: %s", strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// List returns all the objects with the given key prefix.
+func (c *Client) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	var token string
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		req, err := c.newRequest(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		body, _, err := c.do(req, emptyPayloadHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list objects with prefix %q", prefix)
+		}
+		var result ListResult
+		if err = xml.Unmarshal(body, &result); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the list of objects")
+		}
+		objects = append(objects, result.Contents...)
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// Delete removes the object stored at key.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	_, _, err = c.do(req, emptyPayloadHash)
+	return errors.Wrapf(err, "failed to delete %s", key)
+}
+
+func (c *Client) newRequest(ctx context.Context, method, key string, query url.Values, body io.Reader) (*http.Request, error) {
+	u := c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = ""
+	u.RawQuery = strings.ReplaceAll(query.Encode(), "+", "%20")
+	return http.NewRequestWithContext(ctx, method, u.String(), body)
+}
+
+func (c *Client) do(req *http.Request, payloadHash string) ([]byte, http.Header, error) {
+	c.sign(req, payloadHash)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, errors.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, resp.Header, nil
+}
+
+// sign adds the AWS Signature Version 4 authorization header to req.
+func (c *Client) sign(req *http.Request, payloadHash string) {
+	now := c.now().UTC()
+	amzDate := now.Format(timeFormat)
+	scope := strings.Join([]string{now.Format(dateFormat), c.region, service, "aws4_request"}, "/")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, headers[name])
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		Algorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secretAccessKey), now.Format(dateFormat))
+	for _, part := range []string{c.region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		Algorithm, c.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
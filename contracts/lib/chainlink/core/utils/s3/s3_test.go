@@ -0,0 +1,59 @@
+package s3_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/s3test"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/s3"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	server := s3test.NewServer(t, "backups")
+	client := s3.NewClient(server.Endpoint(t), "backups", "us-east-1", s3test.AccessKeyID, s3test.SecretAccessKey, time.Minute)
+
+	path := filepath.Join(t.TempDir(), "cl_backup_1.0.0.dump")
+	require.NoError(t, os.WriteFile(path, []byte("dump"), 0600))
+	require.NoError(t, client.PutFile(ctx, "node-1/cl_backup_1.0.0.dump", path))
+	server.Put("node-2/cl_backup_1.0.0.dump", []byte("other"), time.Now())
+
+	objects, err := client.List(ctx, "node-1/")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "node-1/cl_backup_1.0.0.dump", objects[0].Key)
+	assert.Equal(t, int64(4), objects[0].Size)
+
+	require.NoError(t, client.Delete(ctx, "node-1/cl_backup_1.0.0.dump"))
+	assert.Equal(t, []string{"node-2/cl_backup_1.0.0.dump"}, server.Keys())
+
+	denied := s3.NewClient(server.Endpoint(t), "backups", "us-east-1", "unknown", s3test.SecretAccessKey, time.Minute)
+	require.ErrorContains(t, denied.PutFile(ctx, "node-1/cl_backup_1.0.0.dump", path), "403 Forbidden")
+}
+
+func TestClient_PutFileMultipart(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	server := s3test.NewServer(t, "backups")
+	client := s3.NewClient(server.Endpoint(t), "backups", "us-east-1", s3test.AccessKeyID, s3test.SecretAccessKey, time.Minute)
+	client.SetPartSize(4)
+
+	path := filepath.Join(t.TempDir(), "cl_backup_1.0.0.dump")
+	data := []byte("a dump uploaded in parts")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	require.NoError(t, client.PutFile(ctx, "node-1/cl_backup_1.0.0.dump", path))
+
+	uploaded, ok := server.Get("node-1/cl_backup_1.0.0.dump")
+	require.True(t, ok)
+	assert.Equal(t, data, uploaded)
+	assert.Zero(t, server.Uploads())
+}
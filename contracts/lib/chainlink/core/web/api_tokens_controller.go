@@ -0,0 +1,167 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CreateAPITokenRequest is the body of a named API token creation request.
+type CreateAPITokenRequest struct {
+	Name      string       `json:"name"`
+	Password  string       `json:"password"`
+	ExpiresAt *time.Time   `json:"expiresAt"`
+	Grants    []rbac.Grant `json:"grants"`
+}
+
+// APITokensController manages the named API tokens of users.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// Index lists the API tokens of the current user.
+// Example:
+// "GET <application>/user/tokens"
+func (tc *APITokensController) Index(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	tc.respondWithTokens(c, user.Email)
+}
+
+// Create creates a new API token for the current user. The secret is only returned in the
+// response.
+// Example:
+// "POST <application>/user/tokens"
+func (tc *APITokensController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	if restrictedToken(c) {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+		return
+	}
+	// In order to create an API token, login validation with provided password must succeed
+	if err := tc.App.AuthenticationProvider().TestPassword(ctx, user.Email, request.Password); err != nil {
+		tc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	token := apitokens.Token{
+		UserEmail: user.Email,
+		Name:      request.Name,
+		Grants:    request.Grants,
+		ExpiresAt: null.TimeFromPtr(request.ExpiresAt),
+	}
+	if err := token.Validate(time.Now()); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	credentials, err := tc.App.APITokensORM().CreateToken(ctx, &token)
+	if err != nil {
+		// If this is a duplicate key error (code 23505), return a nicer error message
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("token %s already exists", token.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":      user.Email,
+		"name":      token.Name,
+		"grants":    token.Grants,
+		"expiresAt": token.ExpiresAt,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewCreatedAPITokenResource(token, credentials), "apiToken", http.StatusCreated)
+}
+
+// Delete revokes an API token of the current user.
+// Example:
+// "DELETE <application>/user/tokens/:name"
+func (tc *APITokensController) Delete(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	if restrictedToken(c) {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+		return
+	}
+	tc.revoke(c, user.Email, c.Param("name"))
+}
+
+// UserIndex lists the API tokens of a user.
+// Example:
+// "GET <application>/users/:email/tokens"
+func (tc *APITokensController) UserIndex(c *gin.Context) {
+	tc.respondWithTokens(c, c.Param("email"))
+}
+
+// UserDelete revokes an API token of a user.
+// Example:
+// "DELETE <application>/users/:email/tokens/:name"
+func (tc *APITokensController) UserDelete(c *gin.Context) {
+	tc.revoke(c, c.Param("email"), c.Param("name"))
+}
+
+func (tc *APITokensController) revoke(c *gin.Context, email string, name string) {
+	err := tc.App.APITokensORM().DeleteToken(c.Request.Context(), email, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("token not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{
+		"user": email,
+		"name": name,
+	})
+	jsonAPIResponseWithStatus(c, nil, "apiToken", http.StatusNoContent)
+}
+
+func (tc *APITokensController) respondWithTokens(c *gin.Context, email string) {
+	tokens, err := tc.App.APITokensORM().ListTokens(c.Request.Context(), email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "apiTokens")
+}
+
+// restrictedToken reports whether the request was authenticated by an API token only having some
+// of the permissions of its user, which may not manage tokens.
+func restrictedToken(c *gin.Context) bool {
+	token, ok := webauth.GetAuthenticatedAPIToken(c)
+	return ok && token.Restricted()
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 const (
@@ -33,6 +38,18 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionCustomRolesKey is the custom roles ORM key in the session map
+	SessionCustomRolesKey = "custom_roles"
+
+	// SessionAuthorizationKey is the Authorization key in the session map
+	SessionAuthorizationKey = "authorization"
+
+	// SessionAPITokenKey is the named API token key in the session map
+	SessionAPITokenKey = "api_token"
+
+	// SessionPermissionKey is the key of the permission checked by the route in the session map
+	SessionPermissionKey = "permission"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -44,6 +61,28 @@ type Authenticator interface {
 	FindUserByAPIToken(ctx context.Context, apiToken string) (clsessions.User, error)
 }
 
+// APITokensAuthenticator is an Authenticator which also authenticates the named API tokens of
+// users.
+type APITokensAuthenticator interface {
+	Authenticator
+	APITokens() apitokens.ORM
+}
+
+type apiTokensAuthenticator struct {
+	Authenticator
+	tokens apitokens.ORM
+}
+
+func (a apiTokensAuthenticator) APITokens() apitokens.ORM {
+	return a.tokens
+}
+
+// WithAPITokens returns an Authenticator which also authenticates the named API tokens of users
+// with AuthenticateByToken.
+func WithAPITokens(authr Authenticator, tokens apitokens.ORM) APITokensAuthenticator {
+	return apiTokensAuthenticator{Authenticator: authr, tokens: tokens}
+}
+
 // authMethod defines a method which can be used to authenticate a request. This
 // can be implemented according to your authentication method (i.e by session,
 // token, etc)
@@ -72,7 +111,8 @@ func AuthenticateBySession(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateBySession
 
-// AuthenticateByToken authenticates a User by their API token.
+// AuthenticateByToken authenticates a User by one of their named API tokens, when authr is an
+// APITokensAuthenticator, or by their API token.
 //
 // Implements authMethod
 func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
@@ -89,6 +129,16 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		return auth.ErrorAuthFailed
 	}
 
+	if tokensAuthr, ok := authr.(APITokensAuthenticator); ok {
+		apiToken, err := tokensAuthr.APITokens().FindTokenByKey(ctx, token.AccessKey)
+		if err == nil {
+			return authenticateByAPIToken(c, tokensAuthr, token, apiToken)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "finding API token")
+		}
+	}
+
 	// We need to first load the user row so we can compare tokens using the stored salt
 	user, err := authr.FindUserByAPIToken(ctx, token.AccessKey)
 	if err != nil {
@@ -113,6 +163,41 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+func authenticateByAPIToken(c *gin.Context, authr APITokensAuthenticator, credentials *auth.Token, apiToken apitokens.Token) error {
+	ctx := c.Request.Context()
+	now := time.Now()
+	if apiToken.Expired(now) {
+		return auth.ErrorAuthFailed
+	}
+	ok, err := apiToken.Authenticate(credentials)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.ErrorAuthFailed
+	}
+
+	// The user is loaded on every request, so that changes to their role apply to their tokens
+	user, err := authr.FindUser(ctx, apiToken.UserEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.ErrorAuthFailed
+		}
+		return err
+	}
+
+	if apiToken.NeedsLastUsedUpdate(now) {
+		if err = authr.APITokens().MarkUsed(ctx, apiToken.ID); err != nil {
+			return errors.Wrap(err, "recording API token use")
+		}
+	}
+
+	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, &apiToken)
+
+	return nil
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -174,8 +259,97 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 	}
 }
 
-// GetAuthenticatedUser extracts the authentication user from the context.
+// LoadCustomRoles is middleware which lets permission checks take the custom roles of the
+// authenticated user into account. The roles are only loaded when the base role of the user is
+// not enough.
+func LoadCustomRoles(roles rbac.ORM) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(SessionCustomRolesKey, roles)
+		c.Next()
+	}
+}
+
+// errRouteNotGranted is the error of the routes denied to restricted API tokens.
+var errRouteNotGranted = errors.New("Unauthorized: API token is not granted access to this route")
+
+// RestrictAPITokens is middleware which denies requests authenticated by a restricted API token,
+// unless the route checks its permission with RequiresPermission, against the grants of the
+// token. Routes are thus closed to restricted tokens by default, whatever their method.
+//
+// The middleware runs before the route handler, so it cannot tell yet whether the route checks
+// its permission. Until RequiresPermission marks the request with SessionPermissionKey, the user
+// is hidden from the handlers and the first response written is replaced by the error.
+func RestrictAPITokens() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !restrictedByAPIToken(c) {
+			c.Next()
+			return
+		}
+		w := &restrictedTokenWriter{ResponseWriter: c.Writer, c: c}
+		c.Writer = w
+		c.Next()
+		w.allowed()
+	}
+}
+
+// restrictedTokenWriter writes the response of a request authenticated by a restricted API token,
+// if the route checked its permission by the time the response is written.
+type restrictedTokenWriter struct {
+	gin.ResponseWriter
+	c       *gin.Context
+	checked bool
+	allow   bool
+}
+
+// allowed reports whether the route checked its permission, writing the error the first time it
+// did not.
+func (w *restrictedTokenWriter) allowed() bool {
+	if w.checked {
+		return w.allow
+	}
+	w.checked = true
+	if _, w.allow = w.c.Get(SessionPermissionKey); w.allow {
+		return true
+	}
+	w.c.Abort()
+	_ = w.c.Error(errRouteNotGranted).SetType(gin.ErrorTypePublic)
+	w.ResponseWriter.WriteHeader(http.StatusUnauthorized)
+	_ = render.JSON{Data: models.NewJSONAPIErrorsWith(errRouteNotGranted.Error())}.Render(w.ResponseWriter)
+	return false
+}
+
+func (w *restrictedTokenWriter) WriteHeader(code int) {
+	if w.allowed() {
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *restrictedTokenWriter) WriteHeaderNow() {
+	if w.allowed() {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *restrictedTokenWriter) Write(data []byte) (int, error) {
+	if !w.allowed() {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *restrictedTokenWriter) WriteString(s string) (int, error) {
+	if !w.allowed() {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+// GetAuthenticatedUser extracts the authentication user from the context. Requests authenticated
+// by a restricted API token only have a user once RequiresPermission checked the route permission.
 func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
+	if _, checked := c.Get(SessionPermissionKey); !checked && restrictedByAPIToken(c) {
+		return nil, false
+	}
 	obj, ok := c.Get(SessionUserKey)
 	if !ok {
 		return nil, false
@@ -186,6 +360,25 @@ func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return user, ok
 }
 
+// GetAuthenticatedAPIToken extracts the named API token used to authenticate from the context.
+func GetAuthenticatedAPIToken(c *gin.Context) (*apitokens.Token, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	token, ok := obj.(*apitokens.Token)
+
+	return token, ok
+}
+
+// restrictedByAPIToken reports whether the request was authenticated by an API token only having
+// some of the permissions of its user.
+func restrictedByAPIToken(c *gin.Context) bool {
+	token, ok := GetAuthenticatedAPIToken(c)
+	return ok && token.Restricted()
+}
+
 // GetAuthenticatedExternalInitiator extracts the external initiator from the
 // context.
 func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiator, bool) {
@@ -207,7 +400,7 @@ func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role == clsessions.UserRoleView {
+		if user.Role == clsessions.UserRoleView || restrictedByAPIToken(c) {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
@@ -226,7 +419,7 @@ func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
+		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun || restrictedByAPIToken(c) {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
@@ -244,7 +437,7 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role != clsessions.UserRoleAdmin {
+		if user.Role != clsessions.UserRoleAdmin || restrictedByAPIToken(c) {
 			c.Abort()
 			addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
 			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
@@ -253,3 +446,105 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 		handler(c)
 	}
 }
+
+// GetAuthorization returns what the authenticated user is allowed to do, loading their custom
+// roles when the request went through LoadCustomRoles.
+func GetAuthorization(c *gin.Context) (rbac.Authorization, error) {
+	if obj, ok := c.Get(SessionAuthorizationKey); ok {
+		return obj.(rbac.Authorization), nil
+	}
+	user, ok := GetAuthenticatedUser(c)
+	if !ok {
+		return rbac.Authorization{}, errors.New("not a valid session")
+	}
+
+	authz := rbac.Authorization{Role: user.Role}
+	// External initiators act as a user without an email, who cannot hold custom roles
+	if obj, ok := c.Get(SessionCustomRolesKey); ok && user.Email != "" {
+		roles, err := obj.(rbac.ORM).FindUserRoles(c.Request.Context(), user.Email)
+		if err != nil {
+			return rbac.Authorization{}, errors.Wrap(err, "failed to load custom roles")
+		}
+		authz = rbac.NewAuthorization(user.Role, roles)
+	}
+	if token, ok := GetAuthenticatedAPIToken(c); ok && token.Restricted() {
+		authz = authz.Limit(token.Grants)
+	}
+	c.Set(SessionAuthorizationKey, authz)
+
+	return authz, nil
+}
+
+// BaseRoleAllows reports whether the base role of the authenticated user grants the permission,
+// in which case there is no need to load their custom roles. It is always false for requests
+// authenticated by a restricted API token.
+func BaseRoleAllows(c *gin.Context, permission rbac.Permission) bool {
+	user, ok := GetAuthenticatedUser(c)
+	return ok && !restrictedByAPIToken(c) && rbac.RoleAllows(user.Role, permission)
+}
+
+// RequiresPermission extracts the user object from the context, and asserts the user is granted
+// the permission, either by their base role or by one of their custom roles, and by the API token
+// used if it is restricted. Handlers of job
+// scoped permissions must also check the job itself with AuthorizeJob.
+func RequiresPermission(permission rbac.Permission, handler func(*gin.Context)) func(*gin.Context) {
+	return func(c *gin.Context) {
+		c.Set(SessionPermissionKey, permission)
+		user, ok := GetAuthenticatedUser(c)
+		if !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+			return
+		}
+		if BaseRoleAllows(c, permission) {
+			handler(c)
+			return
+		}
+		authz, err := GetAuthorization(c)
+		if err != nil {
+			c.Abort()
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if !authz.Allows(permission) {
+			c.Abort()
+			permissionDenied(c, permission, user)
+			return
+		}
+		handler(c)
+	}
+}
+
+// AuthorizeJob asserts the authenticated user is granted the permission for the job. If not, it
+// writes the error response and returns false.
+func AuthorizeJob(c *gin.Context, permission rbac.Permission, job rbac.Job) bool {
+	user, ok := GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+		return false
+	}
+	if BaseRoleAllows(c, permission) {
+		return true
+	}
+	authz, err := GetAuthorization(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+	if !authz.AllowsJob(permission, job) {
+		permissionDenied(c, permission, user)
+		return false
+	}
+	return true
+}
+
+// permissionDenied responds the same way as the role checks above: permissions otherwise
+// reserved to admins are forbidden, the others are unauthorized.
+func permissionDenied(c *gin.Context, permission rbac.Permission, user *clsessions.User) {
+	if permission.Role() == clsessions.UserRoleAdmin {
+		addForbiddenErrorHeaders(c, string(permission.Role()), string(user.Role), user.Email)
+		jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+		return
+	}
+	jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+}
@@ -2,22 +2,27 @@ package auth_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
@@ -225,6 +230,19 @@ var routesRolesMap = [...]routeRules{
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
+	{"GET", "/v2/user/tokens", true, true, true},
+	{"POST", "/v2/user/tokens", true, true, true},
+	{"DELETE", "/v2/user/tokens/MOCK", true, true, true},
+	{"GET", "/v2/users/MOCK/tokens", false, false, false},
+	{"DELETE", "/v2/users/MOCK/tokens/MOCK", false, false, false},
+	{"GET", "/v2/roles", false, false, false},
+	{"POST", "/v2/roles", false, false, false},
+	{"GET", "/v2/roles/MOCK", false, false, false},
+	{"PATCH", "/v2/roles/MOCK", false, false, false},
+	{"DELETE", "/v2/roles/MOCK", false, false, false},
+	{"POST", "/v2/roles/MOCK/users", false, false, false},
+	{"DELETE", "/v2/roles/MOCK/users/MOCK", false, false, false},
+	{"GET", "/v2/users/MOCK/roles", false, false, false},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
 	{"GET", "/v2/external_initiators", true, true, true},
@@ -307,6 +325,7 @@ var routesRolesMap = [...]routeRules{
 	{"DELETE", "/v2/keys/vrf/MOCK", false, false, false},
 	{"POST", "/v2/keys/vrf/import", false, false, false},
 	{"POST", "/v2/keys/vrf/export/MOCK", false, false, false},
+	{"GET", "/v2/keys/usage", false, false, false},
 	{"GET", "/v2/jobs", true, true, true},
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
@@ -528,3 +547,194 @@ func mustRequest(t *testing.T, method, url string, body io.Reader) *http.Request
 	require.NoError(t, err)
 	return req
 }
+
+type customRolesFinder struct {
+	rbac.ORM
+	roles []rbac.Role
+}
+
+func (f customRolesFinder) FindUserRoles(context.Context, string) ([]rbac.Role, error) {
+	return f.roles, nil
+}
+
+func TestRequiresPermission(t *testing.T) {
+	roles := customRolesFinder{roles: []rbac.Role{{
+		Name: "bridge-operator",
+		Grants: rbac.Grants{
+			{Permission: rbac.BridgesCreate},
+			{Permission: rbac.JobsDelete, JobTags: []string{"staging"}},
+		},
+	}}}
+
+	newRouter := func(role sessions.UserRole, called *bool) *gin.Engine {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set(webauth.SessionUserKey, &sessions.User{Email: "operator@chain.link", Role: role})
+		}, webauth.LoadCustomRoles(roles))
+		handler := func(c *gin.Context) {
+			*called = true
+			c.String(http.StatusOK, "")
+		}
+		router.POST("/bridges", webauth.RequiresPermission(rbac.BridgesCreate, handler))
+		router.POST("/keys", webauth.RequiresPermission(rbac.KeysExport, handler))
+		router.DELETE("/jobs/:tag", webauth.RequiresPermission(rbac.JobsDelete, func(c *gin.Context) {
+			if webauth.AuthorizeJob(c, rbac.JobsDelete, rbac.Job{ID: 1, Type: "cron", Tags: []string{c.Param("tag")}}) {
+				handler(c)
+			}
+		}))
+		return router
+	}
+
+	tests := []struct {
+		name     string
+		role     sessions.UserRole
+		method   string
+		path     string
+		wantCode int
+	}{
+		{"granted by custom role", sessions.UserRoleView, "POST", "/bridges", http.StatusOK},
+		{"granted by base role", sessions.UserRoleAdmin, "POST", "/keys", http.StatusOK},
+		{"admin permission not granted", sessions.UserRoleView, "POST", "/keys", http.StatusForbidden},
+		{"job in scope", sessions.UserRoleRun, "DELETE", "/jobs/staging", http.StatusOK},
+		{"job out of scope", sessions.UserRoleRun, "DELETE", "/jobs/production", http.StatusUnauthorized},
+		{"any job for base role", sessions.UserRoleEdit, "DELETE", "/jobs/production", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			w := httptest.NewRecorder()
+			newRouter(tt.role, &called).ServeHTTP(w, mustRequest(t, tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.Equal(t, tt.wantCode == http.StatusOK, called)
+		})
+	}
+}
+
+type apiTokensFinder struct {
+	apitokens.ORM
+	tokens map[string]apitokens.Token
+	used   []int64
+}
+
+func (f *apiTokensFinder) FindTokenByKey(_ context.Context, accessKey string) (apitokens.Token, error) {
+	token, ok := f.tokens[accessKey]
+	if !ok {
+		return apitokens.Token{}, sql.ErrNoRows
+	}
+	return token, nil
+}
+
+func (f *apiTokensFinder) MarkUsed(_ context.Context, id int64) error {
+	f.used = append(f.used, id)
+	return nil
+}
+
+func newAPIToken(t *testing.T, id int64, name string, grants rbac.Grants, expiresAt null.Time) (apitokens.Token, *auth.Token) {
+	credentials := auth.NewToken()
+	salt := uuid.New().String()
+	hashedSecret, err := auth.HashedSecret(credentials, salt)
+	require.NoError(t, err)
+	return apitokens.Token{
+		ID:                id,
+		UserEmail:         "operator@chain.link",
+		Name:              name,
+		TokenKey:          credentials.AccessKey,
+		TokenSalt:         salt,
+		TokenHashedSecret: hashedSecret,
+		Grants:            grants,
+		ExpiresAt:         expiresAt,
+	}, credentials
+}
+
+func TestAuthenticateByToken_APITokens(t *testing.T) {
+	user := sessions.User{Email: "operator@chain.link", Role: sessions.UserRoleAdmin}
+	scoped, scopedCredentials := newAPIToken(t, 1, "ci", rbac.Grants{
+		{Permission: rbac.JobsRun, JobIDs: []int32{1}},
+		{Permission: rbac.JobsRead, JobIDs: []int32{1}},
+	}, null.Time{})
+	full, fullCredentials := newAPIToken(t, 2, "full", nil, null.Time{})
+	expired, expiredCredentials := newAPIToken(t, 3, "expired", nil, null.TimeFrom(time.Now().Add(-time.Minute)))
+	tokens := &apiTokensFinder{tokens: map[string]apitokens.Token{
+		scoped.TokenKey:  scoped,
+		full.TokenKey:    full,
+		expired.TokenKey: expired,
+	}}
+
+	router := gin.New()
+	router.Use(
+		webauth.Authenticate(webauth.WithAPITokens(userFindSuccesser{user: user}, tokens), webauth.AuthenticateByToken),
+		webauth.RestrictAPITokens(),
+		webauth.LoadCustomRoles(customRolesFinder{}),
+	)
+	handler := func(c *gin.Context) {
+		c.String(http.StatusOK, "")
+	}
+	router.GET("/jobs", handler)
+	readJob := webauth.RequiresPermission(rbac.JobsRead, func(c *gin.Context) {
+		var id int32
+		_, err := fmt.Sscan(c.Param("id"), &id)
+		require.NoError(t, err)
+		if webauth.AuthorizeJob(c, rbac.JobsRead, rbac.Job{ID: id, Type: "webhook"}) {
+			handler(c)
+		}
+	})
+	router.GET("/jobs/:id", readJob)
+	router.GET("/jobs/:id/wrapped", func(c *gin.Context) {
+		readJob(c)
+	})
+	var actedAs *sessions.User
+	router.POST("/user/token", func(c *gin.Context) {
+		actedAs, _ = webauth.GetAuthenticatedUser(c)
+		handler(c)
+	})
+	router.POST("/bridges", webauth.RequiresEditRole(handler))
+	router.POST("/users", webauth.RequiresAdminRole(handler))
+	router.POST("/jobs/:id/runs", webauth.RequiresPermission(rbac.JobsRun, func(c *gin.Context) {
+		var id int32
+		_, err := fmt.Sscan(c.Param("id"), &id)
+		require.NoError(t, err)
+		if webauth.AuthorizeJob(c, rbac.JobsRun, rbac.Job{ID: id, Type: "webhook"}) {
+			handler(c)
+		}
+	}))
+
+	tests := []struct {
+		name        string
+		credentials *auth.Token
+		method      string
+		path        string
+		wantCode    int
+	}{
+		{"scoped token runs job in scope", scopedCredentials, "POST", "/jobs/1/runs", http.StatusOK},
+		{"scoped token cannot run other jobs", scopedCredentials, "POST", "/jobs/2/runs", http.StatusUnauthorized},
+		{"scoped token reads job in scope", scopedCredentials, "GET", "/jobs/1", http.StatusOK},
+		{"scoped token cannot read other jobs", scopedCredentials, "GET", "/jobs/2", http.StatusUnauthorized},
+		{"scoped token reads job in scope through a wrapped handler", scopedCredentials, "GET", "/jobs/1/wrapped", http.StatusOK},
+		{"scoped token cannot read other jobs through a wrapped handler", scopedCredentials, "GET", "/jobs/2/wrapped", http.StatusUnauthorized},
+		{"scoped token cannot use routes without permission", scopedCredentials, "GET", "/jobs", http.StatusUnauthorized},
+		{"scoped token cannot act as its user", scopedCredentials, "POST", "/user/token", http.StatusUnauthorized},
+		{"scoped token cannot edit", scopedCredentials, "POST", "/bridges", http.StatusUnauthorized},
+		{"scoped token cannot administer", scopedCredentials, "POST", "/users", http.StatusUnauthorized},
+		{"unrestricted token can view", fullCredentials, "GET", "/jobs", http.StatusOK},
+		{"unrestricted token has the user role", fullCredentials, "POST", "/users", http.StatusOK},
+		{"unrestricted token runs any job", fullCredentials, "POST", "/jobs/2/runs", http.StatusOK},
+		{"expired token", expiredCredentials, "GET", "/jobs", http.StatusUnauthorized},
+		{"wrong secret", &auth.Token{AccessKey: scoped.TokenKey, Secret: "wrong"}, "GET", "/jobs", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := mustRequest(t, tt.method, tt.path, nil)
+			req.Header.Set(webauth.APIKey, tt.credentials.AccessKey)
+			req.Header.Set(webauth.APISecret, tt.credentials.Secret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+
+	assert.Nil(t, actedAs)
+	assert.Contains(t, tokens.used, scoped.ID)
+	assert.NotContains(t, tokens.used, expired.ID)
+}
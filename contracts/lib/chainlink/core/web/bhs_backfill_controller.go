@@ -0,0 +1,236 @@
+package web
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/batch_blockhash_store"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/blockhash_store"
+	v1 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	v2 "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2"
+	v2plus "github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2plus_interface"
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	defaultBackfillGetBlockhashesBatchSize   = 100
+	defaultBackfillStoreBlockhashesBatchSize = 10
+)
+
+// BHSBackfillRequest is the body of a BlockhashStore coverage and backfill request.
+type BHSBackfillRequest struct {
+	EVMChainID                *ubig.Big       `json:"evmChainID"`
+	BHSAddress                common.Address  `json:"bhsAddress"`
+	BatchBHSAddress           common.Address  `json:"batchBHSAddress"`
+	CoordinatorV1Address      *common.Address `json:"coordinatorV1Address"`
+	CoordinatorV2Address      *common.Address `json:"coordinatorV2Address"`
+	CoordinatorV2PlusAddress  *common.Address `json:"coordinatorV2PlusAddress"`
+	FromBlock                 uint64          `json:"fromBlock"`
+	ToBlock                   uint64          `json:"toBlock"`
+	FromAddress               common.Address  `json:"fromAddress"`
+	GetBlockhashesBatchSize   uint16          `json:"getBlockhashesBatchSize"`
+	StoreBlockhashesBatchSize uint16          `json:"storeBlockhashesBatchSize"`
+	DryRun                    bool            `json:"dryRun"`
+}
+
+// BHSBackfillController reports on the BlockhashStore coverage of unfulfilled VRF requests
+// over an arbitrary block range, and backfills the missing blockhashes. Both can take long over
+// wide ranges, so they run as background tasks whose status is polled.
+type BHSBackfillController struct {
+	App   chainlink.Application
+	Tasks *blockheaderfeeder.BackfillTasks
+}
+
+// Create starts a task computing the coverage report for the requested range and, unless it is a
+// dry run, enqueuing the transactions storing the missing blockhashes. At most
+// blockheaderfeeder.MaxBlocksToStore block headers are stored by a task.
+// Example:
+// "POST <application>/bhs/backfill"
+func (bc *BHSBackfillController) Create(c *gin.Context) {
+	var req BHSBackfillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if req.BHSAddress == utils.ZeroAddress || req.BatchBHSAddress == utils.ZeroAddress {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bhsAddress and batchBHSAddress are required"))
+		return
+	}
+	if req.CoordinatorV1Address == nil && req.CoordinatorV2Address == nil && req.CoordinatorV2PlusAddress == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("at least one coordinator address is required"))
+		return
+	}
+	if req.FromAddress == utils.ZeroAddress {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("fromAddress is required"))
+		return
+	}
+	if req.FromBlock > req.ToBlock {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("fromBlock (%d) must not be greater than toBlock (%d)", req.FromBlock, req.ToBlock))
+		return
+	}
+	if req.GetBlockhashesBatchSize == 0 {
+		req.GetBlockhashesBatchSize = defaultBackfillGetBlockhashesBatchSize
+	}
+	if req.StoreBlockhashesBatchSize == 0 {
+		req.StoreBlockhashesBatchSize = defaultBackfillStoreBlockhashesBatchSize
+	}
+
+	chain, err := getChain(bc.App.GetRelayers().LegacyEVMChains(), req.EVMChainID.String())
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	cid := chain.ID()
+	ks := keys.NewChainStore(keystore.NewEthSigner(bc.App.GetKeyStore().Eth(), cid), cid)
+	if err = ks.CheckEnabled(c.Request.Context(), req.FromAddress); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	backfiller, err := bc.newBackfiller(chain, ks, req)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	task, err := bc.Tasks.Start(cid.String(), req.BHSAddress, req.DryRun, func(ctx context.Context) (*blockheaderfeeder.CoverageReport, int, error) {
+		report, err := backfiller.Coverage(ctx, req.FromBlock, req.ToBlock, req.FromAddress)
+		if err != nil || req.DryRun {
+			return report, 0, err
+		}
+		bc.App.GetAuditLogger().Audit(audit.BHSBackfillStarted, map[string]interface{}{
+			"evmChainID":    cid.String(),
+			"bhsAddress":    req.BHSAddress,
+			"fromBlock":     req.FromBlock,
+			"toBlock":       req.ToBlock,
+			"fromAddress":   req.FromAddress,
+			"blocksToStore": report.BlocksToStore,
+		})
+		enqueued, err := backfiller.Backfill(ctx, report, req.FromAddress)
+		if err != nil {
+			return report, enqueued, errors.Wrapf(err, "backfill failed after enqueuing %d blockhashes", enqueued)
+		}
+		return report, enqueued, nil
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewBHSBackfillTaskResource(task), "bhs_backfill_tasks", http.StatusAccepted)
+}
+
+// Show returns the status of a backfill task, along with its coverage report once it finished.
+// Example:
+// "GET <application>/bhs/backfill/:ID"
+func (bc *BHSBackfillController) Show(c *gin.Context) {
+	task, err := bc.Tasks.Get(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewBHSBackfillTaskResource(task), "bhs_backfill_tasks")
+}
+
+func (bc *BHSBackfillController) newBackfiller(chain legacyevm.Chain, ks keys.ChainStore, req BHSBackfillRequest) (*blockheaderfeeder.Backfiller, error) {
+	client := chain.Client()
+	bhs, err := blockhash_store.NewBlockhashStore(req.BHSAddress, client)
+	if err != nil {
+		return nil, errors.Wrap(err, "building BHS")
+	}
+	batchBlockhashStore, err := batch_blockhash_store.NewBatchBlockhashStore(req.BatchBHSAddress, client)
+	if err != nil {
+		return nil, errors.Wrap(err, "building batch BHS")
+	}
+
+	// The log poller only covers its retention window, so the coordinators read logs
+	// straight from the RPC.
+	var coordinators []blockhashstore.Coordinator
+	if req.CoordinatorV1Address != nil {
+		c, err2 := v1.NewVRFCoordinator(*req.CoordinatorV1Address, client)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "building V1 coordinator")
+		}
+		coordinators = append(coordinators, blockhashstore.NewRPCV1Coordinator(c, client))
+	}
+	if req.CoordinatorV2Address != nil {
+		c, err2 := v2.NewVRFCoordinatorV2(*req.CoordinatorV2Address, client)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "building V2 coordinator")
+		}
+		coordinators = append(coordinators, blockhashstore.NewRPCV2Coordinator(c, client))
+	}
+	if req.CoordinatorV2PlusAddress != nil {
+		c, err2 := v2plus.NewIVRFCoordinatorV2PlusInternal(*req.CoordinatorV2PlusAddress, client)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "building V2 plus coordinator")
+		}
+		coordinators = append(coordinators, blockhashstore.NewRPCV2PlusCoordinator(c, client))
+	}
+
+	bpBHS, err := blockhashstore.NewBulletproofBHS(
+		chain.Config().EVM().GasEstimator(),
+		bc.App.GetConfig().Database(),
+		[]evmtypes.EIP55Address{evmtypes.EIP55AddressFromAddress(req.FromAddress)},
+		chain.TxManager(),
+		bhs,
+		nil,
+		ks,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "building bulletproof bhs")
+	}
+	batchBHS, err := blockhashstore.NewBatchBHS(
+		chain.Config().EVM().GasEstimator(),
+		chain.TxManager(),
+		batchBlockhashStore,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "building batchBHS")
+	}
+
+	lggr := bc.App.GetLogger().With(
+		"evmChainID", chain.ID(),
+		"bhsAddress", req.BHSAddress,
+		"batchBHSAddress", req.BatchBHSAddress,
+	)
+	return blockheaderfeeder.NewBackfiller(
+		lggr,
+		blockhashstore.NewMultiCoordinator(coordinators...),
+		bpBHS,
+		batchBHS,
+		blockheaderfeeder.NewGethBlockHeaderProvider(client),
+		func(ctx context.Context) (uint64, error) {
+			head, err := client.HeadByNumber(ctx, nil)
+			if err != nil {
+				return 0, errors.Wrap(err, "getting chain head")
+			}
+			return uint64(head.Number), nil
+		},
+		func(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) (uint64, error) {
+			return batchBHS.EstimateStoreVerifyHeader(ctx, client, blockNumbers, blockHeaders, fromAddress)
+		},
+		req.GetBlockhashesBatchSize,
+		req.StoreBlockhashesBatchSize,
+	), nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
 )
@@ -41,6 +42,20 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, ConfigV2Resource{toml}, "config")
 }
 
+// Reload re-reads the configuration and applies the changes which do not require a restart. The changes which
+// require a restart are only reported. Nothing is applied if the new configuration is invalid.
+// Example:
+//
+//	"<application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	reload, err := cc.App.ReloadConfig(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewConfigReloadResource(reload), "configReload")
+}
+
 type ConfigV2Resource struct {
 	Config string `json:"config"`
 }
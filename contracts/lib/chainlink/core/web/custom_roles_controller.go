@@ -0,0 +1,216 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CustomRoleRequest is the body of a custom role creation or update request.
+type CustomRoleRequest struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Grants      []rbac.Grant `json:"grants"`
+}
+
+// CustomRoleAssignmentRequest is the body of a custom role assignment request.
+type CustomRoleAssignmentRequest struct {
+	Email string `json:"email"`
+}
+
+// CustomRolesController manages the custom roles granting users permissions on top of their
+// base role.
+type CustomRolesController struct {
+	App chainlink.Application
+}
+
+// Index lists all the custom roles.
+// Example:
+// "GET <application>/roles"
+func (rc *CustomRolesController) Index(c *gin.Context) {
+	roles, err := rc.App.CustomRolesORM().ListRoles(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCustomRoleResources(roles), "customRoles")
+}
+
+// Show returns a custom role by name.
+// Example:
+// "GET <application>/roles/:name"
+func (rc *CustomRolesController) Show(c *gin.Context) {
+	role, err := rc.App.CustomRolesORM().FindRole(c.Request.Context(), c.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCustomRoleResource(role), "customRole")
+}
+
+// Create creates a new custom role.
+// Example:
+// "POST <application>/roles"
+func (rc *CustomRolesController) Create(c *gin.Context) {
+	var request CustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role := rbac.Role{Name: request.Name, Description: request.Description, Grants: request.Grants}
+	if err := role.Validate(); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := rc.App.CustomRolesORM().CreateRole(c.Request.Context(), &role); err != nil {
+		// If this is a duplicate key error (code 23505), return a nicer error message
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("role %s already exists", role.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.CustomRoleCreated, map[string]interface{}{
+		"name":   role.Name,
+		"grants": role.Grants,
+	})
+	jsonAPIResponse(c, presenters.NewCustomRoleResource(role), "customRole")
+}
+
+// Update replaces the description and grants of a custom role.
+// Example:
+// "PATCH <application>/roles/:name"
+func (rc *CustomRolesController) Update(c *gin.Context) {
+	var request CustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role := rbac.Role{Name: c.Param("name"), Description: request.Description, Grants: request.Grants}
+	if err := role.Validate(); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	err := rc.App.CustomRolesORM().UpdateRole(c.Request.Context(), &role)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.CustomRoleUpdated, map[string]interface{}{
+		"name":   role.Name,
+		"grants": role.Grants,
+	})
+	jsonAPIResponse(c, presenters.NewCustomRoleResource(role), "customRole")
+}
+
+// Delete deletes a custom role, unassigning it from all its users.
+// Example:
+// "DELETE <application>/roles/:name"
+func (rc *CustomRolesController) Delete(c *gin.Context) {
+	name := c.Param("name")
+	err := rc.App.CustomRolesORM().DeleteRole(c.Request.Context(), name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.CustomRoleDeleted, map[string]interface{}{"name": name})
+	jsonAPIResponseWithStatus(c, nil, "customRole", http.StatusNoContent)
+}
+
+// Assign assigns a custom role to a user.
+// Example:
+// "POST <application>/roles/:name/users"
+func (rc *CustomRolesController) Assign(c *gin.Context) {
+	var request CustomRoleAssignmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Email == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("email is required"))
+		return
+	}
+
+	name := c.Param("name")
+	ctx := c.Request.Context()
+	err := rc.App.CustomRolesORM().AssignRole(ctx, request.Email, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.CustomRoleAssigned, map[string]interface{}{
+		"name":  name,
+		"email": request.Email,
+	})
+	rc.respondWithUserRoles(c, request.Email)
+}
+
+// Unassign removes a custom role from a user.
+// Example:
+// "DELETE <application>/roles/:name/users/:email"
+func (rc *CustomRolesController) Unassign(c *gin.Context) {
+	name, email := c.Param("name"), c.Param("email")
+	err := rc.App.CustomRolesORM().UnassignRole(c.Request.Context(), email, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("role %s is not assigned to %s", name, email))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.CustomRoleUnassigned, map[string]interface{}{
+		"name":  name,
+		"email": email,
+	})
+	rc.respondWithUserRoles(c, email)
+}
+
+// UserRoles lists the custom roles assigned to a user.
+// Example:
+// "GET <application>/users/:email/roles"
+func (rc *CustomRolesController) UserRoles(c *gin.Context) {
+	rc.respondWithUserRoles(c, c.Param("email"))
+}
+
+func (rc *CustomRolesController) respondWithUserRoles(c *gin.Context, email string) {
+	roles, err := rc.App.CustomRolesORM().FindUserRoles(c.Request.Context(), email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCustomRoleResources(roles), "customRoles")
+}
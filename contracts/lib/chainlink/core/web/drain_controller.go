@@ -0,0 +1,66 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// DrainController puts the node in drain mode before planned maintenance, in which new pipeline runs are refused while
+// the in-flight ones complete.
+type DrainController struct {
+	App chainlink.Application
+}
+
+// DrainRequest requests to drain the node, cancelling the runs still in flight after Timeout, like "10m".
+type DrainRequest struct {
+	Timeout string `json:"timeout"`
+}
+
+// Show returns the drain mode of the node.
+// Example:
+// "GET <application>/drain"
+func (dc *DrainController) Show(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewDrainResource(dc.App.DrainStatus()), "drain")
+}
+
+// Create starts draining the node. The runs in flight are awaited in the background: the state becomes "drained" once
+// they completed or were cancelled at the deadline.
+// Example:
+// "POST <application>/drain"
+func (dc *DrainController) Create(c *gin.Context) {
+	request := &DrainRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	timeout, err := time.ParseDuration(request.Timeout)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid timeout"))
+		return
+	}
+	status, err := dc.App.Drain(timeout)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	dc.App.GetAuditLogger().Audit(audit.NodeDrainStarted, map[string]interface{}{"timeout": timeout.String(), "deadline": status.Deadline})
+	jsonAPIResponseWithStatus(c, presenters.NewDrainResource(status), "drain", http.StatusAccepted)
+}
+
+// Delete stops draining the node, so that new pipeline runs are started again.
+// Example:
+// "DELETE <application>/drain"
+func (dc *DrainController) Delete(c *gin.Context) {
+	status := dc.App.Undrain()
+
+	dc.App.GetAuditLogger().Audit(audit.NodeDrainCanceled, map[string]interface{}{})
+	jsonAPIResponse(c, presenters.NewDrainResource(status), "drain")
+}
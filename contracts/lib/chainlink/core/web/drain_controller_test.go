@@ -0,0 +1,70 @@
+package web_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestDrainController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	drainStatus := func(t *testing.T) presenters.DrainResource {
+		resp, cleanup := client.Get("/v2/drain")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var drain presenters.DrainResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &drain))
+		return drain
+	}
+	assert.Equal(t, string(chainlink.DrainStateActive), drainStatus(t).State)
+
+	viewer := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+	resp, cleanup := viewer.Post("/v2/drain", strings.NewReader(`{"timeout": "1m"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = client.Post("/v2/drain", strings.NewReader(`{"timeout": "forever"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/drain", strings.NewReader(`{"timeout": "1m"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+
+	// no runs are in flight
+	require.Eventually(t, func() bool {
+		return drainStatus(t).State == string(chainlink.DrainStateDrained)
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+	drain := drainStatus(t)
+	assert.NotNil(t, drain.Deadline)
+	assert.False(t, drain.TimedOut)
+
+	resp, cleanup = client.Get("/readyz")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	resp, cleanup = client.Get("/health")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Contains(t, string(cltest.ParseResponseBody(t, resp)), `"status":"draining"`)
+
+	resp, cleanup = client.Delete("/v2/drain")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, string(chainlink.DrainStateActive), drainStatus(t).State)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/exp/maps"
@@ -20,10 +21,14 @@ type HealthController struct {
 }
 
 const (
-	HealthStatusPassing = "passing"
-	HealthStatusFailing = "failing"
+	HealthStatusPassing  = "passing"
+	HealthStatusFailing  = "failing"
+	HealthStatusDraining = "draining"
 )
 
+// drainCheckName is the name of the check reporting that the node is draining.
+const drainCheckName = "Drain"
+
 // NOTE: We only implement the k8s readiness check, *not* the liveness check. Liveness checks are only recommended in cases
 // where the app doesn't crash itself on panic, and if implemented incorrectly can cause cascading failures.
 // See the following for more information:
@@ -34,8 +39,10 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 	checker := hc.App.GetHealthChecker()
 
 	ready, errors := checker.IsReady()
+	drain, draining := hc.drainCheck()
 
-	if !ready {
+	// a draining node is not ready, so that traffic is shifted away from it
+	if !ready || draining {
 		status = http.StatusServiceUnavailable
 	}
 
@@ -63,6 +70,9 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 			Output: output,
 		})
 	}
+	if draining {
+		checks = append(checks, drain)
+	}
 
 	// return a json description of all the checks
 	jsonAPIResponse(c, checks, "checks")
@@ -76,8 +86,11 @@ func (hc *HealthController) Health(c *gin.Context) {
 	checker := hc.App.GetHealthChecker()
 
 	healthy, errors := checker.IsHealthy()
+	drain, draining := hc.drainCheck()
 
-	if !healthy {
+	if draining {
+		status = http.StatusServiceUnavailable
+	} else if !healthy {
 		status = http.StatusMultiStatus
 	}
 
@@ -102,6 +115,9 @@ func (hc *HealthController) Health(c *gin.Context) {
 			Output: output,
 		})
 	}
+	if draining {
+		checks = append(checks, drain)
+	}
 
 	switch c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML, gin.MIMEPlain) {
 	case gin.MIMEJSON:
@@ -126,6 +142,21 @@ func (hc *HealthController) Health(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, checks, "checks", status)
 }
 
+// drainCheck returns the check reporting the drain mode of the node, and whether it is draining.
+func (hc *HealthController) drainCheck() (presenters.Check, bool) {
+	drain := hc.App.DrainStatus()
+	if drain.State == chainlink.DrainStateActive {
+		return presenters.Check{}, false
+	}
+	output := fmt.Sprintf("%s, %d pipeline runs in flight, deadline %s", drain.State, drain.InFlightRuns, drain.Deadline.Format(time.RFC3339))
+	return presenters.Check{
+		JAID:   presenters.NewJAID(drainCheckName),
+		Name:   drainCheckName,
+		Status: HealthStatusDraining,
+		Output: output,
+	}, true
+}
+
 func writeTextTo(w io.Writer, checks []presenters.Check) error {
 	slices.SortFunc(checks, presenters.CmpCheckName)
 	for _, ch := range checks {
@@ -135,6 +166,8 @@ func writeTextTo(w io.Writer, checks []presenters.Check) error {
 			status = "ok "
 		case HealthStatusFailing:
 			status = "!  "
+		case HealthStatusDraining:
+			status = "~  "
 		}
 		if _, err := fmt.Fprintf(w, "%s%s\n", status, ch.Name); err != nil {
 			return err
@@ -217,6 +250,13 @@ func (t checkTree) WriteHTMLTo(w io.Writer) error {
         font-size:small;
         text-transform: uppercase;
     }
+    .draining:after {
+        color: orange;
+        content: " - (Draining)";
+        font-weight: bold;
+        font-size:small;
+        text-transform: uppercase;
+    }
     summary.noexpand::marker {
         color: rgba(100,101,10,0);
     }
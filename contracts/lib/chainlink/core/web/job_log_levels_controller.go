@@ -0,0 +1,152 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/loglevel"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobLogLevelsController manages the log level overrides of jobs and job types.
+type JobLogLevelsController struct {
+	App chainlink.Application
+}
+
+// JobLogLevelRequest sets the log level of a job, or of all the jobs of a type. The override is kept until it is
+// cleared, or for Duration if given.
+type JobLogLevelRequest struct {
+	JobID    *int32  `json:"jobID"`
+	JobType  *string `json:"jobType"`
+	Level    string  `json:"level"`
+	Duration string  `json:"duration"`
+}
+
+// Index lists the log level overrides which have not expired, of the jobs and job types the user may read.
+// Example:
+// "GET <application>/log/jobs"
+func (jc *JobLogLevelsController) Index(c *gin.Context) {
+	overrides, err := jc.App.LogLevelOverrides().Overrides(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if !auth.BaseRoleAllows(c, rbac.JobsRead) {
+		overrides, err = jc.readableOverrides(c, overrides)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	jsonAPIResponse(c, presenters.NewLogLevelOverrideResources(overrides), "logLevelOverrides")
+}
+
+// readableOverrides returns the overrides of the jobs and job types the user is granted rbac.JobsRead for. The
+// overrides of jobs which do not exist anymore are left out.
+func (jc *JobLogLevelsController) readableOverrides(c *gin.Context, overrides []loglevel.Override) ([]loglevel.Override, error) {
+	authz, err := auth.GetAuthorization(c)
+	if err != nil {
+		return nil, err
+	}
+	var readable []loglevel.Override
+	for _, o := range overrides {
+		var job rbac.Job
+		if o.JobID != nil {
+			jb, err := jc.App.JobORM().FindJob(c.Request.Context(), *o.JobID)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			job = rbacJob(jb)
+		} else {
+			job = rbac.Job{Type: *o.JobType}
+		}
+		if authz.AllowsJob(rbac.JobsRead, job) {
+			readable = append(readable, o)
+		}
+	}
+	return readable, nil
+}
+
+// Create sets the log level of a job or job type, replacing its current override.
+// Example:
+// "POST <application>/log/jobs"
+func (jc *JobLogLevelsController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := &JobLogLevelRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	override := loglevel.Override{JobID: request.JobID, JobType: request.JobType, Level: request.Level, CreatedAt: time.Now()}
+	if request.Duration != "" {
+		d, err := time.ParseDuration(request.Duration)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid duration"))
+			return
+		}
+		override.ExpiresAt = null.TimeFrom(override.CreatedAt.Add(d))
+	}
+	if err := override.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if override.JobID != nil {
+		if _, err := jc.App.JobORM().FindJob(ctx, *override.JobID); err != nil {
+			if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+				jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			} else {
+				jsonAPIError(c, http.StatusInternalServerError, err)
+			}
+			return
+		}
+	}
+
+	if err := jc.App.LogLevelOverrides().SetOverride(ctx, &override); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobLogLevelSet, map[string]interface{}{
+		"jobID":     override.JobID,
+		"jobType":   override.JobType,
+		"level":     override.Level,
+		"expiresAt": override.ExpiresAt,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewLogLevelOverrideResource(override), "logLevelOverride", http.StatusCreated)
+}
+
+// Delete clears a log level override, restoring the global log level for its job or job type.
+// Example:
+// "DELETE <application>/log/jobs/:id"
+func (jc *JobLogLevelsController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err = jc.App.LogLevelOverrides().DeleteOverride(c.Request.Context(), id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("log level override not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobLogLevelCleared, map[string]interface{}{"id": id})
+	jsonAPIResponseWithStatus(c, nil, "logLevelOverride", http.StatusNoContent)
+}
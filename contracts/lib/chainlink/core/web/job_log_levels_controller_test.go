@@ -0,0 +1,60 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobLogLevelsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/log/jobs", bytes.NewBufferString(`{"jobID": 1000, "level": "debug"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/log/jobs", bytes.NewBufferString(`{"jobType": "cron", "jobID": 1000, "level": "debug"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/log/jobs", bytes.NewBufferString(`{"jobType": "cron", "level": "debug", "duration": "1h"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var created presenters.LogLevelOverrideResource
+	cltest.ParseJSONAPIResponse(t, resp, &created)
+	assert.Equal(t, "debug", created.Level)
+	require.NotNil(t, created.ExpiresAt)
+
+	resp, cleanup = client.Get("/v2/log/jobs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var overrides []presenters.LogLevelOverrideResource
+	cltest.ParseJSONAPIResponse(t, resp, &overrides)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, "cron", *overrides[0].JobType)
+
+	viewer := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+	resp, cleanup = viewer.Delete("/v2/log/jobs/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = client.Delete("/v2/log/jobs/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/log/jobs/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
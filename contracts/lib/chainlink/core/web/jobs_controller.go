@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -32,6 +33,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -49,7 +52,14 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 		size = 1000
 	}
 
-	jobs, count, err := jc.App.JobORM().FindJobs(c.Request.Context(), offset, size)
+	var jobs []job.Job
+	var count int
+	var err error
+	if auth.BaseRoleAllows(c, rbac.JobsRead) {
+		jobs, count, err = jc.App.JobORM().FindJobs(c.Request.Context(), offset, size)
+	} else {
+		jobs, count, err = jc.readableJobs(c, offset, size)
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -89,9 +99,30 @@ func (jc *JobsController) Show(c *gin.Context) {
 		return
 	}
 
+	if !auth.AuthorizeJob(c, rbac.JobsRead, rbacJob(jobSpec)) {
+		return
+	}
+
 	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
 }
 
+// readableJobs returns the page of the jobs the user is granted rbac.JobsRead for, and their count.
+func (jc *JobsController) readableJobs(c *gin.Context, offset, size int) ([]job.Job, int, error) {
+	authz, err := auth.GetAuthorization(c)
+	if err != nil {
+		return nil, 0, err
+	}
+	var scopes [][]job.JobScope
+	for _, grants := range authz.JobScopes(rbac.JobsRead) {
+		set := make([]job.JobScope, 0, len(grants))
+		for _, g := range grants {
+			set = append(set, job.JobScope{IDs: g.JobIDs, Types: g.JobTypes, Tags: g.JobTags})
+		}
+		scopes = append(scopes, set)
+	}
+	return jc.App.JobORM().FindJobsInScopes(c.Request.Context(), scopes, offset, size)
+}
+
 // CreateJobRequest represents a request to create and start a job (V2).
 type CreateJobRequest struct {
 	TOML string `json:"toml"`
@@ -112,6 +143,9 @@ func (jc *JobsController) Create(c *gin.Context) {
 		jsonAPIError(c, status, err)
 		return
 	}
+	if !auth.AuthorizeJob(c, rbac.JobsCreate, rbacJob(jb)) {
+		return
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -145,6 +179,11 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	if !authorizeJob(c, rbac.JobsDelete, func(ctx context.Context) (job.Job, error) {
+		return jc.App.JobORM().FindJobWithoutSpecErrors(ctx, j.ID)
+	}) {
+		return
+	}
 
 	// Delete the job
 	err = jc.App.DeleteJob(c.Request.Context(), j.ID)
@@ -187,6 +226,12 @@ func (jc *JobsController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	// Both the job being replaced and its replacement must be in the scope of the user
+	if !authorizeJob(c, rbac.JobsUpdate, func(ctx context.Context) (job.Job, error) {
+		return jc.App.JobORM().FindJobWithoutSpecErrors(ctx, jb.ID)
+	}) || !auth.AuthorizeJob(c, rbac.JobsUpdate, rbacJob(jb)) {
+		return
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -241,6 +286,8 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.Cron:
 		jb, err = cron.ValidatedCronSpec(tomlString)
+	case job.EVMLog:
+		jb, err = evmlog.ValidatedEVMLogSpec(tomlString)
 	case job.VRF:
 		jb, err = vrfcommon.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
@@ -270,3 +317,26 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	}
 	return jb, 0, nil
 }
+
+// authorizeJob checks the authenticated user is granted the permission for the job returned by
+// findJob. The job is only looked up when the base role of the user is not enough.
+func authorizeJob(c *gin.Context, permission rbac.Permission, findJob func(ctx context.Context) (job.Job, error)) bool {
+	if auth.BaseRoleAllows(c, permission) {
+		return true
+	}
+	jb, err := findJob(c.Request.Context())
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+	return auth.AuthorizeJob(c, permission, rbacJob(jb))
+}
+
+// rbacJob returns what job scoped grants are matched against.
+func rbacJob(jb job.Job) rbac.Job {
+	return rbac.Job{ID: jb.ID, Type: jb.Type.String(), Tags: jb.Tags}
+}
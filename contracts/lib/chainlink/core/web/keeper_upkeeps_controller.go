@@ -0,0 +1,113 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeeperUpkeepsController exposes the upkeeps of keeper jobs for debugging.
+type KeeperUpkeepsController struct {
+	App chainlink.Application
+}
+
+// Index lists the upkeeps of a keeper job with their last check and turn eligibility.
+// Example:
+// "GET <application>/jobs/:ID/upkeeps"
+func (kc *KeeperUpkeepsController) Index(c *gin.Context) {
+	debugger, ok := kc.upkeepDebugger(c, rbac.JobsRead)
+	if !ok {
+		return
+	}
+
+	statuses, err := debugger.Upkeeps(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewKeeperUpkeepResources(statuses), "keeper_upkeeps")
+}
+
+// Check runs a one-off simulated check of an upkeep, optionally at the block given by the block query param.
+// Example:
+// "POST <application>/jobs/:ID/upkeeps/:upkeepID/check?block=123"
+func (kc *KeeperUpkeepsController) Check(c *gin.Context) {
+	upkeepID, ok := keeper.ParseUpkeepId(c.Param("upkeepID"))
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid upkeep ID: %s", c.Param("upkeepID")))
+		return
+	}
+
+	var blockNumber int64
+	if block := c.Query("block"); block != "" {
+		var err error
+		blockNumber, err = strconv.ParseInt(block, 10, 64)
+		if err != nil || blockNumber < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid block number: %s", block))
+			return
+		}
+	}
+
+	debugger, ok := kc.upkeepDebugger(c, rbac.JobsRun)
+	if !ok {
+		return
+	}
+
+	check, err := debugger.SimulateCheck(c.Request.Context(), ubig.New(upkeepID), blockNumber)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewKeeperUpkeepCheckResource(upkeepID.String(), check), "keeper_upkeep_checks")
+}
+
+// upkeepDebugger returns the debugger of the keeper job given by the ID param, if the user is granted the permission
+// for it.
+func (kc *KeeperUpkeepsController) upkeepDebugger(c *gin.Context, permission rbac.Permission) (*keeper.UpkeepDebugger, bool) {
+	ctx := c.Request.Context()
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return nil, false
+	}
+	jb, err := kc.App.JobORM().FindJob(ctx, jb.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return nil, false
+	}
+	if !auth.AuthorizeJob(c, permission, rbacJob(jb)) {
+		return nil, false
+	}
+	if jb.KeeperSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a keeper job", jb.ID))
+		return nil, false
+	}
+
+	chain, err := getChain(kc.App.GetRelayers().LegacyEVMChains(), jb.KeeperSpec.EVMChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return nil, false
+	}
+
+	lggr := kc.App.GetLogger()
+	effectiveKeeperAddress := keeper.EffectiveKeeperAddress(ctx, chain, jb, lggr)
+	debugger, err := keeper.NewUpkeepDebugger(jb, keeper.NewORM(kc.App.GetDB(), lggr), chain.Client(), kc.App.GetConfig().Keeper(), effectiveKeeperAddress)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return debugger, true
+}
@@ -0,0 +1,60 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyusage"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeyUsageController queries the log of the signatures produced with the keys of the keystore,
+// which is only populated when AuditLogger.KeyUsageStore is enabled.
+type KeyUsageController struct {
+	App chainlink.Application
+}
+
+// Index lists the recorded signatures, most recent first. They can be filtered by keyType, keyID,
+// subsystem, jobID, and by time with since and until, in RFC 3339 format.
+// Example:
+// "GET <application>/keys/usage?keyType=EVM&since=2025-01-01T00:00:00Z"
+func (kuc *KeyUsageController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseKeyUsageFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	entries, count, err := kuc.App.KeyUsageORM().FindEntries(c.Request.Context(), filter, offset, size)
+	paginatedResponse(c, "KeyUsages", size, page, presenters.NewKeyUsageResources(entries), count, err)
+}
+
+func parseKeyUsageFilter(c *gin.Context) (filter keyusage.Filter, err error) {
+	filter.KeyType = c.Query("keyType")
+	filter.KeyID = c.Query("keyID")
+	filter.Subsystem = c.Query("subsystem")
+	if s := c.Query("jobID"); s != "" {
+		id, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid jobID")
+		}
+		jobID := int32(id)
+		filter.JobID = &jobID
+	}
+	if s := c.Query("since"); s != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, s); err != nil {
+			return filter, errors.Wrap(err, "invalid since")
+		}
+	}
+	if s := c.Query("until"); s != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, s); err != nil {
+			return filter, errors.Wrap(err, "invalid until")
+		}
+	}
+	return filter, nil
+}
@@ -0,0 +1,59 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeyUsageController_Index(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.AuditLogger.KeyUsageStore = ptr(true)
+	}))
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	require.NoError(t, app.GetKeyStore().CSA().EnsureKey(ctx))
+	keys, err := app.GetKeyStore().CSA().GetAll()
+	require.NoError(t, err)
+	signer := keystore.CSASigner{CSA: app.GetKeyStore().CSA(), Subsystem: "JobDistributor"}
+	_, err = signer.Sign(ctx, keys[0].ID(), []byte("message"))
+	require.NoError(t, err)
+
+	var resources []presenters.KeyUsageResource
+	require.Eventually(t, func() bool {
+		resp, cleanup := client.Get("/v2/keys/usage?keyType=CSA&subsystem=JobDistributor")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		resources = nil
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		return len(resources) == 1
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+	assert.Equal(t, keys[0].ID(), resources[0].KeyID)
+	assert.Nil(t, resources[0].JobID)
+	assert.Len(t, resources[0].PayloadHash, 64)
+
+	resp, cleanup := client.Get("/v2/keys/usage?subsystem=OCR2")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	resources = nil
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+	assert.Empty(t, resources)
+
+	resp, cleanup = client.Get("/v2/keys/usage?since=yesterday")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -50,6 +52,11 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 			jsonAPIError(c, http.StatusUnprocessableEntity, err)
 			return
 		}
+		if !authorizeJob(c, rbac.JobsRead, func(ctx context.Context) (job.Job, error) {
+			return prc.App.JobORM().FindJobWithoutSpecErrors(ctx, jobSpec.ID)
+		}) {
+			return
+		}
 
 		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(ctx, &jobSpec.ID, offset, size)
 	}
@@ -80,6 +87,23 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	if !auth.BaseRoleAllows(c, rbac.JobsRead) {
+		// The run is only shown for the job it belongs to, which the user must be granted
+		jobSpec := job.Job{}
+		if err = jobSpec.SetID(c.Param("ID")); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		if pipelineRun.PipelineSpec.JobID != jobSpec.ID {
+			jsonAPIError(c, http.StatusNotFound, errors.New("run not found"))
+			return
+		}
+		if !authorizeJob(c, rbac.JobsRead, func(ctx context.Context) (job.Job, error) {
+			return prc.App.JobORM().FindJobWithoutSpecErrors(ctx, jobSpec.ID)
+		}) {
+			return
+		}
+	}
 
 	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
 	jsonAPIResponse(c, res, "pipelineRun")
@@ -114,6 +138,11 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	// Is it a UUID? Then process it as a webhook job
 	jobUUID, err := uuid.Parse(idStr)
 	if err == nil {
+		if isUser && !authorizeJob(c, rbac.JobsRun, func(ctx context.Context) (job.Job, error) {
+			return prc.App.JobORM().FindJobByExternalJobID(ctx, jobUUID)
+		}) {
+			return
+		}
 		canRun, err2 := authorizer.CanRun(ctx, prc.App.GetConfig().JobPipeline(), jobUUID)
 		if err2 != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err2)
@@ -124,6 +153,9 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, pipeline.ErrDraining) {
+				jsonAPIError(c, http.StatusServiceUnavailable, err3)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
@@ -142,8 +174,16 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 		jobID64, err := strconv.ParseInt(idStr, 10, 32)
 		if err == nil {
 			jobID = int32(jobID64)
+			if !authorizeJob(c, rbac.JobsRun, func(ctx context.Context) (job.Job, error) {
+				return prc.App.JobORM().FindJobWithoutSpecErrors(ctx, jobID)
+			}) {
+				return
+			}
 			jobRunID, err := prc.App.RunJobV2(ctx, jobID, nil)
-			if err != nil {
+			if errors.Is(err, pipeline.ErrDraining) {
+				jsonAPIError(c, http.StatusServiceUnavailable, err)
+				return
+			} else if err != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err)
 				return
 			}
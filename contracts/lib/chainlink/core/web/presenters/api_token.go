@@ -0,0 +1,89 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+// APITokenResource represents a named API token JSONAPI resource. Its credentials are never
+// included.
+type APITokenResource struct {
+	JAID
+	Name       string       `json:"name"`
+	UserEmail  string       `json:"userEmail"`
+	AccessKey  string       `json:"accessKey"`
+	Grants     []rbac.Grant `json:"grants"`
+	ExpiresAt  *time.Time   `json:"expiresAt"`
+	LastUsedAt *time.Time   `json:"lastUsedAt"`
+	CreatedAt  time.Time    `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "apiTokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource.
+//
+// Token names are unique per user, so the name is used as the ID
+func NewAPITokenResource(token apitokens.Token) *APITokenResource {
+	grants := []rbac.Grant(token.Grants)
+	if grants == nil {
+		grants = []rbac.Grant{}
+	}
+	return &APITokenResource{
+		JAID:       NewJAID(token.Name),
+		Name:       token.Name,
+		UserEmail:  token.UserEmail,
+		AccessKey:  token.TokenKey,
+		Grants:     grants,
+		ExpiresAt:  token.ExpiresAt.Ptr(),
+		LastUsedAt: token.LastUsedAt.Ptr(),
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+// NewAPITokenResources constructs a list of APITokenResource.
+func NewAPITokenResources(tokens []apitokens.Token) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, token := range tokens {
+		rs = append(rs, *NewAPITokenResource(token))
+	}
+	return rs
+}
+
+// CreatedAPITokenResource represents a newly created named API token, including the secret
+// which cannot be retrieved afterwards.
+type CreatedAPITokenResource struct {
+	JAID
+	Name      string       `json:"name"`
+	UserEmail string       `json:"userEmail"`
+	AccessKey string       `json:"accessKey"`
+	Secret    string       `json:"secret"`
+	Grants    []rbac.Grant `json:"grants"`
+	ExpiresAt *time.Time   `json:"expiresAt"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CreatedAPITokenResource) GetName() string {
+	return "apiTokens"
+}
+
+// NewCreatedAPITokenResource constructs a new CreatedAPITokenResource.
+func NewCreatedAPITokenResource(token apitokens.Token, credentials *auth.Token) *CreatedAPITokenResource {
+	r := NewAPITokenResource(token)
+	return &CreatedAPITokenResource{
+		JAID:      r.JAID,
+		Name:      r.Name,
+		UserEmail: r.UserEmail,
+		AccessKey: credentials.AccessKey,
+		Secret:    credentials.Secret,
+		Grants:    r.Grants,
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
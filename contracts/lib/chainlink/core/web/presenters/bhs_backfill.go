@@ -0,0 +1,68 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
+)
+
+// BHSBackfillTaskResource represents a BlockhashStore coverage report and backfill task JSONAPI resource. The
+// coverage fields are only set once the task finished.
+type BHSBackfillTaskResource struct {
+	JAID
+	EVMChainID          string     `json:"evmChainID"`
+	BHSAddress          string     `json:"bhsAddress"`
+	DryRun              bool       `json:"dryRun"`
+	State               string     `json:"state"`
+	Error               string     `json:"error,omitempty"`
+	StartedAt           time.Time  `json:"startedAt"`
+	FinishedAt          *time.Time `json:"finishedAt"`
+	FromBlock           uint64     `json:"fromBlock"`
+	ToBlock             uint64     `json:"toBlock"`
+	LatestBlock         uint64     `json:"latestBlock"`
+	RequestBlocks       int        `json:"requestBlocks"`
+	StoredBlocks        int        `json:"storedBlocks"`
+	MissingBlocks       []uint64   `json:"missingBlocks"`
+	UnfulfilledRequests int        `json:"unfulfilledRequests"`
+	Covered             float64    `json:"covered"`
+	AnchorBlock         *uint64    `json:"anchorBlock"`
+	BlocksToStore       int        `json:"blocksToStore"`
+	Batches             int        `json:"batches"`
+	EstimatedGas        uint64     `json:"estimatedGas"`
+	Enqueued            int        `json:"enqueued"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BHSBackfillTaskResource) GetName() string {
+	return "bhs_backfill_tasks"
+}
+
+// NewBHSBackfillTaskResource returns a new BHSBackfillTaskResource.
+func NewBHSBackfillTaskResource(task blockheaderfeeder.BackfillTask) *BHSBackfillTaskResource {
+	r := &BHSBackfillTaskResource{
+		JAID:       NewJAID(task.ID),
+		EVMChainID: task.EVMChainID,
+		BHSAddress: task.BHSAddress.Hex(),
+		DryRun:     task.DryRun,
+		State:      string(task.State),
+		Error:      task.Error,
+		StartedAt:  task.StartedAt,
+		FinishedAt: task.FinishedAt,
+		Enqueued:   task.Enqueued,
+	}
+	if report := task.Report; report != nil {
+		r.FromBlock = report.FromBlock
+		r.ToBlock = report.ToBlock
+		r.LatestBlock = report.LatestBlock
+		r.RequestBlocks = report.RequestBlocks
+		r.StoredBlocks = report.StoredBlocks
+		r.MissingBlocks = report.MissingBlocks
+		r.UnfulfilledRequests = report.UnfulfilledRequests
+		r.Covered = report.Covered()
+		r.AnchorBlock = report.AnchorBlock
+		r.BlocksToStore = report.BlocksToStore
+		r.Batches = report.Batches
+		r.EstimatedGas = report.EstimatedGas
+	}
+	return r
+}
@@ -0,0 +1,34 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ConfigReloadResource represents the outcome of a configuration reload JSONAPI resource.
+type ConfigReloadResource struct {
+	JAID
+	Applied         []string `json:"applied"`
+	RestartRequired []string `json:"restartRequired"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ConfigReloadResource) GetName() string {
+	return "configReloads"
+}
+
+// NewConfigReloadResource constructs a new ConfigReloadResource.
+func NewConfigReloadResource(reload chainlink.ConfigReload) *ConfigReloadResource {
+	r := &ConfigReloadResource{
+		JAID:            NewJAID(utils.NewBytes32ID()),
+		Applied:         reload.Applied,
+		RestartRequired: reload.RestartRequired,
+	}
+	if r.Applied == nil {
+		r.Applied = []string{}
+	}
+	if r.RestartRequired == nil {
+		r.RestartRequired = []string{}
+	}
+	return r
+}
@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+// CustomRoleResource represents a custom role JSONAPI resource.
+type CustomRoleResource struct {
+	JAID
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Grants      []rbac.Grant `json:"grants"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CustomRoleResource) GetName() string {
+	return "customRoles"
+}
+
+// NewCustomRoleResource constructs a new CustomRoleResource.
+//
+// The role name is unique, so it is used as the ID
+func NewCustomRoleResource(role rbac.Role) *CustomRoleResource {
+	grants := []rbac.Grant(role.Grants)
+	if grants == nil {
+		grants = []rbac.Grant{}
+	}
+	return &CustomRoleResource{
+		JAID:        NewJAID(role.Name),
+		Name:        role.Name,
+		Description: role.Description,
+		Grants:      grants,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// NewCustomRoleResources constructs a list of CustomRoleResource.
+func NewCustomRoleResources(roles []rbac.Role) []CustomRoleResource {
+	rs := []CustomRoleResource{}
+	for _, role := range roles {
+		rs = append(rs, *NewCustomRoleResource(role))
+	}
+	return rs
+}
@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// DrainResource represents the drain mode of the node JSONAPI resource.
+type DrainResource struct {
+	JAID
+	State        string     `json:"state"`
+	StartedAt    *time.Time `json:"startedAt"`
+	Deadline     *time.Time `json:"deadline"`
+	TimedOut     bool       `json:"timedOut"`
+	InFlightRuns int        `json:"inFlightRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DrainResource) GetName() string {
+	return "drains"
+}
+
+// NewDrainResource constructs a new DrainResource.
+func NewDrainResource(s chainlink.DrainStatus) *DrainResource {
+	r := &DrainResource{
+		JAID:         NewJAID("drain"),
+		State:        string(s.State),
+		TimedOut:     s.TimedOut,
+		InFlightRuns: s.InFlightRuns,
+	}
+	if !s.StartedAt.IsZero() {
+		r.StartedAt, r.Deadline = &s.StartedAt, &s.Deadline
+	}
+	return r
+}
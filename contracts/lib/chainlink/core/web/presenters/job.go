@@ -33,6 +33,7 @@ const (
 	OffChainReportingJobSpec    JobSpecType = "offchainreporting"
 	KeeperJobSpec               JobSpecType = "keeper"
 	CronJobSpec                 JobSpecType = "cron"
+	EVMLogJobSpec               JobSpecType = "evmlog"
 	VRFJobSpec                  JobSpecType = "vrf"
 	WebhookJobSpec              JobSpecType = "webhook"
 	BlockhashStoreJobSpec       JobSpecType = "blockhashstore"
@@ -272,6 +273,34 @@ func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	}
 }
 
+// EVMLogSpec defines the spec details of an EVMLog Job
+type EVMLogSpec struct {
+	ContractAddress  types.EIP55Address     `json:"contractAddress"`
+	EventABI         string                 `json:"eventABI"`
+	TopicFilters     job.EVMLogTopicFilters `json:"topicFilters"`
+	MinConfirmations clnull.Uint32          `json:"minConfirmations"`
+	LookbackBlocks   int32                  `json:"lookbackBlocks"`
+	PollPeriod       commonconfig.Duration  `json:"pollPeriod"`
+	CreatedAt        time.Time              `json:"createdAt"`
+	UpdatedAt        time.Time              `json:"updatedAt"`
+	EVMChainID       *big.Big               `json:"evmChainID"`
+}
+
+// NewEVMLogSpec generates a new EVMLogSpec from a job.EVMLogSpec
+func NewEVMLogSpec(spec *job.EVMLogSpec) *EVMLogSpec {
+	return &EVMLogSpec{
+		ContractAddress:  spec.ContractAddress,
+		EventABI:         spec.EventABI,
+		TopicFilters:     spec.TopicFilters,
+		MinConfirmations: spec.MinConfirmations,
+		LookbackBlocks:   spec.LookbackBlocks,
+		PollPeriod:       *commonconfig.MustNewDuration(spec.PollPeriod),
+		CreatedAt:        spec.CreatedAt,
+		UpdatedAt:        spec.UpdatedAt,
+		EVMChainID:       spec.EVMChainID,
+	}
+}
+
 type VRFSpec struct {
 	BatchCoordinatorAddress       *types.EIP55Address   `json:"batchCoordinatorAddress"`
 	BatchFulfillmentEnabled       bool                  `json:"batchFulfillmentEnabled"`
@@ -320,21 +349,22 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 
 // BlockhashStoreSpec defines the job parameters for a blockhash store feeder job.
 type BlockhashStoreSpec struct {
-	CoordinatorV1Address           *types.EIP55Address  `json:"coordinatorV1Address"`
-	CoordinatorV2Address           *types.EIP55Address  `json:"coordinatorV2Address"`
-	CoordinatorV2PlusAddress       *types.EIP55Address  `json:"coordinatorV2PlusAddress"`
-	WaitBlocks                     int32                `json:"waitBlocks"`
-	LookbackBlocks                 int32                `json:"lookbackBlocks"`
-	HeartbeatPeriod                time.Duration        `json:"heartbeatPeriod"`
-	BlockhashStoreAddress          types.EIP55Address   `json:"blockhashStoreAddress"`
-	TrustedBlockhashStoreAddress   *types.EIP55Address  `json:"trustedBlockhashStoreAddress"`
-	TrustedBlockhashStoreBatchSize int32                `json:"trustedBlockhashStoreBatchSize"`
-	PollPeriod                     time.Duration        `json:"pollPeriod"`
-	RunTimeout                     time.Duration        `json:"runTimeout"`
-	EVMChainID                     *big.Big             `json:"evmChainID"`
-	FromAddresses                  []types.EIP55Address `json:"fromAddresses"`
-	CreatedAt                      time.Time            `json:"createdAt"`
-	UpdatedAt                      time.Time            `json:"updatedAt"`
+	CoordinatorV1Address           *types.EIP55Address        `json:"coordinatorV1Address"`
+	CoordinatorV2Address           *types.EIP55Address        `json:"coordinatorV2Address"`
+	CoordinatorV2PlusAddress       *types.EIP55Address        `json:"coordinatorV2PlusAddress"`
+	WaitBlocks                     int32                      `json:"waitBlocks"`
+	LookbackBlocks                 int32                      `json:"lookbackBlocks"`
+	HeartbeatPeriod                time.Duration              `json:"heartbeatPeriod"`
+	BlockhashStoreAddress          types.EIP55Address         `json:"blockhashStoreAddress"`
+	TrustedBlockhashStoreAddress   *types.EIP55Address        `json:"trustedBlockhashStoreAddress"`
+	TrustedBlockhashStoreBatchSize int32                      `json:"trustedBlockhashStoreBatchSize"`
+	PollPeriod                     time.Duration              `json:"pollPeriod"`
+	RunTimeout                     time.Duration              `json:"runTimeout"`
+	EVMChainID                     *big.Big                   `json:"evmChainID"`
+	FromAddresses                  []types.EIP55Address       `json:"fromAddresses"`
+	AdditionalStores               []job.BlockhashStoreTarget `json:"additionalStores"`
+	CreatedAt                      time.Time                  `json:"createdAt"`
+	UpdatedAt                      time.Time                  `json:"updatedAt"`
 }
 
 // NewBlockhashStoreSpec creates a new BlockhashStoreSpec for the given parameters.
@@ -353,24 +383,26 @@ func NewBlockhashStoreSpec(spec *job.BlockhashStoreSpec) *BlockhashStoreSpec {
 		RunTimeout:                     spec.RunTimeout,
 		EVMChainID:                     spec.EVMChainID,
 		FromAddresses:                  spec.FromAddresses,
+		AdditionalStores:               spec.AdditionalStores,
 	}
 }
 
 // BlockHeaderFeederSpec defines the job parameters for a blcok header feeder job.
 type BlockHeaderFeederSpec struct {
-	CoordinatorV1Address       *types.EIP55Address  `json:"coordinatorV1Address"`
-	CoordinatorV2Address       *types.EIP55Address  `json:"coordinatorV2Address"`
-	CoordinatorV2PlusAddress   *types.EIP55Address  `json:"coordinatorV2PlusAddress"`
-	WaitBlocks                 int32                `json:"waitBlocks"`
-	LookbackBlocks             int32                `json:"lookbackBlocks"`
-	BlockhashStoreAddress      types.EIP55Address   `json:"blockhashStoreAddress"`
-	BatchBlockhashStoreAddress types.EIP55Address   `json:"batchBlockhashStoreAddress"`
-	PollPeriod                 time.Duration        `json:"pollPeriod"`
-	RunTimeout                 time.Duration        `json:"runTimeout"`
-	EVMChainID                 *big.Big             `json:"evmChainID"`
-	FromAddresses              []types.EIP55Address `json:"fromAddresses"`
-	GetBlockhashesBatchSize    uint16               `json:"getBlockhashesBatchSize"`
-	StoreBlockhashesBatchSize  uint16               `json:"storeBlockhashesBatchSize"`
+	CoordinatorV1Address       *types.EIP55Address        `json:"coordinatorV1Address"`
+	CoordinatorV2Address       *types.EIP55Address        `json:"coordinatorV2Address"`
+	CoordinatorV2PlusAddress   *types.EIP55Address        `json:"coordinatorV2PlusAddress"`
+	WaitBlocks                 int32                      `json:"waitBlocks"`
+	LookbackBlocks             int32                      `json:"lookbackBlocks"`
+	BlockhashStoreAddress      types.EIP55Address         `json:"blockhashStoreAddress"`
+	BatchBlockhashStoreAddress types.EIP55Address         `json:"batchBlockhashStoreAddress"`
+	PollPeriod                 time.Duration              `json:"pollPeriod"`
+	RunTimeout                 time.Duration              `json:"runTimeout"`
+	EVMChainID                 *big.Big                   `json:"evmChainID"`
+	FromAddresses              []types.EIP55Address       `json:"fromAddresses"`
+	GetBlockhashesBatchSize    uint16                     `json:"getBlockhashesBatchSize"`
+	StoreBlockhashesBatchSize  uint16                     `json:"storeBlockhashesBatchSize"`
+	AdditionalStores           []job.BlockhashStoreTarget `json:"additionalStores"`
 
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
@@ -392,6 +424,7 @@ func NewBlockHeaderFeederSpec(spec *job.BlockHeaderFeederSpec) *BlockHeaderFeede
 		FromAddresses:              spec.FromAddresses,
 		GetBlockhashesBatchSize:    spec.GetBlockhashesBatchSize,
 		StoreBlockhashesBatchSize:  spec.StoreBlockhashesBatchSize,
+		AdditionalStores:           spec.AdditionalStores,
 	}
 }
 
@@ -514,46 +547,55 @@ func NewJobError(e job.SpecError) JobError {
 // JobResource represents a JobResource
 type JobResource struct {
 	JAID
-	Name                     string                    `json:"name"`
-	StreamID                 *uint32                   `json:"streamID,omitempty"`
-	Type                     JobSpecType               `json:"type"`
-	SchemaVersion            uint32                    `json:"schemaVersion"`
-	GasLimit                 clnull.Uint32             `json:"gasLimit"`
-	ForwardingAllowed        bool                      `json:"forwardingAllowed"`
-	MaxTaskDuration          models.Interval           `json:"maxTaskDuration"`
-	ExternalJobID            uuid.UUID                 `json:"externalJobID"`
-	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
-	FluxMonitorSpec          *FluxMonitorSpec          `json:"fluxMonitorSpec"`
-	CronSpec                 *CronSpec                 `json:"cronSpec"`
-	OffChainReportingSpec    *OffChainReportingSpec    `json:"offChainReportingOracleSpec"`
-	OffChainReporting2Spec   *OffChainReporting2Spec   `json:"offChainReporting2OracleSpec"`
-	KeeperSpec               *KeeperSpec               `json:"keeperSpec"`
-	VRFSpec                  *VRFSpec                  `json:"vrfSpec"`
-	WebhookSpec              *WebhookSpec              `json:"webhookSpec"`
-	BlockhashStoreSpec       *BlockhashStoreSpec       `json:"blockhashStoreSpec"`
-	BlockHeaderFeederSpec    *BlockHeaderFeederSpec    `json:"blockHeaderFeederSpec"`
-	BootstrapSpec            *BootstrapSpec            `json:"bootstrapSpec"`
-	GatewaySpec              *GatewaySpec              `json:"gatewaySpec"`
-	WorkflowSpec             *WorkflowSpec             `json:"workflowSpec"`
-	StandardCapabilitiesSpec *StandardCapabilitiesSpec `json:"standardCapabilitiesSpec"`
-	CCIPSpec                 *CCIPSpec                 `json:"ccipSpec"`
-	PipelineSpec             PipelineSpec              `json:"pipelineSpec"`
-	Errors                   []JobError                `json:"errors"`
+	Name                         string                    `json:"name"`
+	Tags                         []string                  `json:"tags,omitempty"`
+	StreamID                     *uint32                   `json:"streamID,omitempty"`
+	Type                         JobSpecType               `json:"type"`
+	SchemaVersion                uint32                    `json:"schemaVersion"`
+	GasLimit                     clnull.Uint32             `json:"gasLimit"`
+	ForwardingAllowed            bool                      `json:"forwardingAllowed"`
+	MaxTaskDuration              models.Interval           `json:"maxTaskDuration"`
+	ExpectedRunInterval          models.Interval           `json:"expectedRunInterval,omitempty"`
+	MaxTimeBetweenSuccessfulRuns models.Interval           `json:"maxTimeBetweenSuccessfulRuns,omitempty"`
+	MaxConsecutiveErrors         uint32                    `json:"maxConsecutiveErrors,omitempty"`
+	ExternalJobID                uuid.UUID                 `json:"externalJobID"`
+	DirectRequestSpec            *DirectRequestSpec        `json:"directRequestSpec"`
+	FluxMonitorSpec              *FluxMonitorSpec          `json:"fluxMonitorSpec"`
+	CronSpec                     *CronSpec                 `json:"cronSpec"`
+	EVMLogSpec                   *EVMLogSpec               `json:"evmLogSpec"`
+	OffChainReportingSpec        *OffChainReportingSpec    `json:"offChainReportingOracleSpec"`
+	OffChainReporting2Spec       *OffChainReporting2Spec   `json:"offChainReporting2OracleSpec"`
+	KeeperSpec                   *KeeperSpec               `json:"keeperSpec"`
+	VRFSpec                      *VRFSpec                  `json:"vrfSpec"`
+	WebhookSpec                  *WebhookSpec              `json:"webhookSpec"`
+	BlockhashStoreSpec           *BlockhashStoreSpec       `json:"blockhashStoreSpec"`
+	BlockHeaderFeederSpec        *BlockHeaderFeederSpec    `json:"blockHeaderFeederSpec"`
+	BootstrapSpec                *BootstrapSpec            `json:"bootstrapSpec"`
+	GatewaySpec                  *GatewaySpec              `json:"gatewaySpec"`
+	WorkflowSpec                 *WorkflowSpec             `json:"workflowSpec"`
+	StandardCapabilitiesSpec     *StandardCapabilitiesSpec `json:"standardCapabilitiesSpec"`
+	CCIPSpec                     *CCIPSpec                 `json:"ccipSpec"`
+	PipelineSpec                 PipelineSpec              `json:"pipelineSpec"`
+	Errors                       []JobError                `json:"errors"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
 func NewJobResource(j job.Job) *JobResource {
 	resource := &JobResource{
-		JAID:              NewJAIDInt32(j.ID),
-		Name:              j.Name.ValueOrZero(),
-		StreamID:          j.StreamID,
-		Type:              JobSpecType(j.Type),
-		SchemaVersion:     j.SchemaVersion,
-		GasLimit:          j.GasLimit,
-		ForwardingAllowed: j.ForwardingAllowed,
-		MaxTaskDuration:   j.MaxTaskDuration,
-		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
-		ExternalJobID:     j.ExternalJobID,
+		JAID:                         NewJAIDInt32(j.ID),
+		Name:                         j.Name.ValueOrZero(),
+		Tags:                         j.Tags,
+		StreamID:                     j.StreamID,
+		Type:                         JobSpecType(j.Type),
+		SchemaVersion:                j.SchemaVersion,
+		GasLimit:                     j.GasLimit,
+		ForwardingAllowed:            j.ForwardingAllowed,
+		MaxTaskDuration:              j.MaxTaskDuration,
+		ExpectedRunInterval:          j.ExpectedRunInterval,
+		MaxTimeBetweenSuccessfulRuns: j.MaxTimeBetweenSuccessfulRuns,
+		MaxConsecutiveErrors:         j.MaxConsecutiveErrors,
+		PipelineSpec:                 NewPipelineSpec(j.PipelineSpec),
+		ExternalJobID:                j.ExternalJobID,
 	}
 
 	switch j.Type {
@@ -563,6 +605,8 @@ func NewJobResource(j job.Job) *JobResource {
 		resource.FluxMonitorSpec = NewFluxMonitorSpec(j.FluxMonitorSpec)
 	case job.Cron:
 		resource.CronSpec = NewCronSpec(j.CronSpec)
+	case job.EVMLog:
+		resource.EVMLogSpec = NewEVMLogSpec(j.EVMLogSpec)
 	case job.OffchainReporting:
 		resource.OffChainReportingSpec = NewOffChainReportingSpec(j.OCROracleSpec)
 	case job.OffchainReporting2:
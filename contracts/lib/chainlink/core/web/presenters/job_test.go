@@ -131,6 +131,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -210,6 +211,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -299,6 +301,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -365,6 +368,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -428,6 +432,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+                        "evmLogSpec": null,
                         "errors": []
                     }
                 }
@@ -487,6 +492,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -575,6 +581,7 @@ func TestJob(t *testing.T) {
 						"standardCapabilitiesSpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -645,6 +652,7 @@ func TestJob(t *testing.T) {
 							"runTimeout": 10000000000,
 							"evmChainID": "4",
 							"fromAddresses": ["0xa8037A20989AFcBC51798de9762b351D63ff462e"],
+							"additionalStores": null,
 							"createdAt": "0001-01-01T00:00:00Z",
 							"updatedAt": "0001-01-01T00:00:00Z"
 						},
@@ -658,6 +666,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -729,6 +738,7 @@ func TestJob(t *testing.T) {
 							"fromAddresses": ["0xa8037A20989AFcBC51798de9762b351D63ff462e"],
 							"getBlockhashesBatchSize": 5,
 							"storeBlockhashesBatchSize": 10,
+							"additionalStores": null,
 							"createdAt": "0001-01-01T00:00:00Z",
 							"updatedAt": "0001-01-01T00:00:00Z"
 						},
@@ -741,6 +751,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -808,6 +819,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -872,6 +884,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -937,6 +950,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -998,6 +1012,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -1063,6 +1078,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"evmLogSpec": null,
 						"errors": []
 					}
 				}
@@ -1138,6 +1154,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"evmLogSpec": null,
 						"errors": [{
 							"id": 200,
 							"description": "some error",
@@ -0,0 +1,113 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+)
+
+// KeeperUpkeepCheck is the presentation of a single checkUpkeep evaluation.
+type KeeperUpkeepCheck struct {
+	BlockNumber         int64         `json:"blockNumber"`
+	Performable         bool          `json:"performable"`
+	PerformData         hexutil.Bytes `json:"performData"`
+	GasLimit            *int64        `json:"gasLimit"`
+	GasEstimate         *int64        `json:"gasEstimate,omitempty"`
+	Error               *string       `json:"error"`
+	TurnBlockHashBinary string        `json:"turnBlockHashBinary,omitempty"`
+	Eligible            *bool         `json:"eligible,omitempty"`
+	EligibilityReason   string        `json:"eligibilityReason,omitempty"`
+	CheckedAt           time.Time     `json:"checkedAt"`
+}
+
+// NewKeeperUpkeepCheck returns a new KeeperUpkeepCheck.
+func NewKeeperUpkeepCheck(check keeper.UpkeepCheck) KeeperUpkeepCheck {
+	return KeeperUpkeepCheck{
+		BlockNumber: check.BlockNumber,
+		Performable: check.Performable,
+		PerformData: check.PerformData,
+		GasLimit:    check.GasLimit.Ptr(),
+		GasEstimate: check.GasEstimate.Ptr(),
+		Error:       check.Error.Ptr(),
+		CheckedAt:   check.CheckedAt,
+	}
+}
+
+// KeeperUpkeepResource represents a keeper upkeep JSONAPI resource.
+type KeeperUpkeepResource struct {
+	JAID
+	PrettyID           string             `json:"prettyID"`
+	RegistryAddress    string             `json:"registryAddress"`
+	ExecuteGas         uint32             `json:"executeGas"`
+	CheckData          hexutil.Bytes      `json:"checkData"`
+	LastRunBlockHeight int64              `json:"lastRunBlockHeight"`
+	LastKeeperIndex    *int64             `json:"lastKeeperIndex"`
+	BlockNumber        int64              `json:"blockNumber"`
+	Eligible           bool               `json:"eligible"`
+	EligibilityReason  string             `json:"eligibilityReason"`
+	LastCheck          *KeeperUpkeepCheck `json:"lastCheck"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeeperUpkeepResource) GetName() string {
+	return "keeper_upkeeps"
+}
+
+// NewKeeperUpkeepResource returns a new KeeperUpkeepResource.
+func NewKeeperUpkeepResource(status keeper.UpkeepStatus) *KeeperUpkeepResource {
+	upkeep := status.Upkeep
+	r := &KeeperUpkeepResource{
+		JAID:               NewJAID(upkeep.UpkeepID.String()),
+		PrettyID:           upkeep.PrettyID(),
+		RegistryAddress:    upkeep.Registry.ContractAddress.String(),
+		ExecuteGas:         upkeep.ExecuteGas,
+		CheckData:          upkeep.CheckData,
+		LastRunBlockHeight: upkeep.LastRunBlockHeight,
+		BlockNumber:        status.BlockNumber,
+		Eligible:           status.Eligible,
+		EligibilityReason:  status.EligibilityReason,
+	}
+	if upkeep.LastKeeperIndex.Valid {
+		idx := upkeep.LastKeeperIndex.Int64
+		r.LastKeeperIndex = &idx
+	}
+	if status.LastCheck != nil {
+		check := NewKeeperUpkeepCheck(*status.LastCheck)
+		r.LastCheck = &check
+	}
+	return r
+}
+
+// NewKeeperUpkeepResources returns a slice of KeeperUpkeepResources.
+func NewKeeperUpkeepResources(statuses []keeper.UpkeepStatus) []KeeperUpkeepResource {
+	rs := []KeeperUpkeepResource{}
+	for _, status := range statuses {
+		rs = append(rs, *NewKeeperUpkeepResource(status))
+	}
+	return rs
+}
+
+// KeeperUpkeepCheckResource represents a simulated keeper upkeep check JSONAPI resource.
+type KeeperUpkeepCheckResource struct {
+	JAID
+	KeeperUpkeepCheck
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeeperUpkeepCheckResource) GetName() string {
+	return "keeper_upkeep_checks"
+}
+
+// NewKeeperUpkeepCheckResource returns a new KeeperUpkeepCheckResource for a simulated check.
+func NewKeeperUpkeepCheckResource(upkeepID string, check keeper.UpkeepCheck) *KeeperUpkeepCheckResource {
+	c := NewKeeperUpkeepCheck(check)
+	c.TurnBlockHashBinary = check.TurnBlockHashBinary
+	c.Eligible = &check.Eligible
+	c.EligibilityReason = check.EligibilityReason
+	return &KeeperUpkeepCheckResource{
+		JAID:              NewJAID(upkeepID),
+		KeeperUpkeepCheck: c,
+	}
+}
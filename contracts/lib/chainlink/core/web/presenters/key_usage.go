@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyusage"
+)
+
+// KeyUsageResource represents a signature recorded in the key usage log.
+type KeyUsageResource struct {
+	JAID
+	KeyType     string    `json:"keyType"`
+	KeyID       string    `json:"keyID"`
+	Subsystem   string    `json:"subsystem"`
+	JobID       *int32    `json:"jobID"`
+	PayloadHash string    `json:"payloadHash"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyUsageResource) GetName() string {
+	return "keyUsages"
+}
+
+// NewKeyUsageResource constructs a new KeyUsageResource.
+func NewKeyUsageResource(entry keyusage.Entry) *KeyUsageResource {
+	return &KeyUsageResource{
+		JAID:        NewJAIDInt64(entry.ID),
+		KeyType:     entry.KeyType,
+		KeyID:       entry.KeyID,
+		Subsystem:   entry.Subsystem,
+		JobID:       entry.JobID,
+		PayloadHash: entry.PayloadHash,
+		CreatedAt:   entry.CreatedAt,
+	}
+}
+
+// NewKeyUsageResources constructs a list of KeyUsageResource.
+func NewKeyUsageResources(entries []keyusage.Entry) []KeyUsageResource {
+	rs := []KeyUsageResource{}
+	for _, entry := range entries {
+		rs = append(rs, *NewKeyUsageResource(entry))
+	}
+	return rs
+}
@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/loglevel"
+)
+
+// LogLevelOverrideResource represents the log level override of a job or job type JSONAPI resource.
+type LogLevelOverrideResource struct {
+	JAID
+	JobID     *int32     `json:"jobID"`
+	JobType   *string    `json:"jobType"`
+	Level     string     `json:"level"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogLevelOverrideResource) GetName() string {
+	return "logLevelOverrides"
+}
+
+// NewLogLevelOverrideResource constructs a new LogLevelOverrideResource.
+func NewLogLevelOverrideResource(o loglevel.Override) *LogLevelOverrideResource {
+	return &LogLevelOverrideResource{
+		JAID:      NewJAIDInt64(o.ID),
+		JobID:     o.JobID,
+		JobType:   o.JobType,
+		Level:     o.Level,
+		ExpiresAt: o.ExpiresAt.Ptr(),
+		CreatedAt: o.CreatedAt,
+	}
+}
+
+// NewLogLevelOverrideResources constructs a list of LogLevelOverrideResource.
+func NewLogLevelOverrideResources(overrides []loglevel.Override) []LogLevelOverrideResource {
+	rs := []LogLevelOverrideResource{}
+	for _, o := range overrides {
+		rs = append(rs, *NewLogLevelOverrideResource(o))
+	}
+	return rs
+}
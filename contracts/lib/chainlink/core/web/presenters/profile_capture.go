@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+)
+
+// ProfileCaptureResource represents a capture of the pprof profiles of the node JSONAPI resource.
+type ProfileCaptureResource struct {
+	JAID
+	Time       time.Time              `json:"time"`
+	Reason     string                 `json:"reason"`
+	Meta       map[string]interface{} `json:"meta"`
+	Files      []string               `json:"files"`
+	Size       int64                  `json:"size"`
+	InProgress bool                   `json:"inProgress"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ProfileCaptureResource) GetName() string {
+	return "profileCaptures"
+}
+
+// NewProfileCaptureResource constructs a new ProfileCaptureResource.
+func NewProfileCaptureResource(c services.ProfileCapture) *ProfileCaptureResource {
+	return &ProfileCaptureResource{
+		JAID:       NewJAID(c.ID),
+		Time:       c.Time,
+		Reason:     c.Reason,
+		Meta:       c.Meta,
+		Files:      c.Files,
+		Size:       c.Size,
+		InProgress: c.InProgress,
+	}
+}
+
+// NewProfileCaptureResources constructs a list of ProfileCaptureResource.
+func NewProfileCaptureResources(captures []services.ProfileCapture) []ProfileCaptureResource {
+	rs := []ProfileCaptureResource{}
+	for _, c := range captures {
+		rs = append(rs, *NewProfileCaptureResource(c))
+	}
+	return rs
+}
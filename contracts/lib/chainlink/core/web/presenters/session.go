@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// SessionResource represents an active session JSONAPI resource. The session ID, which
+// authenticates the requests of the session, is never included.
+type SessionResource struct {
+	JAID
+	Email     string    `json:"email"`
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
+	Current   bool      `json:"current"`
+	CreatedAt time.Time `json:"createdAt"`
+	LastUsed  time.Time `json:"lastUsed"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SessionResource) GetName() string {
+	return "sessions"
+}
+
+// NewSessionResource constructs a new SessionResource, identified by the public ID of the
+// session. Current is set if the session is the one of the request.
+func NewSessionResource(s sessions.Session, current bool) *SessionResource {
+	return &SessionResource{
+		JAID:      NewJAID(s.PublicID()),
+		Email:     s.Email,
+		IPAddress: s.IPAddress,
+		UserAgent: s.UserAgent,
+		Current:   current,
+		CreatedAt: s.CreatedAt,
+		LastUsed:  s.LastUsed,
+	}
+}
+
+// NewSessionResources constructs a list of SessionResource, flagging the session of the request.
+func NewSessionResources(ss []sessions.Session, currentSessionID string) []SessionResource {
+	rs := []SessionResource{}
+	for _, s := range ss {
+		rs = append(rs, *NewSessionResource(s, s.ID == currentSessionID))
+	}
+	return rs
+}
@@ -0,0 +1,76 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+)
+
+// VRFRequestResource represents a pending, in-flight or reverted VRF request JSONAPI resource.
+type VRFRequestResource struct {
+	JAID
+	SubID              string         `json:"subID"`
+	Sender             common.Address `json:"sender"`
+	RequestTxHash      common.Hash    `json:"requestTxHash"`
+	RequestBlockNumber uint64         `json:"requestBlockNumber"`
+	ConfirmedAtBlock   uint64         `json:"confirmedAtBlock"`
+	CallbackGasLimit   uint32         `json:"callbackGasLimit"`
+	NativePayment      bool           `json:"nativePayment"`
+	State              string         `json:"state"`
+	Reason             string         `json:"reason"`
+	SubActive          *bool          `json:"subActive"`
+	SubLinkBalance     *string        `json:"subLinkBalance"`
+	SubNativeBalance   *string        `json:"subNativeBalance"`
+	FulfillmentTxHash  *common.Hash   `json:"fulfillmentTxHash"`
+	RequestedAt        *time.Time     `json:"requestedAt"`
+	UpdatedAt          *time.Time     `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestResource) GetName() string {
+	return "vrf_requests"
+}
+
+// NewVRFRequestResource returns a new VRFRequestResource.
+func NewVRFRequestResource(status vrfv2.RequestStatus) *VRFRequestResource {
+	r := &VRFRequestResource{
+		JAID:               NewJAID(status.RequestID),
+		SubID:              status.SubID,
+		Sender:             status.Sender,
+		RequestTxHash:      status.RequestTxHash,
+		RequestBlockNumber: status.RequestBlockNumber,
+		ConfirmedAtBlock:   status.ConfirmedAtBlock,
+		CallbackGasLimit:   status.CallbackGasLimit,
+		NativePayment:      status.NativePayment,
+		State:              string(status.State),
+		Reason:             status.Reason,
+		SubActive:          status.SubActive,
+		FulfillmentTxHash:  status.FulfillmentTxHash,
+	}
+	if status.SubLinkBalance != nil {
+		balance := status.SubLinkBalance.String()
+		r.SubLinkBalance = &balance
+	}
+	if status.SubNativeBalance != nil {
+		balance := status.SubNativeBalance.String()
+		r.SubNativeBalance = &balance
+	}
+	if !status.RequestedAt.IsZero() {
+		r.RequestedAt = &status.RequestedAt
+	}
+	if !status.UpdatedAt.IsZero() {
+		r.UpdatedAt = &status.UpdatedAt
+	}
+	return r
+}
+
+// NewVRFRequestResources returns a slice of VRFRequestResources.
+func NewVRFRequestResources(statuses []vrfv2.RequestStatus) []VRFRequestResource {
+	rs := []VRFRequestResource{}
+	for _, status := range statuses {
+		rs = append(rs, *NewVRFRequestResource(status))
+	}
+	return rs
+}
@@ -0,0 +1,142 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// ProfilesController captures the pprof profiles of the node on demand, and serves the captured profiles.
+type ProfilesController struct {
+	App chainlink.Application
+}
+
+// ProfileCaptureRequest requests a capture of the profiles of the node, recording the reason for it.
+type ProfileCaptureRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Index lists the profile captures, most recent first.
+// Example:
+// "GET <application>/profiles"
+func (pc *ProfilesController) Index(c *gin.Context) {
+	nurse, ok := pc.nurse(c)
+	if !ok {
+		return
+	}
+	captures, err := nurse.Captures()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewProfileCaptureResources(captures), "profileCaptures")
+}
+
+// Create requests a capture of the profiles of the node. The profiles are gathered in the background, for
+// AutoPprof.GatherDuration.
+// Example:
+// "POST <application>/profiles"
+func (pc *ProfilesController) Create(c *gin.Context) {
+	nurse, ok := pc.nurse(c)
+	if !ok {
+		return
+	}
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	request := &ProfileCaptureRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request.Reason = strings.TrimSpace(request.Reason)
+	if request.Reason == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("reason is required"))
+		return
+	}
+
+	meta := services.Meta{"requested_by": user.Email}
+	id, err := nurse.RequestCapture(c.Request.Context(), request.Reason, meta)
+	if err != nil {
+		if errors.Is(err, services.ErrCapturePending) || errors.Is(err, services.ErrProfileSizeExceeded) {
+			jsonAPIError(c, http.StatusConflict, err)
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	pc.App.GetAuditLogger().Audit(audit.ProfileCaptureRequested, map[string]interface{}{"id": id, "reason": request.Reason})
+	resource := presenters.NewProfileCaptureResource(services.ProfileCapture{ID: id, Reason: request.Reason, Meta: meta, InProgress: true})
+	jsonAPIResponseWithStatus(c, resource, "profileCapture", http.StatusAccepted)
+}
+
+// Download serves the profile captures with the given IDs as a zip archive, or all the completed captures if no ID is
+// given.
+// Example:
+// "GET <application>/profiles/download?id=1700000000000000"
+func (pc *ProfilesController) Download(c *gin.Context) {
+	nurse, ok := pc.nurse(c)
+	if !ok {
+		return
+	}
+	ids := c.QueryArray("id")
+
+	w := &zipResponseWriter{c: c, filename: "profiles.zip"}
+	if err := nurse.WriteCapturesZip(w, ids...); err != nil {
+		if w.started {
+			// the status is already sent, the client gets a truncated archive
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		switch {
+		case errors.Is(err, services.ErrCaptureNotFound):
+			jsonAPIError(c, http.StatusNotFound, err)
+		case errors.Is(err, services.ErrCaptureInProgress):
+			jsonAPIError(c, http.StatusConflict, err)
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	pc.App.GetAuditLogger().Audit(audit.ProfilesDownloaded, map[string]interface{}{"ids": ids})
+}
+
+func (pc *ProfilesController) nurse(c *gin.Context) (*services.Nurse, bool) {
+	nurse := pc.App.Nurse()
+	if nurse == nil {
+		jsonAPIError(c, http.StatusMethodNotAllowed, errors.New("Automatic profiling is disabled by configuration, set AutoPprof.Enabled to capture profiles"))
+		return nil, false
+	}
+	return nurse, true
+}
+
+// zipResponseWriter sends the headers of a zip archive download with the first write, so that errors detected before
+// anything is written can still be reported as JSON.
+type zipResponseWriter struct {
+	c        *gin.Context
+	filename string
+	started  bool
+}
+
+func (w *zipResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.c.Header("Content-Type", "application/zip")
+		w.c.Header("Content-Disposition", `attachment; filename="`+w.filename+`"`)
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
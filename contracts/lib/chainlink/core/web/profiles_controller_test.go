@@ -0,0 +1,96 @@
+package web_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestProfilesController(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.AutoPprof.Enabled = ptr(true)
+		c.AutoPprof.ProfileRoot = ptr(t.TempDir())
+		c.AutoPprof.GatherDuration = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.AutoPprof.GatherTraceDuration = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.AutoPprof.GoroutineThreshold = ptr[int64](1 << 30)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	viewer := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+	resp, cleanup := viewer.Post("/v2/profiles", bytes.NewBufferString(`{"reason": "investigating"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = client.Post("/v2/profiles", bytes.NewBufferString(`{"reason": " "}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/profiles", bytes.NewBufferString(`{"reason": "investigating"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+	var requested presenters.ProfileCaptureResource
+	cltest.ParseJSONAPIResponse(t, resp, &requested)
+	assert.Equal(t, "investigating", requested.Reason)
+	assert.Equal(t, cltest.APIEmailAdmin, requested.Meta["requested_by"])
+
+	var captures []presenters.ProfileCaptureResource
+	testutils.AssertEventually(t, func() bool {
+		resp, cleanup := client.Get("/v2/profiles")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		cltest.ParseJSONAPIResponse(t, resp, &captures)
+		return len(captures) == 1 && !captures[0].InProgress
+	})
+	assert.Equal(t, requested.ID, captures[0].ID)
+	assert.Equal(t, "investigating", captures[0].Reason)
+	assert.Equal(t, cltest.APIEmailAdmin, captures[0].Meta["requested_by"])
+
+	resp, cleanup = client.Get("/v2/profiles/download?id=" + requested.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "application/zip", resp.Header.Get("Content-Type"))
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.ElementsMatch(t, captures[0].Files, names)
+
+	resp, cleanup = client.Get("/v2/profiles/download?id=42")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestProfilesController_Disabled(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/profiles")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusMethodNotAllowed)
+}
@@ -1,6 +1,12 @@
 package resolver
 
-import "github.com/smartcontractkit/chainlink/v2/core/auth"
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
 
 type APITokenResolver struct {
 	token auth.Token
@@ -107,3 +113,187 @@ func NewDeleteAPITokenSuccess(token *auth.Token) *DeleteAPITokenSuccessResolver
 func (r *DeleteAPITokenSuccessResolver) Token() *APITokenResolver {
 	return NewAPIToken(*r.token)
 }
+
+// APITokenGrantResolver resolves a permission of a named API token.
+type APITokenGrantResolver struct {
+	grant rbac.Grant
+}
+
+func NewAPITokenGrant(grant rbac.Grant) *APITokenGrantResolver {
+	return &APITokenGrantResolver{grant}
+}
+
+func (r *APITokenGrantResolver) Permission() string {
+	return string(r.grant.Permission)
+}
+
+func (r *APITokenGrantResolver) JobTypes() []string {
+	if r.grant.JobTypes == nil {
+		return []string{}
+	}
+	return r.grant.JobTypes
+}
+
+func (r *APITokenGrantResolver) JobTags() []string {
+	if r.grant.JobTags == nil {
+		return []string{}
+	}
+	return r.grant.JobTags
+}
+
+func (r *APITokenGrantResolver) JobIDs() []int32 {
+	if r.grant.JobIDs == nil {
+		return []int32{}
+	}
+	return r.grant.JobIDs
+}
+
+// NamedAPITokenResolver resolves a named API token of a user, without its secret.
+type NamedAPITokenResolver struct {
+	token apitokens.Token
+}
+
+func NewNamedAPIToken(token apitokens.Token) *NamedAPITokenResolver {
+	return &NamedAPITokenResolver{token}
+}
+
+func NewNamedAPITokens(tokens []apitokens.Token) []*NamedAPITokenResolver {
+	var resolvers []*NamedAPITokenResolver
+	for _, t := range tokens {
+		resolvers = append(resolvers, NewNamedAPIToken(t))
+	}
+
+	return resolvers
+}
+
+func (r *NamedAPITokenResolver) Name() string {
+	return r.token.Name
+}
+
+func (r *NamedAPITokenResolver) AccessKey() string {
+	return r.token.TokenKey
+}
+
+func (r *NamedAPITokenResolver) Grants() []*APITokenGrantResolver {
+	resolvers := []*APITokenGrantResolver{}
+	for _, g := range r.token.Grants {
+		resolvers = append(resolvers, NewAPITokenGrant(g))
+	}
+	return resolvers
+}
+
+func (r *NamedAPITokenResolver) ExpiresAt() *graphql.Time {
+	if !r.token.ExpiresAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.token.ExpiresAt.Time}
+}
+
+func (r *NamedAPITokenResolver) LastUsedAt() *graphql.Time {
+	if !r.token.LastUsedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.token.LastUsedAt.Time}
+}
+
+func (r *NamedAPITokenResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.token.CreatedAt}
+}
+
+// -- NamedAPITokens Query --
+
+type NamedAPITokensPayloadResolver struct {
+	tokens []apitokens.Token
+}
+
+func NewNamedAPITokensPayload(tokens []apitokens.Token) *NamedAPITokensPayloadResolver {
+	return &NamedAPITokensPayloadResolver{tokens}
+}
+
+func (r *NamedAPITokensPayloadResolver) Results() []*NamedAPITokenResolver {
+	return NewNamedAPITokens(r.tokens)
+}
+
+// -- CreateNamedAPIToken Mutation --
+
+type CreateNamedAPITokenPayloadResolver struct {
+	token       apitokens.Token
+	credentials *auth.Token
+	inputErrs   map[string]string
+}
+
+func NewCreateNamedAPITokenPayload(token apitokens.Token, credentials *auth.Token, inputErrs map[string]string) *CreateNamedAPITokenPayloadResolver {
+	return &CreateNamedAPITokenPayloadResolver{token, credentials, inputErrs}
+}
+
+func (r *CreateNamedAPITokenPayloadResolver) ToCreateNamedAPITokenSuccess() (*CreateNamedAPITokenSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewCreateNamedAPITokenSuccess(r.token, r.credentials), true
+}
+
+func (r *CreateNamedAPITokenPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type CreateNamedAPITokenSuccessResolver struct {
+	token       apitokens.Token
+	credentials *auth.Token
+}
+
+func NewCreateNamedAPITokenSuccess(token apitokens.Token, credentials *auth.Token) *CreateNamedAPITokenSuccessResolver {
+	return &CreateNamedAPITokenSuccessResolver{token, credentials}
+}
+
+func (r *CreateNamedAPITokenSuccessResolver) Token() *NamedAPITokenResolver {
+	return NewNamedAPIToken(r.token)
+}
+
+func (r *CreateNamedAPITokenSuccessResolver) Secret() string {
+	return r.credentials.Secret
+}
+
+// -- RevokeNamedAPIToken Mutation --
+
+type RevokeNamedAPITokenPayloadResolver struct {
+	name string
+	NotFoundErrorUnionType
+}
+
+func NewRevokeNamedAPITokenPayload(name string, err error) *RevokeNamedAPITokenPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "token not found"}
+
+	return &RevokeNamedAPITokenPayloadResolver{name: name, NotFoundErrorUnionType: e}
+}
+
+func (r *RevokeNamedAPITokenPayloadResolver) ToRevokeNamedAPITokenSuccess() (*RevokeNamedAPITokenSuccessResolver, bool) {
+	if r.err == nil {
+		return NewRevokeNamedAPITokenSuccess(r.name), true
+	}
+
+	return nil, false
+}
+
+type RevokeNamedAPITokenSuccessResolver struct {
+	name string
+}
+
+func NewRevokeNamedAPITokenSuccess(name string) *RevokeNamedAPITokenSuccessResolver {
+	return &RevokeNamedAPITokenSuccessResolver{name}
+}
+
+func (r *RevokeNamedAPITokenSuccessResolver) Name() string {
+	return r.name
+}
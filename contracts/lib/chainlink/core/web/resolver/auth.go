@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
@@ -54,6 +57,51 @@ func authenticateUserIsAdmin(ctx context.Context) error {
 	return nil
 }
 
+// Authenticates the user from the session cookie and asserts they are granted the permission,
+// either by their base role or by one of their custom roles. Resolvers of job scoped permissions
+// must also check the job itself with authenticateUserHasJobPermission.
+func authenticateUserHasPermission(ctx context.Context, app chainlink.Application, permission rbac.Permission) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return unauthorizedError{}
+	}
+	if rbac.RoleAllows(session.User.Role, permission) {
+		return nil
+	}
+	roles, err := app.CustomRolesORM().FindUserRoles(ctx, session.User.Email)
+	if err != nil {
+		return err
+	}
+	if !rbac.NewAuthorization(session.User.Role, roles).Allows(permission) {
+		return RoleNotPermittedErr{session.User.Role}
+	}
+	return nil
+}
+
+// Authenticates the user from the session cookie and asserts they are granted the permission for
+// the job returned by findJob. The job is only looked up when the base role is not enough.
+func authenticateUserHasJobPermission(ctx context.Context, app chainlink.Application, permission rbac.Permission, findJob func(ctx context.Context) (job.Job, error)) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return unauthorizedError{}
+	}
+	if rbac.RoleAllows(session.User.Role, permission) {
+		return nil
+	}
+	jb, err := findJob(ctx)
+	if err != nil {
+		return err
+	}
+	roles, err := app.CustomRolesORM().FindUserRoles(ctx, session.User.Email)
+	if err != nil {
+		return err
+	}
+	if !rbac.NewAuthorization(session.User.Role, roles).AllowsJob(permission, rbac.Job{ID: jb.ID, Type: jb.Type.String(), Tags: jb.Tags}) {
+		return RoleNotPermittedErr{session.User.Role}
+	}
+	return nil
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
@@ -64,6 +64,14 @@ func (r *JobResolver) Name() string {
 	return r.j.Name.ValueOrZero()
 }
 
+// Tags resolves the job's tags.
+func (r *JobResolver) Tags() []string {
+	if r.j.Tags == nil {
+		return []string{}
+	}
+	return r.j.Tags
+}
+
 // ObservationSource resolves the job's observation source.
 //
 // This could potentially be moved to a dataloader in the future as we are
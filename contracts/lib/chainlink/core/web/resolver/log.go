@@ -3,7 +3,10 @@ package resolver
 import (
 	"strings"
 
+	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/loglevel"
 )
 
 type LogLevel string
@@ -169,3 +172,140 @@ func NewSetGlobalLogLevelSuccess(lvl LogLevel) *SetGlobalLogLevelSuccessResolver
 func (r *SetGlobalLogLevelSuccessResolver) GlobalLogLevel() *GlobalLogLevelResolver {
 	return GlobalLogLevel(FromLogLevel(r.lvl))
 }
+
+// JobLogLevelOverrideResolver resolves the log level override of a job or job type.
+type JobLogLevelOverrideResolver struct {
+	override loglevel.Override
+}
+
+func NewJobLogLevelOverride(override loglevel.Override) *JobLogLevelOverrideResolver {
+	return &JobLogLevelOverrideResolver{override: override}
+}
+
+func NewJobLogLevelOverrides(overrides []loglevel.Override) []*JobLogLevelOverrideResolver {
+	resolvers := []*JobLogLevelOverrideResolver{}
+	for _, o := range overrides {
+		resolvers = append(resolvers, NewJobLogLevelOverride(o))
+	}
+
+	return resolvers
+}
+
+func (r *JobLogLevelOverrideResolver) ID() graphql.ID {
+	return int64GQLID(r.override.ID)
+}
+
+func (r *JobLogLevelOverrideResolver) JobID() *int32 {
+	return r.override.JobID
+}
+
+func (r *JobLogLevelOverrideResolver) JobType() *string {
+	return r.override.JobType
+}
+
+func (r *JobLogLevelOverrideResolver) Level() (LogLevel, error) {
+	return ToLogLevel(r.override.Level)
+}
+
+func (r *JobLogLevelOverrideResolver) ExpiresAt() *graphql.Time {
+	if !r.override.ExpiresAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.override.ExpiresAt.Time}
+}
+
+func (r *JobLogLevelOverrideResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.override.CreatedAt}
+}
+
+// -- JobLogLevelOverrides Query --
+
+type JobLogLevelOverridesPayloadResolver struct {
+	overrides []loglevel.Override
+}
+
+func NewJobLogLevelOverridesPayload(overrides []loglevel.Override) *JobLogLevelOverridesPayloadResolver {
+	return &JobLogLevelOverridesPayloadResolver{overrides: overrides}
+}
+
+func (r *JobLogLevelOverridesPayloadResolver) Results() []*JobLogLevelOverrideResolver {
+	return NewJobLogLevelOverrides(r.overrides)
+}
+
+// -- SetJobLogLevelOverride Mutation --
+
+type SetJobLogLevelOverridePayloadResolver struct {
+	override  loglevel.Override
+	inputErrs map[string]string
+}
+
+func NewSetJobLogLevelOverridePayload(override loglevel.Override, inputErrs map[string]string) *SetJobLogLevelOverridePayloadResolver {
+	return &SetJobLogLevelOverridePayloadResolver{override: override, inputErrs: inputErrs}
+}
+
+func (r *SetJobLogLevelOverridePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+func (r *SetJobLogLevelOverridePayloadResolver) ToSetJobLogLevelOverrideSuccess() (*SetJobLogLevelOverrideSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewSetJobLogLevelOverrideSuccess(r.override), true
+}
+
+type SetJobLogLevelOverrideSuccessResolver struct {
+	override loglevel.Override
+}
+
+func NewSetJobLogLevelOverrideSuccess(override loglevel.Override) *SetJobLogLevelOverrideSuccessResolver {
+	return &SetJobLogLevelOverrideSuccessResolver{override: override}
+}
+
+func (r *SetJobLogLevelOverrideSuccessResolver) Override() *JobLogLevelOverrideResolver {
+	return NewJobLogLevelOverride(r.override)
+}
+
+// -- ClearJobLogLevelOverride Mutation --
+
+type ClearJobLogLevelOverridePayloadResolver struct {
+	id int64
+	NotFoundErrorUnionType
+}
+
+func NewClearJobLogLevelOverridePayload(id int64, err error) *ClearJobLogLevelOverridePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "log level override not found"}
+
+	return &ClearJobLogLevelOverridePayloadResolver{id: id, NotFoundErrorUnionType: e}
+}
+
+func (r *ClearJobLogLevelOverridePayloadResolver) ToClearJobLogLevelOverrideSuccess() (*ClearJobLogLevelOverrideSuccessResolver, bool) {
+	if r.err == nil {
+		return NewClearJobLogLevelOverrideSuccess(r.id), true
+	}
+
+	return nil, false
+}
+
+type ClearJobLogLevelOverrideSuccessResolver struct {
+	id int64
+}
+
+func NewClearJobLogLevelOverrideSuccess(id int64) *ClearJobLogLevelOverrideSuccessResolver {
+	return &ClearJobLogLevelOverrideSuccessResolver{id: id}
+}
+
+func (r *ClearJobLogLevelOverrideSuccessResolver) ID() graphql.ID {
+	return int64GQLID(r.id)
+}
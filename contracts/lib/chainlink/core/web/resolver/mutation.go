@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
+	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
@@ -24,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
@@ -35,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/loglevel"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
@@ -43,6 +46,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -63,7 +68,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.BridgesCreate); err != nil {
 		return nil, err
 	}
 
@@ -113,7 +118,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +142,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysDelete); err != nil {
 		return nil, err
 	}
 
@@ -458,7 +463,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.BridgesUpdate); err != nil {
 		return nil, err
 	}
 
@@ -615,7 +620,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -635,7 +640,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysDelete); err != nil {
 		return nil, err
 	}
 
@@ -654,7 +659,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.BridgesDelete); err != nil {
 		return nil, err
 	}
 
@@ -690,7 +695,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -714,7 +719,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysDelete); err != nil {
 		return nil, err
 	}
 
@@ -740,7 +745,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -762,7 +767,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysDelete); err != nil {
 		return nil, err
 	}
 
@@ -1057,12 +1062,120 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 	}, nil), nil
 }
 
+type createNamedAPITokenInput struct {
+	Name      string
+	Password  string
+	ExpiresAt *graphql.Time
+	Grants    *[]struct {
+		Permission string
+		JobTypes   *[]string
+		JobTags    *[]string
+		JobIDs     *[]int32
+	}
+}
+
+func (r *Resolver) CreateNamedAPIToken(ctx context.Context, args struct {
+	Input createNamedAPITokenInput
+}) (*CreateNamedAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	err := r.App.AuthenticationProvider().TestPassword(ctx, session.User.Email, args.Input.Password)
+	if err != nil {
+		r.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": session.User.Email})
+
+		return NewCreateNamedAPITokenPayload(apitokens.Token{}, nil, map[string]string{
+			"password": "incorrect password",
+		}), nil
+	}
+
+	token := apitokens.Token{
+		UserEmail: session.User.Email,
+		Name:      args.Input.Name,
+	}
+	if args.Input.ExpiresAt != nil {
+		token.ExpiresAt = null.TimeFrom(args.Input.ExpiresAt.Time)
+	}
+	if args.Input.Grants != nil {
+		for _, g := range *args.Input.Grants {
+			grant := rbac.Grant{Permission: rbac.Permission(g.Permission)}
+			if g.JobTypes != nil {
+				grant.JobTypes = *g.JobTypes
+			}
+			if g.JobTags != nil {
+				grant.JobTags = *g.JobTags
+			}
+			if g.JobIDs != nil {
+				grant.JobIDs = *g.JobIDs
+			}
+			token.Grants = append(token.Grants, grant)
+		}
+	}
+	if err = token.Validate(time.Now()); err != nil {
+		return NewCreateNamedAPITokenPayload(apitokens.Token{}, nil, map[string]string{
+			"input": err.Error(),
+		}), nil
+	}
+
+	credentials, err := r.App.APITokensORM().CreateToken(ctx, &token)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return NewCreateNamedAPITokenPayload(apitokens.Token{}, nil, map[string]string{
+				"name": fmt.Sprintf("token %s already exists", token.Name),
+			}), nil
+		}
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":      token.UserEmail,
+		"name":      token.Name,
+		"grants":    token.Grants,
+		"expiresAt": token.ExpiresAt,
+	})
+	return NewCreateNamedAPITokenPayload(token, credentials, nil), nil
+}
+
+func (r *Resolver) RevokeNamedAPIToken(ctx context.Context, args struct {
+	Name string
+}) (*RevokeNamedAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	err := r.App.APITokensORM().DeleteToken(ctx, session.User.Email, args.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRevokeNamedAPITokenPayload(args.Name, err), nil
+		}
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{
+		"user": session.User.Email,
+		"name": args.Name,
+	})
+	return NewRevokeNamedAPITokenPayload(args.Name, nil), nil
+}
+
 func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	Input struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.JobsCreate); err != nil {
 		return nil, err
 	}
 
@@ -1094,6 +1207,8 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
 	case job.Cron:
 		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+	case job.EVMLog:
+		jb, err = evmlog.ValidatedEVMLogSpec(args.Input.TOML)
 	case job.VRF:
 		jb, err = vrfcommon.ValidatedVRFSpec(args.Input.TOML)
 	case job.Webhook:
@@ -1122,6 +1237,11 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	if err = authenticateUserHasJobPermission(ctx, r.App, rbac.JobsCreate, func(context.Context) (job.Job, error) {
+		return jb, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -1140,7 +1260,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.JobsDelete); err != nil {
 		return nil, err
 	}
 
@@ -1157,6 +1277,11 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	if err = authenticateUserHasJobPermission(ctx, r.App, rbac.JobsDelete, func(context.Context) (job.Job, error) {
+		return j, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	err = r.App.DeleteJob(ctx, id)
 	if err != nil {
@@ -1208,7 +1333,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.JobsRun); err != nil {
 		return nil, err
 	}
 
@@ -1216,6 +1341,14 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	err = authenticateUserHasJobPermission(ctx, r.App, rbac.JobsRun, func(ctx context.Context) (job.Job, error) {
+		return r.App.JobORM().FindJobWithoutSpecErrors(ctx, jobID)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return NewRunJobPayload(nil, r.App, webhook.ErrJobNotExists), nil
+	} else if err != nil {
+		return nil, err
+	}
 
 	jobRunID, err := r.App.RunJobV2(ctx, jobID, nil)
 	if err != nil {
@@ -1260,11 +1393,90 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	return NewSetGlobalLogLevelPayload(args.Level, nil), nil
 }
 
+func (r *Resolver) SetJobLogLevelOverride(ctx context.Context, args struct {
+	Input struct {
+		JobID    *int32
+		JobType  *string
+		Level    LogLevel
+		Duration *string
+	}
+}) (*SetJobLogLevelOverridePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	override := loglevel.Override{
+		JobID:     args.Input.JobID,
+		JobType:   args.Input.JobType,
+		Level:     FromLogLevel(args.Input.Level),
+		CreatedAt: time.Now(),
+	}
+	if args.Input.Duration != nil {
+		d, err := time.ParseDuration(*args.Input.Duration)
+		if err != nil {
+			return NewSetJobLogLevelOverridePayload(loglevel.Override{}, map[string]string{
+				"duration": "invalid duration",
+			}), nil
+		}
+		override.ExpiresAt = null.TimeFrom(override.CreatedAt.Add(d))
+	}
+	if err := override.Validate(); err != nil {
+		return NewSetJobLogLevelOverridePayload(loglevel.Override{}, map[string]string{
+			"input": err.Error(),
+		}), nil
+	}
+	if override.JobID != nil {
+		if _, err := r.App.JobORM().FindJob(ctx, *override.JobID); err != nil {
+			if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+				return NewSetJobLogLevelOverridePayload(loglevel.Override{}, map[string]string{
+					"jobID": "job not found",
+				}), nil
+			}
+			return nil, err
+		}
+	}
+
+	if err := r.App.LogLevelOverrides().SetOverride(ctx, &override); err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobLogLevelSet, map[string]interface{}{
+		"jobID":     override.JobID,
+		"jobType":   override.JobType,
+		"level":     override.Level,
+		"expiresAt": override.ExpiresAt,
+	})
+	return NewSetJobLogLevelOverridePayload(override, nil), nil
+}
+
+func (r *Resolver) ClearJobLogLevelOverride(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ClearJobLogLevelOverridePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.App.LogLevelOverrides().DeleteOverride(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewClearJobLogLevelOverridePayload(id, err), nil
+		}
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobLogLevelCleared, map[string]interface{}{"id": id})
+	return NewClearJobLogLevelOverridePayload(id, nil), nil
+}
+
 // CreateOCR2KeyBundle resolves a create OCR2 Key bundle mutation
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -1292,7 +1504,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, r.App, rbac.KeysDelete); err != nil {
 		return nil, err
 	}
 
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
@@ -403,6 +404,25 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	return npr, nil
 }
 
+// NamedAPITokens retrieves the named API tokens of the current user
+func (r *Resolver) NamedAPITokens(ctx context.Context) (*NamedAPITokensPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	tokens, err := r.App.APITokensORM().ListTokens(ctx, session.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNamedAPITokensPayload(tokens), nil
+}
+
 func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
@@ -581,6 +601,20 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 	return NewGlobalLogLevelPayload(logLevel), nil
 }
 
+// JobLogLevelOverrides retrieves the log level overrides of jobs and job types which have not expired.
+func (r *Resolver) JobLogLevelOverrides(ctx context.Context) (*JobLogLevelOverridesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	overrides, err := r.App.LogLevelOverrides().Overrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobLogLevelOverridesPayload(overrides), nil
+}
+
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -1,6 +1,8 @@
 package resolver
 
 import (
+	"maps"
+	"slices"
 	"strconv"
 
 	"github.com/graph-gophers/graphql-go"
@@ -34,6 +36,15 @@ func (r *SpecResolver) ToDirectRequestSpec() (*DirectRequestSpecResolver, bool)
 	return &DirectRequestSpecResolver{spec: *r.j.DirectRequestSpec}, true
 }
 
+// ToEVMLogSpec returns the EVMLogSpec from the SpecResolver if the job is an EVMLog job.
+func (r *SpecResolver) ToEVMLogSpec() (*EVMLogSpecResolver, bool) {
+	if r.j.Type != job.EVMLog {
+		return nil, false
+	}
+
+	return &EVMLogSpecResolver{spec: *r.j.EVMLogSpec}, true
+}
+
 func (r *SpecResolver) ToFluxMonitorSpec() (*FluxMonitorSpecResolver, bool) {
 	if r.j.Type != job.FluxMonitor {
 		return nil, false
@@ -230,6 +241,86 @@ func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	return &requesters
 }
 
+// EVMLogSpecResolver exposes the EVMLog job spec as a GraphQL type.
+type EVMLogSpecResolver struct {
+	spec job.EVMLogSpec
+}
+
+// ContractAddress resolves the spec's contract address.
+func (r *EVMLogSpecResolver) ContractAddress() string {
+	return r.spec.ContractAddress.String()
+}
+
+// EventABI resolves the spec's event ABI.
+func (r *EVMLogSpecResolver) EventABI() string {
+	return r.spec.EventABI
+}
+
+// TopicFilters resolves the spec's topic filters, sorted by argument name.
+func (r *EVMLogSpecResolver) TopicFilters() []*EVMLogTopicFilterResolver {
+	var filters []*EVMLogTopicFilterResolver
+	for _, argument := range slices.Sorted(maps.Keys(r.spec.TopicFilters)) {
+		filters = append(filters, &EVMLogTopicFilterResolver{
+			argument: argument,
+			values:   r.spec.TopicFilters[argument],
+		})
+	}
+	return filters
+}
+
+// MinConfirmations resolves the spec's min confirmations.
+func (r *EVMLogSpecResolver) MinConfirmations() *int32 {
+	if !r.spec.MinConfirmations.Valid {
+		return nil
+	}
+
+	confs := int32(r.spec.MinConfirmations.Uint32)
+
+	return &confs
+}
+
+// LookbackBlocks resolves the spec's lookback blocks.
+func (r *EVMLogSpecResolver) LookbackBlocks() int32 {
+	return r.spec.LookbackBlocks
+}
+
+// PollPeriod resolves the spec's poll period.
+func (r *EVMLogSpecResolver) PollPeriod() string {
+	return r.spec.PollPeriod.String()
+}
+
+// EVMChainID resolves the spec's evm chain id.
+func (r *EVMLogSpecResolver) EVMChainID() *string {
+	if r.spec.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := r.spec.EVMChainID.String()
+
+	return &chainID
+}
+
+// CreatedAt resolves the spec's created at timestamp.
+func (r *EVMLogSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+// EVMLogTopicFilterResolver resolves the values accepted for an indexed event argument.
+type EVMLogTopicFilterResolver struct {
+	argument string
+	values   []string
+}
+
+// Argument resolves the name of the indexed event argument.
+func (r *EVMLogTopicFilterResolver) Argument() string {
+	return r.argument
+}
+
+// Values resolves the values accepted for the argument.
+func (r *EVMLogTopicFilterResolver) Values() []string {
+	return r.values
+}
+
 type FluxMonitorSpecResolver struct {
 	spec job.FluxMonitorSpec
 }
@@ -148,6 +148,86 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_EVMLogSpec(t *testing.T) {
+	var (
+		id = int32(1)
+	)
+	contractAddress, err := evmtypes.NewEIP55Address("0x613a38AC1659769640aaE063C651F48E0250454C")
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "evmlog spec success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					Type: job.EVMLog,
+					EVMLogSpec: &job.EVMLogSpec{
+						ContractAddress: contractAddress,
+						EventABI:        "BetPlaced(uint256 indexed marketId, address indexed bettor, uint256 amount)",
+						TopicFilters: job.EVMLogTopicFilters{
+							"marketId": {"1", "2"},
+							"bettor":   {"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"},
+						},
+						MinConfirmations: clnull.NewUint32(3, true),
+						LookbackBlocks:   100,
+						PollPeriod:       15 * time.Second,
+						EVMChainID:       ubig.NewI(42),
+						CreatedAt:        f.Timestamp(),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on EVMLogSpec {
+									contractAddress
+									eventABI
+									topicFilters {
+										argument
+										values
+									}
+									minConfirmations
+									lookbackBlocks
+									pollPeriod
+									evmChainID
+									createdAt
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "EVMLogSpec",
+							"contractAddress": "0x613a38AC1659769640aaE063C651F48E0250454C",
+							"eventABI": "BetPlaced(uint256 indexed marketId, address indexed bettor, uint256 amount)",
+							"topicFilters": [
+								{"argument": "bettor", "values": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]},
+								{"argument": "marketId", "values": ["1", "2"]}
+							],
+							"minConfirmations": 3,
+							"lookbackBlocks": 100,
+							"pollPeriod": "15s",
+							"evmChainID": "42",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_FluxMonitorSpec(t *testing.T) {
 	var (
 		id = int32(1)
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Depado/ginprom"
@@ -37,7 +38,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -75,13 +78,12 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	}
 	engine.Use(helmet.Default())
 
-	rl := config.WebServer().RateLimit()
 	api := engine.Group(
 		"/",
-		rateLimiter(
-			rl.AuthenticatedPeriod(),
-			rl.Authenticated(),
-		),
+		rateLimiter(func() limiter.Rate {
+			rl := config.WebServer().RateLimit()
+			return limiter.Rate{Period: rl.AuthenticatedPeriod(), Limit: rl.Authenticated()}
+		}),
 		sessions.Sessions(auth.SessionName, sessionStore),
 	)
 
@@ -134,13 +136,24 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 	}
 }
 
-func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
+// rateLimiter limits the requests to the current rate, which may change when the configuration is reloaded.
+func rateLimiter(currentRate func() limiter.Rate) gin.HandlerFunc {
 	store := memory.NewStore()
-	rate := limiter.Rate{
-		Period: period,
-		Limit:  limit,
+	var (
+		mu         sync.Mutex
+		rate       limiter.Rate
+		middleware gin.HandlerFunc
+	)
+	return func(c *gin.Context) {
+		mu.Lock()
+		if r := currentRate(); middleware == nil || r != rate {
+			rate = r
+			middleware = mgin.NewMiddleware(limiter.New(store, rate))
+		}
+		m := middleware
+		mu.Unlock()
+		m(c)
 	}
-	return mgin.NewMiddleware(limiter.New(store, rate))
 }
 
 // secureOptions configure security options for the secure middleware, mostly
@@ -209,11 +222,10 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 
 func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	config := app.GetConfig()
-	rl := config.WebServer().RateLimit()
-	unauth := r.Group("/", rateLimiter(
-		rl.UnauthenticatedPeriod(),
-		rl.Unauthenticated(),
-	))
+	unauth := r.Group("/", rateLimiter(func() limiter.Rate {
+		rl := config.WebServer().RateLimit()
+		return limiter.Rate{Period: rl.UnauthenticatedPeriod(), Limit: rl.Unauthenticated()}
+	}))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
@@ -242,35 +254,59 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	psec := PipelineJobSpecErrorsController{app}
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
-	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
+	authr := auth.WithAPITokens(app.AuthenticationProvider(), app.APITokensORM())
+	authv2 := r.Group("/v2", auth.Authenticate(authr,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.RestrictAPITokens(), auth.LoadCustomRoles(app.CustomRolesORM()))
 	{
 		uc := UserController{app}
 		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
 		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
 		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
 		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.POST("/users/:email/unlock", auth.RequiresAdminRole(uc.Unlock))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		tc := APITokensController{app}
+		authv2.GET("/user/tokens", tc.Index)
+		authv2.POST("/user/tokens", tc.Create)
+		authv2.DELETE("/user/tokens/:name", tc.Delete)
+		authv2.GET("/users/:email/tokens", auth.RequiresAdminRole(tc.UserIndex))
+		authv2.DELETE("/users/:email/tokens/:name", auth.RequiresAdminRole(tc.UserDelete))
+
+		usc := UserSessionsController{app}
+		authv2.GET("/sessions", auth.RequiresAdminRole(usc.Index))
+		authv2.DELETE("/sessions/:id", auth.RequiresAdminRole(usc.Delete))
+		authv2.DELETE("/users/:email/sessions", auth.RequiresAdminRole(usc.DeleteForUser))
+
+		crc := CustomRolesController{app}
+		authv2.GET("/roles", auth.RequiresAdminRole(crc.Index))
+		authv2.POST("/roles", auth.RequiresAdminRole(crc.Create))
+		authv2.GET("/roles/:name", auth.RequiresAdminRole(crc.Show))
+		authv2.PATCH("/roles/:name", auth.RequiresAdminRole(crc.Update))
+		authv2.DELETE("/roles/:name", auth.RequiresAdminRole(crc.Delete))
+		authv2.POST("/roles/:name/users", auth.RequiresAdminRole(crc.Assign))
+		authv2.DELETE("/roles/:name/users/:email", auth.RequiresAdminRole(crc.Unassign))
+		authv2.GET("/users/:email/roles", auth.RequiresAdminRole(crc.UserRoles))
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.POST("/external_initiators", auth.RequiresPermission(rbac.ExternalInitiatorsCreate, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresPermission(rbac.ExternalInitiatorsDelete, eia.Destroy))
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
+		authv2.POST("/bridge_types", auth.RequiresPermission(rbac.BridgesCreate, bt.Create))
 		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresPermission(rbac.BridgesUpdate, bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresPermission(rbac.BridgesDelete, bt.Destroy))
 
 		ets := EVMTransfersController{app}
 		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
@@ -283,6 +319,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.GET("/config/v2", cc.Show)
+		authv2.POST("/config/reload", auth.RequiresAdminRole(cc.Reload))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -298,55 +335,58 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		bhsbc := BHSBackfillController{App: app, Tasks: blockheaderfeeder.NewBackfillTasks()}
+		authv2.POST("/bhs/backfill", auth.RequiresAdminRole(bhsbc.Create))
+		authv2.GET("/bhs/backfill/:ID", auth.RequiresAdminRole(bhsbc.Show))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
-		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
-		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
+		authv2.POST("/keys/csa", auth.RequiresPermission(rbac.KeysCreate, csakc.Create))
+		authv2.POST("/keys/csa/import", auth.RequiresPermission(rbac.KeysImport, csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresPermission(rbac.KeysExport, csakc.Export))
 
 		ekc := NewETHKeysController(app)
 		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.POST("/keys/eth", auth.RequiresPermission(rbac.KeysCreate, ekc.Create))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresPermission(rbac.KeysDelete, ekc.Delete))
+		authv2.POST("/keys/eth/import", auth.RequiresPermission(rbac.KeysImport, ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresPermission(rbac.KeysExport, ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
 
-		ethKeysGroup := authv2.Group("", auth.Authenticate(app.AuthenticationProvider(),
+		ethKeysGroup := authv2.Group("", auth.Authenticate(authr,
 			auth.AuthenticateByToken,
 			auth.AuthenticateBySession,
 		))
 
 		ethKeysGroup.Use(ekc.formatETHKeyResponse())
 		authv2.GET("/keys/evm", ekc.Index)
-		ethKeysGroup.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
-		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresAdminRole(ekc.Delete))
-		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
+		ethKeysGroup.POST("/keys/evm", auth.RequiresPermission(rbac.KeysCreate, ekc.Create))
+		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresPermission(rbac.KeysDelete, ekc.Delete))
+		ethKeysGroup.POST("/keys/evm/import", auth.RequiresPermission(rbac.KeysImport, ekc.Import))
+		authv2.POST("/keys/evm/export/:address", auth.RequiresPermission(rbac.KeysExport, ekc.Export))
 		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
-		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
-		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
-		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
+		authv2.POST("/keys/ocr", auth.RequiresPermission(rbac.KeysCreate, ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresPermission(rbac.KeysDelete, ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", auth.RequiresPermission(rbac.KeysImport, ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresPermission(rbac.KeysExport, ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
 		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
-		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
-		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
-		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresPermission(rbac.KeysCreate, ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresPermission(rbac.KeysDelete, ocr2kc.Delete))
+		authv2.POST("/keys/ocr2/import", auth.RequiresPermission(rbac.KeysImport, ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresPermission(rbac.KeysExport, ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
-		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
-		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
-		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
+		authv2.POST("/keys/p2p", auth.RequiresPermission(rbac.KeysCreate, p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresPermission(rbac.KeysDelete, p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", auth.RequiresPermission(rbac.KeysImport, p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresPermission(rbac.KeysExport, p2pkc.Export))
 
 		for _, keys := range []struct {
 			path string
@@ -360,33 +400,44 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"ton", NewTONKeysController(app)},
 		} {
 			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
-			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
-			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
-			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
+			authv2.POST("/keys/"+keys.path, auth.RequiresPermission(rbac.KeysCreate, keys.kc.Create))
+			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresPermission(rbac.KeysDelete, keys.kc.Delete))
+			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresPermission(rbac.KeysImport, keys.kc.Import))
+			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresPermission(rbac.KeysExport, keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
-		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
-		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
-		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+		authv2.POST("/keys/vrf", auth.RequiresPermission(rbac.KeysCreate, vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresPermission(rbac.KeysDelete, vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresPermission(rbac.KeysImport, vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresPermission(rbac.KeysExport, vrfkc.Export))
 
 		wfkc := WorkflowKeysController{app}
 		authv2.GET("/keys/workflow", wfkc.Index)
 
+		kusc := KeyUsageController{app}
+		authv2.GET("/keys/usage", auth.RequiresAdminRole(paginatedRequest(kusc.Index)))
+
 		jc := JobsController{app}
-		authv2.GET("/jobs", paginatedRequest(jc.Index))
-		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.GET("/jobs", auth.RequiresPermission(rbac.JobsRead, paginatedRequest(jc.Index)))
+		authv2.GET("/jobs/:ID", auth.RequiresPermission(rbac.JobsRead, jc.Show))
+		authv2.POST("/jobs", auth.RequiresPermission(rbac.JobsCreate, jc.Create))
+		authv2.PUT("/jobs/:ID", auth.RequiresPermission(rbac.JobsUpdate, jc.Update))
+		authv2.DELETE("/jobs/:ID", auth.RequiresPermission(rbac.JobsDelete, jc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/jobs/:ID/runs", auth.RequiresPermission(rbac.JobsRead, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs/:runID", auth.RequiresPermission(rbac.JobsRead, prc.Show))
+
+		kuc := KeeperUpkeepsController{app}
+		authv2.GET("/jobs/:ID/upkeeps", auth.RequiresPermission(rbac.JobsRead, kuc.Index))
+		authv2.POST("/jobs/:ID/upkeeps/:upkeepID/check", auth.RequiresPermission(rbac.JobsRun, kuc.Check))
+
+		vrc := VRFRequestsController{app}
+		authv2.GET("/jobs/:ID/vrf_requests", auth.RequiresPermission(rbac.JobsRead, vrc.Index))
+		authv2.POST("/jobs/:ID/vrf_requests/:requestID/retry", auth.RequiresPermission(rbac.JobsRun, vrc.Retry))
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -399,6 +450,24 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
 
+		jllc := JobLogLevelsController{app}
+		authv2.GET("/log/jobs", auth.RequiresPermission(rbac.JobsRead, jllc.Index))
+		authv2.POST("/log/jobs", auth.RequiresAdminRole(jllc.Create))
+		authv2.DELETE("/log/jobs/:id", auth.RequiresAdminRole(jllc.Delete))
+
+		pfc := ProfilesController{app}
+		authv2.GET("/profiles", auth.RequiresAdminRole(pfc.Index))
+		authv2.POST("/profiles", auth.RequiresAdminRole(pfc.Create))
+		authv2.GET("/profiles/download", auth.RequiresAdminRole(pfc.Download))
+
+		sbc := SupportBundleController{app}
+		authv2.GET("/support_bundle", auth.RequiresAdminRole(sbc.Download))
+
+		dc := DrainController{app}
+		authv2.GET("/drain", dc.Show)
+		authv2.POST("/drain", auth.RequiresAdminRole(dc.Create))
+		authv2.DELETE("/drain", auth.RequiresAdminRole(dc.Delete))
+
 		chains := authv2.Group("chains")
 		chainController := NewChainsController(
 			app.GetRelayers(),
@@ -431,13 +500,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	}
 
 	ping := PingController{app}
-	userOrEI := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
+	userOrEI := r.Group("/v2", auth.Authenticate(authr,
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.RestrictAPITokens(), auth.LoadCustomRoles(app.CustomRolesORM()))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresPermission(rbac.JobsRun, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -37,6 +38,8 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("error binding json %w", err))
 		return
 	}
+	sr.IPAddress = c.ClientIP()
+	sr.UserAgent = c.Request.UserAgent()
 
 	// Does this user have 2FA enabled?
 	userWebAuthnTokens, err := sc.App.AuthenticationProvider().GetUserWebAuthn(ctx, sr.Email)
@@ -54,6 +57,12 @@ func (sc *SessionsController) Create(c *gin.Context) {
 	}
 
 	sid, err := sc.App.AuthenticationProvider().CreateSession(ctx, sr)
+	var lockedErr *clsessions.LoginLockedError
+	if errors.As(err, &lockedErr) {
+		c.Header("Retry-After", strconv.Itoa(int(lockedErr.RetryAfter.Seconds())))
+		jsonAPIError(c, http.StatusTooManyRequests, err)
+		return
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusUnauthorized, err)
 		return
@@ -0,0 +1,43 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/supportbundle"
+)
+
+// SupportBundleController serves support bundles, which gather the state of the node needed to troubleshoot it.
+type SupportBundleController struct {
+	App chainlink.Application
+}
+
+// Download serves a support bundle of the node as a zip archive: the effective configuration, the recent logs, the
+// job summaries and the recent errored pipeline runs with their credentials redacted, the health report, the recent
+// profile captures and the build info.
+// Example:
+// "GET <application>/support_bundle"
+func (sc *SupportBundleController) Download(c *gin.Context) {
+	w := &zipResponseWriter{c: c, filename: "support-bundle.zip"}
+	err := supportbundle.Write(c.Request.Context(), w, supportbundle.Sources{
+		Config:        sc.App.GetConfig(),
+		HealthChecker: sc.App.GetHealthChecker(),
+		JobORM:        sc.App.JobORM(),
+		Nurse:         sc.App.Nurse(),
+	}, sc.App.GetLogger())
+	if err != nil {
+		if w.started {
+			// the status is already sent, the client gets a truncated archive
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	sc.App.GetAuditLogger().Audit(audit.SupportBundleDownloaded, map[string]interface{}{})
+}
@@ -198,6 +198,40 @@ func (u *UserController) Delete(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
+// Unlock clears the failed logins of an API user, lifting any lockout of the account
+func (u *UserController) Unlock(c *gin.Context) {
+	ctx := c.Request.Context()
+	email := c.Param("email")
+
+	user, err := u.App.AuthenticationProvider().FindUser(ctx, email)
+	if err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("specified user not found: %s", email))
+		return
+	}
+
+	if err = u.App.AuthenticationProvider().UnlockUser(ctx, user.Email); err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		u.App.GetLogger().Errorw("Error unlocking API user", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("error unlocking API user"))
+		return
+	}
+
+	var unlockedBy string
+	if sessionUser, ok := webauth.GetAuthenticatedUser(c); ok {
+		unlockedBy = sessionUser.Email
+	}
+	u.App.GetAuditLogger().Audit(audit.AuthUserUnlocked, map[string]interface{}{"email": user.Email, "unlockedBy": unlockedBy})
+
+	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
+}
+
 // UpdatePassword changes the password for the current User.
 func (u *UserController) UpdatePassword(c *gin.Context) {
 	ctx := c.Request.Context()
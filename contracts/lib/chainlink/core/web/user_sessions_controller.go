@@ -0,0 +1,99 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// UserSessionsController lets administrators see and revoke the active sessions of all users,
+// whatever the authentication provider.
+type UserSessionsController struct {
+	App chainlink.Application
+}
+
+// Index lists the active sessions, optionally only those of the user given by the email query
+// parameter.
+// Example:
+// "GET <application>/sessions"
+func (sc *UserSessionsController) Index(c *gin.Context) {
+	active, err := sc.App.AuthenticationProvider().ActiveSessions(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if email := c.Query("email"); email != "" {
+		filtered := active[:0]
+		for _, s := range active {
+			if strings.EqualFold(s.Email, email) {
+				filtered = append(filtered, s)
+			}
+		}
+		active = filtered
+	}
+	currentSessionID, _ := sessions.Default(c).Get(webauth.SessionIDKey).(string)
+	jsonAPIResponse(c, presenters.NewSessionResources(active, currentSessionID), "sessions")
+}
+
+// Delete revokes a single session, given by its public ID.
+// Example:
+// "DELETE <application>/sessions/:id"
+func (sc *UserSessionsController) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+	active, err := sc.App.AuthenticationProvider().ActiveSessions(ctx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	session, err := clsessions.FindSessionByPublicID(active, c.Param("id"))
+	if errors.Is(err, clsessions.ErrSessionNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err = sc.App.AuthenticationProvider().DeleteUserSession(ctx, session.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	sc.App.GetAuditLogger().Audit(audit.AuthSessionRevoked, map[string]interface{}{
+		"user":      session.Email,
+		"session":   session.PublicID(),
+		"revokedBy": sc.revokedBy(c),
+	})
+	jsonAPIResponseWithStatus(c, nil, "session", http.StatusNoContent)
+}
+
+// DeleteForUser logs a user out of all their sessions.
+// Example:
+// "DELETE <application>/users/:email/sessions"
+func (sc *UserSessionsController) DeleteForUser(c *gin.Context) {
+	email := c.Param("email")
+	count, err := sc.App.AuthenticationProvider().DeleteSessionsForUser(c.Request.Context(), email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	sc.App.GetAuditLogger().Audit(audit.AuthUserSessionsRevoked, map[string]interface{}{
+		"user":      email,
+		"count":     count,
+		"revokedBy": sc.revokedBy(c),
+	})
+	jsonAPIResponseWithStatus(c, nil, "session", http.StatusNoContent)
+}
+
+func (sc *UserSessionsController) revokedBy(c *gin.Context) string {
+	if user, ok := webauth.GetAuthenticatedUser(c); ok {
+		return user.Email
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestUserSessionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	admin := cltest.User{}
+	client := app.NewHTTPClient(&admin)
+	viewer := cltest.User{Role: sessions.UserRoleView}
+	app.NewHTTPClient(&viewer)
+
+	resp, cleanup := client.Get("/v2/sessions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var all []presenters.SessionResource
+	cltest.ParseJSONAPIResponse(t, resp, &all)
+	require.Len(t, all, 2)
+
+	resp, cleanup = client.Get("/v2/sessions?email=" + viewer.Email)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var filtered []presenters.SessionResource
+	cltest.ParseJSONAPIResponse(t, resp, &filtered)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, viewer.Email, filtered[0].Email)
+	assert.False(t, filtered[0].Current)
+}
+
+func TestUserSessionsController_Delete(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.AuthenticationProvider().CreateUser(ctx, &user))
+	sessionID := app.MustSeedNewSession(user.Email)
+
+	resp, cleanup := client.Delete("/v2/sessions/unknown")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Delete("/v2/sessions/" + sessions.Session{ID: sessionID}.PublicID())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	_, err := app.AuthenticationProvider().AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
+
+func TestUserSessionsController_DeleteForUser(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.AuthenticationProvider().CreateUser(ctx, &user))
+	app.MustSeedNewSession(user.Email)
+	app.MustSeedNewSession(user.Email)
+
+	resp, cleanup := client.Delete(fmt.Sprintf("/v2/users/%s/sessions", user.Email))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	active, err := app.AuthenticationProvider().ActiveSessions(ctx)
+	require.NoError(t, err)
+	for _, s := range active {
+		assert.NotEqual(t, user.Email, s.Email)
+	}
+}
+
+func TestUserSessionsController_RequiresAdminRole(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleEdit})
+
+	resp, cleanup := client.Get("/v2/sessions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+}
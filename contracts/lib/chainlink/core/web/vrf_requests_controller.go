@@ -0,0 +1,108 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// VRFRequestsController exposes the requests of running VRF v2 and v2.5 jobs for debugging
+// stuck fulfillments.
+type VRFRequestsController struct {
+	App chainlink.Application
+}
+
+// Index lists the pending, in-flight and recently reverted requests of a VRF job,
+// along with the reason each one has not been fulfilled yet.
+// Example:
+// "GET <application>/jobs/:ID/vrf_requests"
+func (vc *VRFRequestsController) Index(c *gin.Context) {
+	inspector, ok := vc.requestInspector(c, rbac.JobsRead)
+	if !ok {
+		return
+	}
+
+	statuses, err := inspector.Requests(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewVRFRequestResources(statuses), "vrf_requests")
+}
+
+// Retry evicts a request from the in-flight cache of a VRF job so that it is
+// processed again on the next poll.
+// Example:
+// "POST <application>/jobs/:ID/vrf_requests/:requestID/retry"
+func (vc *VRFRequestsController) Retry(c *gin.Context) {
+	inspector, ok := vc.requestInspector(c, rbac.JobsRun)
+	if !ok {
+		return
+	}
+
+	requestID := c.Param("requestID")
+	if err := inspector.RetryRequest(requestID); err != nil {
+		if errors.Is(err, vrfv2.ErrRequestNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	vc.App.GetAuditLogger().Audit(audit.VRFRequestRetried, map[string]interface{}{
+		"jobID":     c.Param("ID"),
+		"requestID": requestID,
+	})
+
+	statuses, err := inspector.Requests(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for _, status := range statuses {
+		if status.RequestID == requestID {
+			jsonAPIResponse(c, presenters.NewVRFRequestResource(status), "vrf_requests")
+			return
+		}
+	}
+	jsonAPIError(c, http.StatusNotFound, errors.Errorf("request %s not found", requestID))
+}
+
+// requestInspector returns the request inspector of the VRF job given by the ID param, if the user is granted the
+// permission for it.
+func (vc *VRFRequestsController) requestInspector(c *gin.Context, permission rbac.Permission) (vrfv2.RequestInspector, bool) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return nil, false
+	}
+	jb, err := vc.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return nil, false
+	}
+	if !auth.AuthorizeJob(c, permission, rbacJob(jb)) {
+		return nil, false
+	}
+	if jb.VRFSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a VRF job", jb.ID))
+		return nil, false
+	}
+
+	inspector, ok := vc.App.VRFRequestInspectors().Get(jb.ID)
+	if !ok {
+		jsonAPIError(c, http.StatusConflict, errors.Errorf("job %d is not running a VRF v2 or v2.5 listener on this node", jb.ID))
+		return nil, false
+	}
+	return inspector, true
+}